@@ -44,6 +44,9 @@ const (
 	FileBased RequestType = iota
 	// CommitBased request types require checks to run on non-HEAD commit content.
 	CommitBased
+	// GitHistoryBased request types require checks to run solely on file-content
+	// and the history of a local git repository.
+	GitHistoryBased
 )
 
 // ListUnsupported returns []RequestType not in `supported` and are `required`.
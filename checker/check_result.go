@@ -272,17 +272,76 @@ func (check *CheckResult) Annotations(c config.Config) []string {
 	var reasons []string
 
 	// For all annotations
-	for _, annotation := range c.Annotations {
-		for _, checkName := range annotation.Checks {
-			// If check is in this annotation
-			if strings.EqualFold(checkName, check.Name) {
-				// Get all the reasons for this annotation
-				for _, reasonGroup := range annotation.Reasons {
-					reasons = append(reasons, reasonGroup.Reason.Doc())
+	for i := range c.Annotations {
+		annotation := &c.Annotations[i]
+		for j := range annotation.Reasons {
+			reasonGroup := &annotation.Reasons[j]
+			// Reasons on a probe apply to the check running it, others to the annotated checks
+			if reasonGroup.Probe != "" {
+				if !check.annotatesProbe(annotation, reasonGroup.Probe) {
+					continue
 				}
+			} else if !check.annotatesCheck(annotation) {
+				continue
 			}
+			reasons = append(reasons, annotation.Describe(reasonGroup))
 		}
 	}
 
 	return reasons
 }
+
+// annotatesCheck reports whether the annotation names the check and,
+// if it is restricted to paths, covers every file the check warns about.
+func (check *CheckResult) annotatesCheck(annotation *config.Annotation) bool {
+	named := false
+	for _, checkName := range annotation.Checks {
+		named = named || strings.EqualFold(checkName, check.Name)
+	}
+	if !named {
+		return false
+	}
+	if len(annotation.Paths) == 0 {
+		return true
+	}
+	var paths []string
+	for i := range check.Details {
+		if check.Details[i].Type == DetailWarn && check.Details[i].Msg.Path != "" {
+			paths = append(paths, check.Details[i].Msg.Path)
+		}
+	}
+	return coversPaths(annotation, paths)
+}
+
+// annotatesProbe reports whether the check ran the probe and, if the annotation
+// is restricted to paths, every finding of the probe is in a covered file.
+func (check *CheckResult) annotatesProbe(annotation *config.Annotation, probe string) bool {
+	ran := false
+	var paths []string
+	for i := range check.Findings {
+		f := &check.Findings[i]
+		if f.Probe != probe {
+			continue
+		}
+		ran = true
+		if f.Location != nil && f.Location.Path != "" {
+			paths = append(paths, f.Location.Path)
+		}
+	}
+	if !ran {
+		return false
+	}
+	return len(annotation.Paths) == 0 || coversPaths(annotation, paths)
+}
+
+func coversPaths(annotation *config.Annotation, paths []string) bool {
+	if len(paths) == 0 {
+		return false
+	}
+	for _, p := range paths {
+		if !annotation.MatchesPath(p) {
+			return false
+		}
+	}
+	return true
+}
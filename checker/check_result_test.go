@@ -23,6 +23,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/config"
 	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
 )
 
 func TestAggregateScores(t *testing.T) {
@@ -989,3 +990,79 @@ func TestAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestAnnotations_scoped(t *testing.T) {
+	t.Parallel()
+	testData := config.TestData
+	testDataOnly := config.Annotation{
+		Checks:  []string{"binary-artifacts"},
+		Paths:   []string{"testdata/**"},
+		Owner:   "@maintainer",
+		Reasons: []config.ReasonGroup{{Reason: config.TestData}},
+	}
+	probeTestDataOnly := config.Annotation{
+		Paths:   []string{"testdata/**"},
+		Reasons: []config.ReasonGroup{{Reason: config.TestData, Probe: "hasUnverifiedBinaryArtifacts"}},
+	}
+	binaries := func(paths ...string) CheckResult {
+		check := CheckResult{Name: "Binary-Artifacts", Score: 0}
+		for _, p := range paths {
+			check.Details = append(check.Details, CheckDetail{Type: DetailWarn, Msg: LogMessage{Path: p}})
+			check.Findings = append(check.Findings, finding.Finding{
+				Probe:    "hasUnverifiedBinaryArtifacts",
+				Outcome:  finding.OutcomeTrue,
+				Location: &finding.Location{Path: p},
+			})
+		}
+		return check
+	}
+	tests := []struct {
+		name       string
+		check      CheckResult
+		annotation config.Annotation
+		want       []string
+	}{
+		{
+			name:       "all warnings in annotated paths",
+			check:      binaries("testdata/a.exe", "testdata/nested/b.exe"),
+			annotation: testDataOnly,
+			want: []string{
+				testData.Doc() + " (paths: testdata/**; owner: @maintainer)",
+			},
+		},
+		{
+			name:       "warning outside annotated paths",
+			check:      binaries("testdata/a.exe", "bin/b.exe"),
+			annotation: testDataOnly,
+		},
+		{
+			name:       "probe findings in annotated paths",
+			check:      binaries("testdata/a.exe"),
+			annotation: probeTestDataOnly,
+			want: []string{
+				testData.Doc() + " (probe: hasUnverifiedBinaryArtifacts; paths: testdata/**)",
+			},
+		},
+		{
+			name:       "probe finding outside annotated paths",
+			check:      binaries("testdata/a.exe", "bin/b.exe"),
+			annotation: probeTestDataOnly,
+		},
+		{
+			name:  "probe not run by the check",
+			check: CheckResult{Name: "Pinned-Dependencies", Score: 0},
+			annotation: config.Annotation{
+				Reasons: []config.ReasonGroup{{Reason: config.TestData, Probe: "hasUnverifiedBinaryArtifacts"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.check.Annotations(config.Config{Annotations: []config.Annotation{tt.annotation}})
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Annotations() (-want,+got): %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/ossf/scorecard/v5/clients"
 	azdorepo "github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	bbrepo "github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	gtrepo "github.com/ossf/scorecard/v5/clients/gitearepo"
 	ghrepo "github.com/ossf/scorecard/v5/clients/githubrepo"
 	glrepo "github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -66,6 +68,13 @@ func GetClients(ctx context.Context, repoURI, localURI string, logger *log.Logge
 		repoClient, makeRepoError = glrepo.CreateGitlabClient(ctx, repo.Host())
 	}
 
+	if experimental && (makeRepoError != nil || repo == nil) {
+		repo, makeRepoError = bbrepo.MakeBitbucketRepo(repoURI)
+		if repo != nil && makeRepoError == nil {
+			repoClient, makeRepoError = bbrepo.CreateBitbucketClient(ctx, repo)
+		}
+	}
+
 	if experimental && (makeRepoError != nil || repo == nil) {
 		repo, makeRepoError = azdorepo.MakeAzureDevOpsRepo(repoURI)
 		if repo != nil && makeRepoError == nil {
@@ -73,6 +82,13 @@ func GetClients(ctx context.Context, repoURI, localURI string, logger *log.Logge
 		}
 	}
 
+	if experimental && (makeRepoError != nil || repo == nil) {
+		repo, makeRepoError = gtrepo.MakeGiteaRepo(repoURI)
+		if repo != nil && makeRepoError == nil {
+			repoClient, makeRepoError = gtrepo.CreateGiteaClient(ctx, repo.Host())
+		}
+	}
+
 	if makeRepoError != nil || repo == nil {
 		repo, makeRepoError = ghrepo.MakeGithubRepo(repoURI)
 		if makeRepoError != nil {
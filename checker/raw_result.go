@@ -43,6 +43,7 @@ type RawResults struct {
 	PackagingResults            PackagingData
 	PinningDependenciesResults  PinningDependenciesData
 	SASTResults                 SASTData
+	SecretsResults              SecretsData
 	SecurityPolicyResults       SecurityPolicyData
 	SignedReleasesResults       SignedReleasesData
 	TokenPermissionsResults     TokenPermissionsData
@@ -51,9 +52,21 @@ type RawResults struct {
 }
 
 type MetadataData struct {
+	// Date is the time the analysis is performed at.
+	Date     time.Time
 	Metadata map[string]string
 }
 
+// Now returns the time the analysis is performed at, or the current time if
+// it isn't set. Time-based probes use it rather than the wall clock, so that
+// replayed runs produce the same findings.
+func (m *MetadataData) Now() time.Time {
+	if m.Date.IsZero() {
+		return time.Now()
+	}
+	return m.Date
+}
+
 type RevisionCIInfo struct {
 	HeadSHA           string
 	CheckRuns         []clients.CheckRun
@@ -68,6 +81,15 @@ type CITestData struct {
 // FuzzingData represents different fuzzing done.
 type FuzzingData struct {
 	Fuzzers []Tool
+	// Jobs are the CI jobs running fuzzers.
+	Jobs []FuzzingJob
+}
+
+// FuzzingJob is a CI job running a fuzzer.
+type FuzzingJob struct {
+	// Fuzzer is the name of the fuzzer, as in FuzzingData.Fuzzers.
+	Fuzzer string
+	File   File
 }
 
 // TODO: Add Msg to all results.
@@ -120,14 +142,48 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypeGitLabCIContainerImage is a container image used via image or services in GitLab CI.
+	DependencyUseTypeGitLabCIContainerImage DependencyUseType = "gitLabCIContainerImage"
+	// DependencyUseTypeGitLabCIInclude is a remote, project or component include in GitLab CI.
+	DependencyUseTypeGitLabCIInclude DependencyUseType = "gitLabCIInclude"
+	// DependencyUseTypeAzurePipelinesContainerImage is a container image used in Azure Pipelines.
+	DependencyUseTypeAzurePipelinesContainerImage DependencyUseType = "azurePipelinesContainerImage"
+	// DependencyUseTypeAzurePipelinesTemplate is a template used from a repository resource in Azure Pipelines.
+	DependencyUseTypeAzurePipelinesTemplate DependencyUseType = "azurePipelinesTemplate"
+	// DependencyUseTypeAzurePipelinesTask is a task used in Azure Pipelines.
+	DependencyUseTypeAzurePipelinesTask DependencyUseType = "azurePipelinesTask"
+	// DependencyUseTypeManifestContainerImage is a container image referenced by a Kubernetes manifest,
+	// a Kustomization, Helm chart values or a Compose file.
+	DependencyUseTypeManifestContainerImage DependencyUseType = "manifestContainerImage"
 )
 
 // PinningDependenciesData represents pinned dependency data.
 type PinningDependenciesData struct {
 	Dependencies     []Dependency
+	Manifests        []DependencyManifest
+	Lockfiles        []Lockfile
 	ProcessingErrors []ElementError // jobs or files with errors may have incomplete results
 }
 
+// DependencyManifest is a file declaring the dependencies of a package, e.g., package.json.
+type DependencyManifest struct {
+	// Lockfile is the path of the lockfile pinning the dependencies, empty if there is none.
+	Lockfile  string
+	Ecosystem string
+	File      File
+}
+
+// Lockfile is a file pinning the versions of the dependencies of manifests, e.g., package-lock.json.
+type Lockfile struct {
+	Ecosystem string
+	File      File
+	// Dependencies is the number of dependencies fetched from registries
+	// or repositories, of which Hashed are pinned by an integrity hash or
+	// a commit. Local dependencies aren't counted.
+	Dependencies int
+	Hashed       int
+}
+
 // Dependency represents a dependency.
 type Dependency struct {
 	// TODO: unique dependency name.
@@ -138,7 +194,9 @@ type Dependency struct {
 	Msg         *string // Only for debug messages.
 	Pinned      *bool
 	Remediation *finding.Remediation
-	Type        DependencyUseType
+	// Caller is the step using the local composite action declaring the dependency.
+	Caller *File
+	Type   DependencyUseType
 }
 
 // MaintainedData contains the raw results
@@ -180,10 +238,74 @@ type LicenseData struct {
 	LicenseFiles []LicenseFile
 }
 
+// SecretType is the type of a secret committed to a repository.
+type SecretType string
+
+const (
+	// SecretTypePrivateKey is a private key, e.g. a PEM or OpenSSH one.
+	SecretTypePrivateKey SecretType = "PrivateKey"
+	// SecretTypeCloudAccessKey is an access key of a cloud provider.
+	SecretTypeCloudAccessKey SecretType = "CloudAccessKey"
+	// SecretTypeHighEntropyToken is a random-looking value assigned to a
+	// name like token or password.
+	SecretTypeHighEntropyToken SecretType = "HighEntropyToken"
+)
+
+// Secret is a secret committed to a repository.
+type Secret struct {
+	// Rule describes the secret, e.g. "AWS access key".
+	Rule string
+	Type SecretType
+	// File.Snippet is the line of the secret, with the secret redacted.
+	File File
+}
+
+// SecretsData contains the raw results for the Secrets check.
+type SecretsData struct {
+	// SecretScanning is nil if the settings are not available,
+	// e.g. for other forges or tokens without admin access.
+	SecretScanning *clients.SecretScanningSettings
+	Secrets        []Secret
+}
+
 // SBOM details.
 type SBOM struct {
-	Name string // SBOM Filename
-	File File   // SBOM File Object
+	// Document is the content of the SBOM, nil if it couldn't be read or
+	// its encoding isn't supported.
+	Document *SBOMDocument
+	Name     string // SBOM Filename
+	File     File   // SBOM File Object
+}
+
+// SBOMFormat is the specification an SBOM follows.
+type SBOMFormat string
+
+const (
+	SBOMFormatSPDX      SBOMFormat = "SPDX"
+	SBOMFormatCycloneDX SBOMFormat = "CycloneDX"
+)
+
+// SBOMDocument is the content of an SBOM.
+type SBOMDocument struct {
+	// Created is when the SBOM was generated, zero if unknown.
+	Created time.Time
+	// DescribesRepo is whether the purl or VCS URL of the subject of the SBOM
+	// is the repository, nil if the subject has neither.
+	DescribesRepo *bool
+	// Stale is whether the SBOM was generated well before the latest release,
+	// nil if unknown or if the SBOM is attached to an older release.
+	Stale  *bool
+	Format SBOMFormat
+	// SpecVersion is the version of the specification, e.g. "2.3" or "1.5".
+	SpecVersion string
+	// Encoding is "json", "xml" or "tag-value".
+	Encoding string
+	// Tools are the tools which generated the SBOM.
+	Tools []string
+	// Errors are the ways the document doesn't conform to its specification.
+	Errors []string
+	// Components is the number of packages or components the SBOM lists.
+	Components int
 }
 
 // SBOMData contains the raw results for the SBOM check.
@@ -225,6 +347,33 @@ type ContributorsData struct {
 // for the Vulnerabilities check.
 type VulnerabilitiesData struct {
 	Vulnerabilities []clients.Vulnerability
+	// Gates are the tools checking changes for vulnerable dependencies before they're merged.
+	Gates []VulnerabilityGate
+}
+
+// VulnerabilityGateType is a tool checking changes for vulnerable dependencies.
+type VulnerabilityGateType string
+
+const (
+	VulnerabilityGateDependencyReview         VulnerabilityGateType = "dependency-review-action"
+	VulnerabilityGateOSVScanner               VulnerabilityGateType = "osv-scanner"
+	VulnerabilityGateGovulncheck              VulnerabilityGateType = "govulncheck"
+	VulnerabilityGateNpmAudit                 VulnerabilityGateType = "npm audit"
+	VulnerabilityGateCargoAudit               VulnerabilityGateType = "cargo audit"
+	VulnerabilityGateGitLabDependencyScanning VulnerabilityGateType = "GitLab Dependency Scanning"
+	VulnerabilityGateRenovate                 VulnerabilityGateType = "Renovate vulnerability alerts"
+)
+
+// VulnerabilityGate is a CI job, or a bot, checking changes for vulnerable dependencies.
+type VulnerabilityGate struct {
+	// Required is whether Job is a required status check of the default branch,
+	// nil if unknown.
+	Required *bool
+	Type     VulnerabilityGateType
+	// Job is the name of the status check of the CI job running the tool,
+	// empty for GitLab pipelines and bots.
+	Job  string
+	File File
 }
 
 type SecurityPolicyInformationType string
@@ -257,7 +406,10 @@ type SecurityPolicyFile struct {
 // SASTData contains the raw results
 // for the SAST check.
 type SASTData struct {
-	Workflows    []SASTWorkflow
+	Workflows []SASTWorkflow
+	// ConfigFiles are the configuration files and pre-commit hooks setting up
+	// SAST tools, which don't show that the tools run.
+	ConfigFiles  []SASTWorkflow
 	Commits      []SASTCommit
 	NumWorkflows int
 }
@@ -286,6 +438,22 @@ const (
 	PysaWorkflow SASTWorkflowType = "Pysa"
 	// QodanaWorkflow represents a workflow that runs Qodana.
 	QodanaWorkflow SASTWorkflowType = "Qodana"
+	// SemgrepWorkflow represents a workflow or configuration that runs Semgrep.
+	SemgrepWorkflow SASTWorkflowType = "Semgrep"
+	// GosecWorkflow represents a workflow or configuration that runs gosec.
+	GosecWorkflow SASTWorkflowType = "gosec"
+	// BanditWorkflow represents a workflow or configuration that runs Bandit.
+	BanditWorkflow SASTWorkflowType = "Bandit"
+	// BrakemanWorkflow represents a workflow or configuration that runs Brakeman.
+	BrakemanWorkflow SASTWorkflowType = "Brakeman"
+	// SpotBugsWorkflow represents a workflow or configuration that runs SpotBugs, or its FindSecBugs plugin.
+	SpotBugsWorkflow SASTWorkflowType = "SpotBugs"
+	// CheckmarxWorkflow represents a workflow that runs Checkmarx.
+	CheckmarxWorkflow SASTWorkflowType = "Checkmarx"
+	// ESLintSecurityWorkflow represents an ESLint configuration with security plugins.
+	ESLintSecurityWorkflow SASTWorkflowType = "ESLint security"
+	// GitLabSASTWorkflow represents a GitLab CI configuration including the GitLab SAST template.
+	GitLabSASTWorkflow SASTWorkflowType = "GitLab SAST"
 )
 
 // SASTWorkflow represents a SAST workflow.
@@ -312,6 +480,96 @@ type BinaryArtifactData struct {
 type SignedReleasesData struct {
 	Releases []clients.Release
 	Packages []ProjectPackage
+	// Signatures are the release signatures which were checked cryptographically.
+	Signatures []ReleaseSignature
+	// Provenance is the in-toto provenance attached to the latest releases, one
+	// entry per release which has some.
+	Provenance []ReleaseProvenance
+}
+
+// SignatureKind is the format of a release signature.
+type SignatureKind string
+
+const (
+	SignatureKindSigstore SignatureKind = "sigstore"
+	SignatureKindCosign   SignatureKind = "cosign"
+	SignatureKindMinisign SignatureKind = "minisign"
+	SignatureKindOpenPGP  SignatureKind = "openpgp"
+)
+
+// ReleaseSignature is the verification of a signature of a release artifact.
+type ReleaseSignature struct {
+	// Signer identifies who signed the artifact, if the signature was verified.
+	Signer *ReleaseSigner
+	// Release is the tag of the release.
+	Release string
+	// Artifact is the name of the signed asset.
+	Artifact string
+	Kind     SignatureKind
+	// Error is why the signature couldn't be verified, empty if it was.
+	Error string
+	// File is the signature asset.
+	File File
+}
+
+// ReleaseSigner identifies the signer of a verified release signature.
+type ReleaseSigner struct {
+	// Identity is the subject of a Sigstore certificate, e.g. an email or a
+	// workflow URI, or the primary user ID of an OpenPGP key.
+	Identity string
+	// Issuer is the OIDC issuer which authenticated a Sigstore identity.
+	Issuer string
+	// KeyID identifies the key of a key-based signature, e.g. an OpenPGP fingerprint.
+	KeyID string
+	// MatchesRepo is whether the Sigstore identity is the repository or one of its
+	// workflows, nil for key-based signatures. It's false if the repository is unknown.
+	MatchesRepo *bool
+}
+
+// ReleaseProvenance is an in-toto provenance attestation attached to a release.
+type ReleaseProvenance struct {
+	// Signer identifies who signed the attestation, if its signature was verified.
+	Signer *ReleaseSigner
+	// Release is the tag of the release.
+	Release string
+	// PredicateType is the SLSA provenance version, e.g. "https://slsa.dev/provenance/v1".
+	PredicateType string
+	// BuilderID identifies the platform which built the release artifacts.
+	BuilderID string
+	// Error is why the attestation couldn't be verified, empty if it was.
+	Error    string
+	Source   ProvenanceSource
+	Subjects []ProvenanceSubject
+	// File is the provenance asset.
+	File File
+	// BuildLevel is the SLSA build level the provenance evidences: 1 if it
+	// isn't verified, 2 if it is signed by a hosted build platform, and 3 if
+	// that platform is a known hardened builder.
+	BuildLevel int
+	// Logged is whether the signature was verified to be in the transparency log.
+	Logged bool
+}
+
+// ProvenanceSource is the source a release was built from, according to its provenance.
+type ProvenanceSource struct {
+	// MatchesRepo is whether Repository is the repository, nil if either is unknown.
+	MatchesRepo *bool
+	// MatchesRelease is whether Ref is the tag or the target of the release, or
+	// Commit its target, nil if the provenance has neither.
+	MatchesRelease *bool
+	// Repository is the URI of the source repository, e.g. "git+https://github.com/owner/repo".
+	Repository string
+	// Ref is the git ref which was built, e.g. "refs/tags/v1.0.0".
+	Ref    string
+	Commit string
+}
+
+// ProvenanceSubject is an artifact the provenance of a release describes.
+type ProvenanceSubject struct {
+	// Matches is whether the release asset of the same name has the digest of the
+	// subject, nil if the release has no such asset or it wasn't checked.
+	Matches *bool
+	Name    string
 }
 
 // DependencyUpdateToolData contains the raw results
@@ -369,9 +627,32 @@ type File struct {
 	EndOffset uint             // End of offset in the file, e.g. if the command spans multiple lines.
 	FileSize  uint             // Total size of file.
 	Type      finding.FileType // Type of file.
+	// Format is the format of a binary artifact, empty if unknown.
+	Format BinaryFormat
+	// Arch is the architecture of a binary artifact in GOARCH terms, e.g. "amd64",
+	// empty if unknown or architecture-independent.
+	Arch string
 	// TODO: add hash.
 }
 
+// BinaryFormat is the file format of a binary artifact, identified by its magic bytes.
+type BinaryFormat string
+
+const (
+	BinaryFormatELF       BinaryFormat = "ELF"
+	BinaryFormatPE        BinaryFormat = "PE"
+	BinaryFormatDotNet    BinaryFormat = ".NET"
+	BinaryFormatMachO     BinaryFormat = "Mach-O"
+	BinaryFormatJavaClass BinaryFormat = "Java class"
+	BinaryFormatJAR       BinaryFormat = "JAR"
+	BinaryFormatDEX       BinaryFormat = "DEX"
+	BinaryFormatWASM      BinaryFormat = "WebAssembly"
+	BinaryFormatPyc       BinaryFormat = "Python bytecode"
+	// BinaryFormatArchive is an archive containing executables, whose
+	// architecture is that of the first executable found.
+	BinaryFormatArchive BinaryFormat = "archive"
+)
+
 // CIIBestPracticesData contains data for CIIBestPractices check.
 type CIIBestPracticesData struct {
 	Badge clients.BadgeLevel
@@ -385,6 +666,14 @@ const (
 	DangerousWorkflowScriptInjection DangerousWorkflowType = "scriptInjection"
 	// DangerousWorkflowUntrustedCheckout represents an untrusted checkout.
 	DangerousWorkflowUntrustedCheckout DangerousWorkflowType = "untrustedCheckout"
+	// DangerousWorkflowArtifactPoisoning represents a workflow_run workflow running code
+	// next to the artifacts of the workflow run which triggered it.
+	DangerousWorkflowArtifactPoisoning DangerousWorkflowType = "artifactPoisoning"
+	// DangerousWorkflowCachePoisoning represents a cache written by untrusted code, which
+	// release jobs may restore.
+	DangerousWorkflowCachePoisoning DangerousWorkflowType = "cachePoisoning"
+	// DangerousWorkflowSelfHostedRunner represents a self-hosted runner running code from forks.
+	DangerousWorkflowSelfHostedRunner DangerousWorkflowType = "selfHostedRunner"
 )
 
 // DangerousWorkflowData contains raw results
@@ -399,6 +688,9 @@ type DangerousWorkflow struct {
 	Job  *WorkflowJob
 	Type DangerousWorkflowType
 	File File
+	// Caller is the step or job using the local composite action or reusable
+	// workflow in File, if the pattern was found by following it.
+	Caller *File
 }
 
 // WorkflowJob represents a workflow job.
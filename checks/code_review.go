@@ -30,6 +30,7 @@ const CheckCodeReview = "Code-Review"
 func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.CommitBased,
+		checker.GitHistoryBased,
 	}
 	if err := registerCheck(CheckCodeReview, CodeReview, supportedRequestTypes); err != nil {
 		// this should never happen
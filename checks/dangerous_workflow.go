@@ -15,9 +15,14 @@
 package checks
 
 import (
+	"fmt"
+
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/evaluation"
 	"github.com/ossf/scorecard/v5/checks/raw"
+	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/localdir"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/probes"
 	"github.com/ossf/scorecard/v5/probes/zrunner"
@@ -31,6 +36,7 @@ func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.CommitBased,
 		checker.FileBased,
+		checker.GitHistoryBased,
 	}
 	if err := registerCheck(CheckDangerousWorkflow, DangerousWorkflow, supportedRequestTypes); err != nil {
 		// this should never happen
@@ -41,6 +47,9 @@ func init() {
 // DangerousWorkflow  will check the repository contains Dangerous-Workflow.
 func DangerousWorkflow(c *checker.CheckRequest) checker.CheckResult {
 	rawData, err := raw.DangerousWorkflow(c)
+	if err == nil {
+		err = addGitLabDangerousWorkflow(c, &rawData)
+	}
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckDangerousWorkflow, e)
@@ -61,3 +70,20 @@ func DangerousWorkflow(c *checker.CheckRequest) checker.CheckResult {
 	ret.Findings = findings
 	return ret
 }
+
+// addGitLabDangerousWorkflow adds the dangerous patterns of the GitLab CI
+// configuration of projects which may have one.
+func addGitLabDangerousWorkflow(c *checker.CheckRequest, rawData *checker.DangerousWorkflowData) error {
+	switch c.RepoClient.(type) {
+	case *gitlabrepo.Client, *localdir.Client:
+	default:
+		return nil
+	}
+	gitlabData, err := gitlab.DangerousWorkflow(c)
+	if err != nil {
+		return fmt.Errorf("gitlab.DangerousWorkflow: %w", err)
+	}
+	rawData.NumWorkflows += gitlabData.NumWorkflows
+	rawData.Workflows = append(rawData.Workflows, gitlabData.Workflows...)
+	return nil
+}
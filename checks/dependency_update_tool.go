@@ -30,6 +30,7 @@ const CheckDependencyUpdateTool = "Dependency-Update-Tool"
 func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.FileBased,
+		checker.GitHistoryBased,
 	}
 	if err := registerCheck(CheckDependencyUpdateTool, DependencyUpdateTool, supportedRequestTypes); err != nil {
 		// this should never happen
@@ -18,7 +18,10 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowCachePoisoning"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
 )
 
@@ -29,6 +32,9 @@ func DangerousWorkflow(name string,
 	expectedProbes := []string{
 		hasDangerousWorkflowScriptInjection.Probe,
 		hasDangerousWorkflowUntrustedCheckout.Probe,
+		hasDangerousWorkflowArtifactPoisoning.Probe,
+		hasDangerousWorkflowCachePoisoning.Probe,
+		hasDangerousWorkflowSelfHostedRunner.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -52,7 +58,7 @@ func DangerousWorkflow(name string,
 		}
 	}
 
-	if hasDWWithUntrustedCheckout(findings) || hasDWWithScriptInjection(findings) {
+	if hasDangerousPattern(findings) {
 		return checker.CreateMinScoreResult(name,
 			"dangerous workflow patterns detected")
 	}
@@ -61,7 +67,7 @@ func DangerousWorkflow(name string,
 		"no dangerous workflow patterns detected")
 }
 
-// Both probes return OutcomeNotApplicable, if there project has no workflows.
+// All probes return OutcomeNotApplicable, if there project has no workflows.
 func hasWorkflows(findings []finding.Finding) bool {
 	for i := range findings {
 		f := &findings[i]
@@ -72,25 +78,11 @@ func hasWorkflows(findings []finding.Finding) bool {
 	return true
 }
 
-func hasDWWithUntrustedCheckout(findings []finding.Finding) bool {
+// hasDangerousPattern returns whether any probe detected a dangerous pattern.
+func hasDangerousPattern(findings []finding.Finding) bool {
 	for i := range findings {
-		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowUntrustedCheckout.Probe {
-			if f.Outcome == finding.OutcomeTrue {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func hasDWWithScriptInjection(findings []finding.Finding) bool {
-	for i := range findings {
-		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowScriptInjection.Probe {
-			if f.Outcome == finding.OutcomeTrue {
-				return true
-			}
+		if findings[i].Outcome == finding.OutcomeTrue {
+			return true
 		}
 	}
 	return false
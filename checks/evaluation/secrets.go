@@ -0,0 +1,108 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedCloudAccessKeys"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedHighEntropyTokens"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedPrivateKeys"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanning"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanningPushProtection"
+)
+
+const (
+	// high-entropy tokens may not be secrets, so each one only costs a few points.
+	highEntropyTokenPenalty = 2
+	secretScanningPenalty   = 3
+	pushProtectionPenalty   = 1
+)
+
+// Secrets applies the score policy for the Secrets check.
+func Secrets(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		hasCommittedPrivateKeys.Probe,
+		hasCommittedCloudAccessKeys.Probe,
+		hasCommittedHighEntropyTokens.Probe,
+		hasSecretScanning.Probe,
+		hasSecretScanningPushProtection.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	var credentials, tokens int
+	settings := make(map[string]finding.Outcome)
+	for i := range findings {
+		f := &findings[i]
+		switch f.Probe {
+		case hasCommittedPrivateKeys.Probe, hasCommittedCloudAccessKeys.Probe:
+			if f.Outcome == finding.OutcomeTrue {
+				credentials++
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			}
+		case hasCommittedHighEntropyTokens.Probe:
+			if f.Outcome == finding.OutcomeTrue {
+				tokens++
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			}
+		case hasSecretScanning.Probe, hasSecretScanningPushProtection.Probe:
+			settings[f.Probe] = f.Outcome
+			switch f.Outcome {
+			case finding.OutcomeTrue:
+				checker.LogFinding(dl, f, checker.DetailInfo)
+			case finding.OutcomeFalse:
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			default:
+				checker.LogFinding(dl, f, checker.DetailDebug)
+			}
+		}
+	}
+
+	if credentials > 0 {
+		return checker.CreateMinScoreResult(name,
+			fmt.Sprintf("%d private or cloud access keys committed", credentials))
+	}
+
+	score := checker.MaxResultScore - tokens*highEntropyTokenPenalty
+	var reasons []string
+	if tokens > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d possible tokens committed", tokens))
+	} else {
+		reasons = append(reasons, "no secrets detected")
+	}
+	// Push protection can't be enabled without secret scanning.
+	switch {
+	case settings[hasSecretScanning.Probe] == finding.OutcomeFalse:
+		score -= secretScanningPenalty
+		reasons = append(reasons, "secret scanning is disabled")
+	case settings[hasSecretScanningPushProtection.Probe] == finding.OutcomeFalse:
+		score -= pushProtectionPenalty
+		reasons = append(reasons, "push protection is disabled")
+	}
+	score = max(score, checker.MinResultScore)
+
+	return checker.CreateResultWithScore(name, strings.Join(reasons, ", "), score)
+}
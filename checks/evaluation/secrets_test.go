@@ -0,0 +1,137 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedCloudAccessKeys"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedHighEntropyTokens"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedPrivateKeys"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanning"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanningPushProtection"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func secretsFindings(privateKeys, cloudKeys, tokens int, scanning, pushProtection finding.Outcome) []finding.Finding {
+	var findings []finding.Finding
+	committed := func(probe string, n int) {
+		if n == 0 {
+			findings = append(findings, finding.Finding{Probe: probe, Outcome: finding.OutcomeFalse})
+		}
+		for i := 0; i < n; i++ {
+			findings = append(findings, finding.Finding{
+				Probe:    probe,
+				Outcome:  finding.OutcomeTrue,
+				Location: &finding.Location{Path: "config.env"},
+			})
+		}
+	}
+	committed(hasCommittedPrivateKeys.Probe, privateKeys)
+	committed(hasCommittedCloudAccessKeys.Probe, cloudKeys)
+	committed(hasCommittedHighEntropyTokens.Probe, tokens)
+	return append(findings,
+		finding.Finding{Probe: hasSecretScanning.Probe, Outcome: scanning},
+		finding.Finding{Probe: hasSecretScanningPushProtection.Probe, Outcome: pushProtection},
+	)
+}
+
+func TestSecrets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name:     "no secrets and push protection",
+			findings: secretsFindings(0, 0, 0, finding.OutcomeTrue, finding.OutcomeTrue),
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 2,
+			},
+		},
+		{
+			name:     "no secrets and settings not available",
+			findings: secretsFindings(0, 0, 0, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable),
+			result: scut.TestReturn{
+				Score:         checker.MaxResultScore,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name:     "private key committed",
+			findings: secretsFindings(1, 0, 0, finding.OutcomeTrue, finding.OutcomeTrue),
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 1,
+				NumberOfInfo: 2,
+			},
+		},
+		{
+			name:     "cloud access keys committed",
+			findings: secretsFindings(0, 2, 1, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable),
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  3,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name:     "tokens committed",
+			findings: secretsFindings(0, 0, 2, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable),
+			result: scut.TestReturn{
+				Score:         6,
+				NumberOfWarn:  2,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name:     "secret scanning disabled",
+			findings: secretsFindings(0, 0, 0, finding.OutcomeFalse, finding.OutcomeFalse),
+			result: scut.TestReturn{
+				Score:        7,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name:     "push protection disabled",
+			findings: secretsFindings(0, 0, 0, finding.OutcomeTrue, finding.OutcomeFalse),
+			result: scut.TestReturn{
+				Score:        9,
+				NumberOfInfo: 1,
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name:     "many tokens",
+			findings: secretsFindings(0, 0, 6, finding.OutcomeFalse, finding.OutcomeFalse),
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 8,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := Secrets(tt.name, tt.findings, &dl)
+			scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl)
+		})
+	}
+}
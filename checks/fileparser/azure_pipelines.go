@@ -0,0 +1,44 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"path"
+	"strings"
+)
+
+// azurePipelinesDirs are the directories Azure Pipelines configurations are conventionally kept in.
+var azurePipelinesDirs = []string{".azure-pipelines", ".azuredevops"}
+
+// IsAzurePipelinesFile determines if a file is an Azure Pipelines configuration, or a
+// template of one: azure-pipelines*.yml files, and YAML files of the .azure-pipelines
+// and .azuredevops directories.
+func IsAzurePipelinesFile(pathfn string) (bool, error) {
+	ext := strings.ToLower(path.Ext(pathfn))
+	if ext != ".yml" && ext != ".yaml" {
+		return false, nil
+	}
+	if strings.HasPrefix(strings.ToLower(path.Base(pathfn)), "azure-pipelines") {
+		return true, nil
+	}
+	for _, dir := range strings.Split(path.Dir(pathfn), "/") {
+		for _, d := range azurePipelinesDirs {
+			if strings.EqualFold(dir, d) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+)
+
+func TestIsAzurePipelinesFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		pathfn string
+		want   bool
+	}{
+		{
+			name:   "root pipeline",
+			pathfn: "azure-pipelines.yml",
+			want:   true,
+		},
+		{
+			name:   "named pipeline",
+			pathfn: "build/azure-pipelines-release.yaml",
+			want:   true,
+		},
+		{
+			name:   "template directory",
+			pathfn: ".azure-pipelines/templates/jobs.yml",
+			want:   true,
+		},
+		{
+			name:   "azuredevops directory",
+			pathfn: ".azuredevops/build.yaml",
+			want:   true,
+		},
+		{
+			name:   "other yaml",
+			pathfn: "deploy/values.yaml",
+			want:   false,
+		},
+		{
+			name:   "not yaml",
+			pathfn: ".azure-pipelines/README.md",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := IsAzurePipelinesFile(tt.pathfn)
+			if err != nil {
+				t.Fatalf("IsAzurePipelinesFile: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsAzurePipelinesFile(%q) = %v, want %v", tt.pathfn, got, tt.want)
+			}
+		})
+	}
+}
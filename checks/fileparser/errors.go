@@ -20,5 +20,8 @@ import (
 
 var (
 	errInvalidGitHubWorkflow = errors.New("invalid GitHub workflow")
+	errInvalidGitLabCI       = errors.New("invalid GitLab CI configuration")
 	errInternalFilenameMatch = errors.New("filename match error")
+	errNotCompositeAction    = errors.New("not a composite action")
+	errNoRepoClient          = errors.New("no repo client")
 )
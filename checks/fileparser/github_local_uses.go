@@ -0,0 +1,252 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// maxLocalUsesDepth bounds how deep composite actions using other local actions are followed.
+const maxLocalUsesDepth = 10
+
+// CompositeAction is a composite action defined in the repository, used with `uses: ./path`.
+type CompositeAction struct {
+	// Path is the path of the metadata file of the action, e.g. `.github/actions/setup/action.yml`.
+	Path  string
+	Steps []*actionlint.Step
+}
+
+// LocalUsesPath returns the path in the repository of a local action or reusable workflow,
+// e.g. `.github/actions/setup` for `./.github/actions/setup`.
+func LocalUsesPath(uses string) (string, bool) {
+	if !strings.HasPrefix(uses, "./") {
+		return "", false
+	}
+	p := path.Clean(uses)
+	if p == "." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+	return p, true
+}
+
+// IsLocalReusableWorkflow returns true if `uses` references a reusable workflow of the repository.
+func IsLocalReusableWorkflow(uses string) bool {
+	p, ok := LocalUsesPath(uses)
+	return ok && IsWorkflowFile(p)
+}
+
+// LocalUsesResolver reads the composite actions and reusable workflows that workflows of the
+// repository use, so that analyses can follow them. Files are parsed once per resolver.
+type LocalUsesResolver struct {
+	client    clients.RepoClient
+	actions   map[string]*CompositeAction
+	workflows map[string]*actionlint.Workflow
+}
+
+// NewLocalUsesResolver returns a resolver reading files with the given client.
+func NewLocalUsesResolver(client clients.RepoClient) *LocalUsesResolver {
+	return &LocalUsesResolver{
+		client:    client,
+		actions:   make(map[string]*CompositeAction),
+		workflows: make(map[string]*actionlint.Workflow),
+	}
+}
+
+// CompositeAction returns the composite action used with `uses`. It returns nil if `uses`
+// isn't a local action, if the action can't be found, or if it isn't a composite action.
+func (r *LocalUsesResolver) CompositeAction(uses string) *CompositeAction {
+	dir, ok := LocalUsesPath(uses)
+	if !ok || IsWorkflowFile(dir) {
+		return nil
+	}
+	if action, ok := r.actions[dir]; ok {
+		return action
+	}
+	r.actions[dir] = nil
+	for _, name := range []string{"action.yml", "action.yaml"} {
+		p := path.Join(dir, name)
+		content, err := r.readFile(p)
+		if err != nil {
+			continue
+		}
+		steps, err := ParseCompositeAction(content)
+		if err != nil {
+			break
+		}
+		r.actions[dir] = &CompositeAction{Path: p, Steps: steps}
+		break
+	}
+	return r.actions[dir]
+}
+
+// ReusableWorkflow returns the reusable workflow called with `uses`, and its path. It returns
+// nil if `uses` isn't a local reusable workflow, or if the workflow can't be read.
+func (r *LocalUsesResolver) ReusableWorkflow(uses string) (*actionlint.Workflow, string) {
+	if !IsLocalReusableWorkflow(uses) {
+		return nil, ""
+	}
+	p, _ := LocalUsesPath(uses)
+	if workflow, ok := r.workflows[p]; ok {
+		return workflow, p
+	}
+	r.workflows[p] = nil
+	content, err := r.readFile(p)
+	if err != nil {
+		return nil, p
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return nil, p
+	}
+	r.workflows[p] = workflow
+	return workflow, p
+}
+
+func (r *LocalUsesResolver) readFile(p string) ([]byte, error) {
+	if r == nil || r.client == nil {
+		return nil, errNoRepoClient
+	}
+	reader, err := r.client.GetFileReader(p)
+	if err != nil {
+		return nil, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading from file: %w", err)
+	}
+	return content, nil
+}
+
+// WalkCompositeActions calls fn with the composite action each step uses, following the
+// composite actions those use in turn. Each action is visited once per call. fn also gets the
+// step using the action, and the path of the workflow or parent action declaring that step.
+func (r *LocalUsesResolver) WalkCompositeActions(path string, steps []*actionlint.Step,
+	fn func(callerPath string, step *actionlint.Step, action *CompositeAction),
+) {
+	r.walkCompositeActions(path, steps, make(map[string]bool), 0, fn)
+}
+
+func (r *LocalUsesResolver) walkCompositeActions(path string, steps []*actionlint.Step,
+	visited map[string]bool, depth int,
+	fn func(callerPath string, step *actionlint.Step, action *CompositeAction),
+) {
+	if depth >= maxLocalUsesDepth {
+		return
+	}
+	for _, step := range steps {
+		uses := GetUses(step)
+		if uses == nil {
+			continue
+		}
+		action := r.CompositeAction(uses.Value)
+		if action == nil || visited[action.Path] {
+			continue
+		}
+		visited[action.Path] = true
+		fn(path, step, action)
+		r.walkCompositeActions(action.Path, action.Steps, visited, depth+1, fn)
+	}
+}
+
+// ParseCompositeAction returns the steps of a composite action from its metadata file.
+// Steps are returned in the same form as those of workflows parsed by actionlint, so
+// analyses of workflow steps apply to them.
+func ParseCompositeAction(content []byte) ([]*actionlint.Step, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("parsing action metadata: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, errNotCompositeAction
+	}
+	runs := mappingValue(doc.Content[0], "runs")
+	using := mappingValue(runs, "using")
+	if using == nil || !strings.EqualFold(using.Value, "composite") {
+		return nil, errNotCompositeAction
+	}
+	stepsNode := mappingValue(runs, "steps")
+	if stepsNode == nil || stepsNode.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	steps := make([]*actionlint.Step, 0, len(stepsNode.Content))
+	for _, n := range stepsNode.Content {
+		if n.Kind != yaml.MappingNode {
+			continue
+		}
+		step := &actionlint.Step{
+			ID:   actionlintString(mappingValue(n, "id")),
+			If:   actionlintString(mappingValue(n, "if")),
+			Name: actionlintString(mappingValue(n, "name")),
+			Pos:  &actionlint.Pos{Line: n.Line, Col: n.Column},
+		}
+		if uses := mappingValue(n, "uses"); uses != nil {
+			exec := &actionlint.ExecAction{
+				Uses:   actionlintString(uses),
+				Inputs: make(map[string]*actionlint.Input),
+			}
+			if with := mappingValue(n, "with"); with != nil && with.Kind == yaml.MappingNode {
+				for i := 0; i+1 < len(with.Content); i += 2 {
+					exec.Inputs[strings.ToLower(with.Content[i].Value)] = &actionlint.Input{
+						Name:  actionlintString(with.Content[i]),
+						Value: actionlintString(with.Content[i+1]),
+					}
+				}
+			}
+			step.Exec = exec
+		} else if run := mappingValue(n, "run"); run != nil {
+			step.Exec = &actionlint.ExecRun{
+				Run:              actionlintString(run),
+				Shell:            actionlintString(mappingValue(n, "shell")),
+				WorkingDirectory: actionlintString(mappingValue(n, "working-directory")),
+				RunPos:           &actionlint.Pos{Line: run.Line, Col: run.Column},
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func actionlintString(n *yaml.Node) *actionlint.String {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return nil
+	}
+	return &actionlint.String{
+		Value:  n.Value,
+		Quoted: n.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle) != 0,
+		Pos:    &actionlint.Pos{Line: n.Line, Col: n.Column},
+	}
+}
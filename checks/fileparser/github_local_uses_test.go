@@ -0,0 +1,268 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rhysd/actionlint"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestLocalUsesPath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		uses string
+		want string
+		ok   bool
+	}{
+		{
+			name: "composite action",
+			uses: "./.github/actions/setup",
+			want: ".github/actions/setup",
+			ok:   true,
+		},
+		{
+			name: "reusable workflow",
+			uses: "./.github/workflows/build.yml",
+			want: ".github/workflows/build.yml",
+			ok:   true,
+		},
+		{
+			name: "trailing slash",
+			uses: "./tools/action/",
+			want: "tools/action",
+			ok:   true,
+		},
+		{
+			name: "root action",
+			uses: "./",
+		},
+		{
+			name: "outside the repository",
+			uses: "./../other",
+		},
+		{
+			name: "remote action",
+			uses: "actions/checkout@v4",
+		},
+		{
+			name: "docker action",
+			uses: "docker://alpine:3.20",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := LocalUsesPath(tt.uses)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("LocalUsesPath(%q) = %q, %v, want %q, %v", tt.uses, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestIsLocalReusableWorkflow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		uses string
+		want bool
+	}{
+		{uses: "./.github/workflows/build.yml", want: true},
+		{uses: "./.github/workflows/build.yaml", want: true},
+		{uses: "./.github/actions/setup", want: false},
+		{uses: "octo-org/repo/.github/workflows/build.yml@main", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uses, func(t *testing.T) {
+			t.Parallel()
+			if got := IsLocalReusableWorkflow(tt.uses); got != tt.want {
+				t.Errorf("IsLocalReusableWorkflow(%q) = %v, want %v", tt.uses, got, tt.want)
+			}
+		})
+	}
+}
+
+type compositeStep struct {
+	Uses   string
+	Inputs map[string]string
+	Run    string
+	Shell  string
+	Line   int
+}
+
+func summarizeSteps(steps []*actionlint.Step) []compositeStep {
+	summary := make([]compositeStep, 0, len(steps))
+	for _, step := range steps {
+		s := compositeStep{Line: step.Pos.Line}
+		switch exec := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			s.Uses = exec.Uses.Value
+			if len(exec.Inputs) > 0 {
+				s.Inputs = make(map[string]string)
+				for k, v := range exec.Inputs {
+					s.Inputs[k] = v.Value.Value
+				}
+			}
+		case *actionlint.ExecRun:
+			s.Run = exec.Run.Value
+			if exec.Shell != nil {
+				s.Shell = exec.Shell.Value
+			}
+		}
+		summary = append(summary, s)
+	}
+	return summary
+}
+
+func TestParseCompositeAction(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		want    []compositeStep
+		wantErr bool
+	}{
+		{
+			name: "composite action",
+			content: `name: setup
+inputs:
+  version:
+    required: true
+runs:
+  using: composite
+  steps:
+    - uses: actions/setup-go@v5
+      with:
+        go-version: ${{ inputs.version }}
+    - name: install
+      shell: bash
+      run: |
+        go install ./...
+`,
+			want: []compositeStep{
+				{
+					Uses:   "actions/setup-go@v5",
+					Inputs: map[string]string{"go-version": "${{ inputs.version }}"},
+					Line:   8,
+				},
+				{
+					Run:   "go install ./...\n",
+					Shell: "bash",
+					Line:  11,
+				},
+			},
+		},
+		{
+			name: "javascript action",
+			content: `name: js
+runs:
+  using: node20
+  main: index.js
+`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			content: "runs: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			steps, err := ParseCompositeAction([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCompositeAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, summarizeSteps(steps)); !tt.wantErr && diff != "" {
+				t.Errorf("ParseCompositeAction() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalUsesResolver(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		".github/actions/outer/action.yml": `runs:
+  using: composite
+  steps:
+    - uses: ./.github/actions/inner
+    - uses: ./.github/actions/outer
+`,
+		".github/actions/inner/action.yaml": `runs:
+  using: composite
+  steps:
+    - run: echo inner
+      shell: bash
+`,
+		".github/actions/js/action.yml": `runs:
+  using: node20
+  main: index.js
+`,
+		".github/workflows/reusable.yml": `on: workflow_call
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo build
+`,
+	}
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(p string) (io.ReadCloser, error) {
+		content, ok := files[p]
+		if !ok {
+			return nil, errInternalFilenameMatch
+		}
+		return io.NopCloser(strings.NewReader(content)), nil
+	}).AnyTimes()
+
+	r := NewLocalUsesResolver(mockRepo)
+	if action := r.CompositeAction("./.github/actions/js"); action != nil {
+		t.Errorf("CompositeAction() = %v for a javascript action", action)
+	}
+	if action := r.CompositeAction("./.github/actions/missing"); action != nil {
+		t.Errorf("CompositeAction() = %v for a missing action", action)
+	}
+	if workflow, p := r.ReusableWorkflow("./.github/workflows/reusable.yml"); workflow == nil ||
+		p != ".github/workflows/reusable.yml" {
+		t.Errorf("ReusableWorkflow() = %v, %q", workflow, p)
+	}
+
+	var visited []string
+	steps := []*actionlint.Step{
+		{Exec: &actionlint.ExecAction{Uses: &actionlint.String{Value: "./.github/actions/outer"}}},
+		{Exec: &actionlint.ExecAction{Uses: &actionlint.String{Value: "./.github/actions/inner"}}},
+	}
+	r.WalkCompositeActions(".github/workflows/ci.yml", steps,
+		func(callerPath string, _ *actionlint.Step, action *CompositeAction) {
+			visited = append(visited, callerPath+" -> "+action.Path)
+		})
+	want := []string{
+		".github/workflows/ci.yml -> .github/actions/outer/action.yml",
+		".github/actions/outer/action.yml -> .github/actions/inner/action.yaml",
+	}
+	if diff := cmp.Diff(want, visited); diff != "" {
+		t.Errorf("WalkCompositeActions() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/rhysd/actionlint"
@@ -36,8 +37,15 @@ const (
 	windows             = "windows"
 	os                  = "os"
 	matrixos            = "matrix.os"
+	// WorkflowFilesPattern matches the files of all workflow directories.
+	// It's meant for PathMatcher, with matches filtered by IsWorkflowFile.
+	WorkflowFilesPattern = "*/workflows/*"
 )
 
+// workflowDirs are the directories holding GitHub Actions workflows, including
+// those of Gitea Actions and Forgejo Actions, which use the same syntax.
+var workflowDirs = []string{".github/workflows", ".gitea/workflows", ".forgejo/workflows"}
+
 // GetJobName returns Name.Value if non-nil, else returns "".
 func GetJobName(job *actionlint.Job) string {
 	if job != nil && job.Name != nil {
@@ -300,13 +308,13 @@ func IsStepWindows(step *actionlint.Step) (bool, error) {
 	return false, nil
 }
 
-// IsWorkflowFile returns true if this is a GitHub workflow file.
+// IsWorkflowFile returns true if this is a GitHub, Gitea or Forgejo workflow file.
 func IsWorkflowFile(pathfn string) bool {
 	// From https://docs.github.com/en/actions/reference/workflow-syntax-for-github-actions:
 	// "Workflow files use YAML syntax, and must have either a .yml or .yaml file extension."
 	switch path.Ext(pathfn) {
 	case ".yml", ".yaml":
-		return filepath.Dir(strings.ToLower(pathfn)) == ".github/workflows"
+		return slices.Contains(workflowDirs, filepath.Dir(strings.ToLower(pathfn)))
 	default:
 		return false
 	}
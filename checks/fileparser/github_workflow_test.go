@@ -201,6 +201,27 @@ func TestIsWorkflowFile(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "forgejo",
+			args: args{
+				pathfn: "./testdata/.forgejo/workflows/build.yml",
+			},
+			want: true,
+		},
+		{
+			name: "gitea",
+			args: args{
+				pathfn: "./testdata/.gitea/workflows/build.yaml",
+			},
+			want: true,
+		},
+		{
+			name: "other workflows directory",
+			args: args{
+				pathfn: "./testdata/docs/workflows/build.yml",
+			},
+			want: false,
+		},
 	}
 
 	for _, tt := range tests {
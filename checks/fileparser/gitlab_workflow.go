@@ -14,8 +14,589 @@
 
 package fileparser
 
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// GitLabCIFile is the default path of the GitLab CI configuration.
+	GitLabCIFile = ".gitlab-ci.yml"
+
+	// maxGitLabCIIncludes is the number of files GitLab allows a configuration to include.
+	maxGitLabCIIncludes = 150
+	// maxGitLabCINesting is the depth GitLab flattens nested script lists to.
+	maxGitLabCINesting = 10
+)
+
+// gitlabCIKeywords are the top-level keys of a GitLab CI configuration which aren't jobs.
+var gitlabCIKeywords = map[string]bool{
+	"after_script":  true,
+	"before_script": true,
+	"cache":         true,
+	"default":       true,
+	"image":         true,
+	"include":       true,
+	"services":      true,
+	"spec":          true,
+	"stages":        true,
+	"variables":     true,
+	"workflow":      true,
+}
+
+// gitlabCIDefaults are the keywords jobs inherit from the configuration
+// when they don't set them. At the top level, they are deprecated in favor
+// of the default keyword.
+var gitlabCIDefaults = []string{"after_script", "before_script", "image", "services"}
+
 // IsGitlabWorkflowFile determines if a file is a workflow
 // as a callback to use for repo client's ListFiles() API.
 func IsGitlabWorkflowFile(pathfn string) (bool, error) {
 	return pathfn == "gitlabscorecard_flattened_ci.yaml", nil
 }
+
+// IsGitLabCIFile determines if a file is the GitLab CI configuration of
+// a repository, as a callback to use for repo client's ListFiles() API.
+func IsGitLabCIFile(pathfn string) (bool, error) {
+	return pathfn == GitLabCIFile, nil
+}
+
+// GitLabCIValue is a value of a GitLab CI configuration, with its location.
+type GitLabCIValue struct {
+	Value string
+	Path  string
+	Line  uint
+}
+
+// GitLabCIRule is one of the rules deciding whether a pipeline, or a job, runs.
+type GitLabCIRule struct {
+	// If is the condition of the rule, empty if the rule always matches.
+	If   GitLabCIValue
+	When string
+}
+
+// GitLabCIJob is a job of a GitLab CI pipeline, with its extends, anchors,
+// references and inherited defaults resolved.
+type GitLabCIJob struct {
+	Name string
+	Path string
+	// Script holds the commands of before_script, script and after_script.
+	Script []GitLabCIValue
+	// Commands holds the same commands as Script, with each entry kept whole,
+	// as the shell runs it. Lines are those of their first line.
+	Commands []GitLabCIValue
+	// Images holds the container images of the image and services keywords.
+	Images []GitLabCIValue
+	Tags   []GitLabCIValue
+	Rules  []GitLabCIRule
+	// Only holds the refs of the only keyword.
+	Only []GitLabCIValue
+	Line uint
+
+	keywords map[string]*yaml.Node
+}
+
+// Has reports whether the job sets keyword, directly or not.
+func (j *GitLabCIJob) Has(keyword string) bool {
+	_, ok := j.keywords[keyword]
+	return ok
+}
+
+// GitLabCIInclude is an include of a configuration from outside the repository.
+type GitLabCIInclude struct {
+	// Type is the keyword of the include: remote, project or component.
+	Type string
+	// Source is the URL, the project path or the component address.
+	Source GitLabCIValue
+	// Ref is the ref of a project, or the version of a component.
+	Ref       string
+	Integrity string
+}
+
+// GitLabCIPipeline is a GitLab CI configuration, merged with the local files it includes.
+type GitLabCIPipeline struct {
+	// WorkflowRules decide whether a pipeline runs.
+	WorkflowRules []GitLabCIRule
+	Jobs          []GitLabCIJob
+	// Includes holds the remote, project and component includes, which aren't followed.
+	Includes []GitLabCIInclude
+	// Templates holds the GitLab templates the configuration includes, e.g. `Jobs/SAST.gitlab-ci.yml`.
+	Templates []GitLabCIValue
+}
+
+type gitlabCIParser struct {
+	client   clients.RepoClient
+	included map[string]bool
+	// keys holds the top-level keys of the configuration, in order.
+	keys  map[string]*yaml.Node
+	order []string
+	// jobKeys locates the jobs, and paths the file of each node.
+	jobKeys   map[string]*yaml.Node
+	paths     map[*yaml.Node]string
+	includes  []GitLabCIInclude
+	templates []GitLabCIValue
+}
+
+// ParseGitLabCI parses the GitLab CI configuration at path, with the local files it
+// includes. Remote, project, template and component includes are not followed.
+func ParseGitLabCI(client clients.RepoClient, path string, content []byte) (*GitLabCIPipeline, error) {
+	p := gitlabCIParser{
+		client:   client,
+		included: map[string]bool{path: true},
+		keys:     map[string]*yaml.Node{},
+		jobKeys:  map[string]*yaml.Node{},
+		paths:    map[*yaml.Node]string{},
+	}
+	if err := p.parseFile(path, content); err != nil {
+		return nil, err
+	}
+	return p.pipeline(), nil
+}
+
+func (p *gitlabCIParser) parseFile(path string, content []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidGitLabCI, path, err)
+		}
+		p.locate(&doc, path)
+
+		pairs := gitlabCIPairs(&doc)
+		// Included files are merged first, so that the including file overrides them.
+		for _, kv := range pairs {
+			if kv.key.Value == "include" {
+				if err := p.include(kv.value); err != nil {
+					return err
+				}
+			}
+		}
+		for _, kv := range pairs {
+			name := kv.key.Value
+			if name == "include" || name == "spec" {
+				continue
+			}
+			if _, exists := p.keys[name]; !exists {
+				p.order = append(p.order, name)
+			}
+			p.keys[name] = p.merge(p.keys[name], kv.value)
+			p.jobKeys[name] = kv.key
+		}
+	}
+}
+
+func (p *gitlabCIParser) locate(n *yaml.Node, path string) {
+	p.paths[n] = path
+	for _, c := range n.Content {
+		p.locate(c, path)
+	}
+}
+
+func (p *gitlabCIParser) include(n *yaml.Node) error {
+	n = gitlabCIAlias(n)
+	switch n.Kind {
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			if err := p.include(c); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		if !strings.Contains(n.Value, "://") {
+			return p.includeLocal(n.Value)
+		}
+		p.includes = append(p.includes, GitLabCIInclude{Type: "remote", Source: p.value(n, n.Value, 0)})
+	case yaml.MappingNode:
+		if local := gitlabCILookup(n, "local"); local != nil && local.Kind == yaml.ScalarNode {
+			return p.includeLocal(local.Value)
+		}
+		if template := gitlabCIAlias(gitlabCILookup(n, "template")); template != nil && template.Kind == yaml.ScalarNode {
+			p.templates = append(p.templates, p.value(template, template.Value, 0))
+			return nil
+		}
+		p.includeExternal(n)
+	}
+	return nil
+}
+
+func (p *gitlabCIParser) includeExternal(n *yaml.Node) {
+	scalar := func(key string) *yaml.Node {
+		if v := gitlabCIAlias(gitlabCILookup(n, key)); v != nil && v.Kind == yaml.ScalarNode {
+			return v
+		}
+		return nil
+	}
+	for _, t := range []string{"remote", "project", "component"} {
+		source := scalar(t)
+		if source == nil {
+			continue
+		}
+		include := GitLabCIInclude{Type: t, Source: p.value(source, source.Value, 0)}
+		if ref := scalar("ref"); ref != nil {
+			include.Ref = ref.Value
+		}
+		if integrity := scalar("integrity"); integrity != nil {
+			include.Integrity = integrity.Value
+		}
+		if t == "component" {
+			if i := strings.LastIndex(source.Value, "@"); i >= 0 {
+				include.Ref = source.Value[i+1:]
+			}
+		}
+		p.includes = append(p.includes, include)
+		return
+	}
+}
+
+func (p *gitlabCIParser) includeLocal(pattern string) error {
+	pattern = strings.TrimPrefix(pattern, "/")
+	files := []string{pattern}
+	if strings.Contains(pattern, "*") {
+		re := gitlabCIGlob(pattern)
+		var err error
+		files, err = p.client.ListFiles(func(f string) (bool, error) {
+			return re.MatchString(f), nil
+		})
+		if err != nil {
+			return fmt.Errorf("error during ListFiles: %w", err)
+		}
+		sort.Strings(files)
+	}
+
+	for _, f := range files {
+		if p.included[f] || len(p.included) > maxGitLabCIIncludes {
+			continue
+		}
+		p.included[f] = true
+		// GitLab fails pipelines including missing files, but the
+		// rest of the configuration can still be analyzed.
+		reader, err := p.client.GetFileReader(f)
+		if err != nil {
+			continue
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("reading from file: %w", err)
+		}
+		if err := p.parseFile(f, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gitlabCIGlob returns a regular expression for an include pattern: * matches
+// within a directory, and ** across directories.
+func gitlabCIGlob(pattern string) *regexp.Regexp {
+	const anyDirs = "\x00"
+	quoted := regexp.QuoteMeta(strings.ReplaceAll(pattern, "**", anyDirs))
+	quoted = strings.ReplaceAll(quoted, anyDirs+"/", "(.*/)?")
+	quoted = strings.ReplaceAll(quoted, anyDirs, ".*")
+	quoted = strings.ReplaceAll(quoted, `\*`, "[^/]*")
+	return regexp.MustCompile("^" + quoted + "$")
+}
+
+// merge deep merges hashes, as GitLab does for includes and extends.
+// Other values are replaced.
+func (p *gitlabCIParser) merge(base, override *yaml.Node) *yaml.Node {
+	b, o := gitlabCIAlias(base), gitlabCIAlias(override)
+	if b == nil || o == nil || b.Kind != yaml.MappingNode || o.Kind != yaml.MappingNode {
+		return override
+	}
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: o.Line, Column: o.Column}
+	p.paths[merged] = p.paths[o]
+	overrides := map[string]*yaml.Node{}
+	for _, kv := range gitlabCIPairs(o) {
+		overrides[kv.key.Value] = kv.value
+	}
+	for _, kv := range gitlabCIPairs(b) {
+		if _, overridden := overrides[kv.key.Value]; !overridden {
+			merged.Content = append(merged.Content, kv.key, kv.value)
+		}
+	}
+	baseValues := map[string]*yaml.Node{}
+	for _, kv := range gitlabCIPairs(b) {
+		baseValues[kv.key.Value] = kv.value
+	}
+	for _, kv := range gitlabCIPairs(o) {
+		merged.Content = append(merged.Content, kv.key, p.merge(baseValues[kv.key.Value], kv.value))
+	}
+	return merged
+}
+
+func (p *gitlabCIParser) pipeline() *GitLabCIPipeline {
+	defaults := map[string]*yaml.Node{}
+	for _, keyword := range gitlabCIDefaults {
+		if n, ok := p.keys[keyword]; ok {
+			defaults[keyword] = n
+		}
+	}
+	for _, kv := range gitlabCIPairs(p.keys["default"]) {
+		defaults[kv.key.Value] = kv.value
+	}
+
+	pipeline := GitLabCIPipeline{
+		WorkflowRules: p.rules(gitlabCILookup(p.keys["workflow"], "rules")),
+		Includes:      p.includes,
+		Templates:     p.templates,
+	}
+	for _, name := range p.order {
+		// Hidden jobs are only templates for other jobs.
+		if gitlabCIKeywords[name] || strings.HasPrefix(name, ".") {
+			continue
+		}
+		keywords := p.resolveJob(name, map[string]bool{})
+		if keywords == nil {
+			continue
+		}
+		for keyword, n := range defaults {
+			if _, ok := keywords[keyword]; !ok && inheritsDefault(keywords["inherit"], keyword) {
+				keywords[keyword] = n
+			}
+		}
+
+		key := p.jobKeys[name]
+		job := GitLabCIJob{
+			Name:     name,
+			Path:     p.paths[key],
+			Line:     uint(key.Line),
+			Rules:    p.rules(keywords["rules"]),
+			keywords: keywords,
+		}
+		for _, keyword := range []string{"before_script", "script", "after_script"} {
+			job.Script = append(job.Script, p.values(keywords[keyword])...)
+			job.Commands = append(job.Commands, p.commands(keywords[keyword])...)
+		}
+		job.Images = append(p.images(keywords["image"]), p.images(keywords["services"])...)
+		job.Tags = p.values(keywords["tags"])
+		only := keywords["only"]
+		if refs := gitlabCILookup(only, "refs"); refs != nil {
+			only = refs
+		}
+		job.Only = p.values(only)
+		pipeline.Jobs = append(pipeline.Jobs, job)
+	}
+	return &pipeline
+}
+
+// resolveJob returns the keywords of a job, merged with those of the jobs it extends.
+func (p *gitlabCIParser) resolveJob(name string, extending map[string]bool) map[string]*yaml.Node {
+	n := gitlabCIAlias(p.keys[name])
+	if n == nil || n.Kind != yaml.MappingNode || extending[name] {
+		return nil
+	}
+	extending[name] = true
+	defer delete(extending, name)
+
+	keywords := map[string]*yaml.Node{}
+	for _, parent := range p.values(gitlabCILookup(n, "extends")) {
+		for keyword, v := range p.resolveJob(parent.Value, extending) {
+			keywords[keyword] = p.merge(keywords[keyword], v)
+		}
+	}
+	for _, kv := range gitlabCIPairs(n) {
+		keywords[kv.key.Value] = p.merge(keywords[kv.key.Value], kv.value)
+	}
+	delete(keywords, "extends")
+	return keywords
+}
+
+func inheritsDefault(inherit *yaml.Node, keyword string) bool {
+	d := gitlabCIAlias(gitlabCILookup(inherit, "default"))
+	switch {
+	case d == nil:
+		return true
+	case d.Kind == yaml.ScalarNode:
+		return d.Value != "false"
+	case d.Kind == yaml.SequenceNode:
+		for _, c := range d.Content {
+			if gitlabCIAlias(c).Value == keyword {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *gitlabCIParser) rules(n *yaml.Node) []GitLabCIRule {
+	var rules []GitLabCIRule
+	for _, r := range p.items(n, 0) {
+		if r.Kind != yaml.MappingNode {
+			continue
+		}
+		rule := GitLabCIRule{If: GitLabCIValue{Path: p.paths[r], Line: uint(r.Line)}}
+		if cond := gitlabCIAlias(gitlabCILookup(r, "if")); cond != nil {
+			rule.If = p.value(cond, cond.Value, 0)
+		}
+		if when := gitlabCIAlias(gitlabCILookup(r, "when")); when != nil {
+			rule.When = when.Value
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// values returns the scalars of a value, one per line of block scalars.
+func (p *gitlabCIParser) values(n *yaml.Node) []GitLabCIValue {
+	var values []GitLabCIValue
+	for _, item := range p.items(n, 0) {
+		if item.Kind != yaml.ScalarNode {
+			continue
+		}
+		if item.Style != yaml.LiteralStyle {
+			values = append(values, p.value(item, item.Value, 0))
+			continue
+		}
+		// Literal block scalars start on the line after their indicator.
+		for i, line := range strings.Split(strings.TrimRight(item.Value, "\n"), "\n") {
+			if strings.TrimSpace(line) != "" {
+				values = append(values, p.value(item, line, uint(i+1)))
+			}
+		}
+	}
+	return values
+}
+
+// commands returns the scalars of a value, located at their first line.
+func (p *gitlabCIParser) commands(n *yaml.Node) []GitLabCIValue {
+	var commands []GitLabCIValue
+	for _, item := range p.items(n, 0) {
+		if item.Kind != yaml.ScalarNode {
+			continue
+		}
+		// Block scalars start on the line after their indicator.
+		var offset uint
+		if item.Style == yaml.LiteralStyle || item.Style == yaml.FoldedStyle {
+			offset = 1
+		}
+		commands = append(commands, p.value(item, item.Value, offset))
+	}
+	return commands
+}
+
+// images returns the names of the images of an image or services keyword,
+// which are either strings or hashes with a name.
+func (p *gitlabCIParser) images(n *yaml.Node) []GitLabCIValue {
+	var images []GitLabCIValue
+	for _, item := range p.items(n, 0) {
+		if item.Kind == yaml.MappingNode {
+			item = gitlabCIAlias(gitlabCILookup(item, "name"))
+		}
+		if item != nil && item.Kind == yaml.ScalarNode && item.Value != "" {
+			images = append(images, p.value(item, item.Value, 0))
+		}
+	}
+	return images
+}
+
+func (p *gitlabCIParser) value(n *yaml.Node, value string, offset uint) GitLabCIValue {
+	return GitLabCIValue{Value: value, Path: p.paths[n], Line: uint(n.Line) + offset}
+}
+
+// items returns the elements of a sequence, flattening nested sequences and
+// resolving references, as GitLab does. Other values are their only element.
+func (p *gitlabCIParser) items(n *yaml.Node, depth int) []*yaml.Node {
+	n = gitlabCIAlias(n)
+	if n == nil || depth > maxGitLabCINesting {
+		return nil
+	}
+	if n.Tag == "!reference" {
+		return p.items(p.reference(n), depth+1)
+	}
+	if n.Kind != yaml.SequenceNode {
+		return []*yaml.Node{n}
+	}
+	var items []*yaml.Node
+	for _, c := range n.Content {
+		items = append(items, p.items(c, depth+1)...)
+	}
+	return items
+}
+
+// reference resolves a !reference [job, keyword, ...] tag.
+func (p *gitlabCIParser) reference(n *yaml.Node) *yaml.Node {
+	if len(n.Content) == 0 {
+		return nil
+	}
+	target := p.keys[gitlabCIAlias(n.Content[0]).Value]
+	for _, key := range n.Content[1:] {
+		target = gitlabCILookup(target, gitlabCIAlias(key).Value)
+	}
+	return target
+}
+
+type gitlabCIPair struct {
+	key, value *yaml.Node
+}
+
+// gitlabCIPairs returns the entries of a mapping, including those merged with
+// the << key. Explicit entries override merged ones.
+func gitlabCIPairs(n *yaml.Node) []gitlabCIPair {
+	n = gitlabCIAlias(n)
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	var explicit, merged []gitlabCIPair
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+		if k.Tag != "!!merge" {
+			explicit = append(explicit, gitlabCIPair{key: k, value: v})
+			continue
+		}
+		if v = gitlabCIAlias(v); v.Kind == yaml.SequenceNode {
+			for _, m := range v.Content {
+				merged = append(merged, gitlabCIPairs(m)...)
+			}
+		} else {
+			merged = append(merged, gitlabCIPairs(v)...)
+		}
+	}
+
+	seen := map[string]bool{}
+	pairs := make([]gitlabCIPair, 0, len(explicit)+len(merged))
+	for _, kv := range append(explicit, merged...) {
+		if !seen[kv.key.Value] {
+			seen[kv.key.Value] = true
+			pairs = append(pairs, kv)
+		}
+	}
+	return pairs
+}
+
+func gitlabCILookup(n *yaml.Node, key string) *yaml.Node {
+	for _, kv := range gitlabCIPairs(n) {
+		if kv.key.Value == key {
+			return kv.value
+		}
+	}
+	return nil
+}
+
+// gitlabCIAlias returns the node an alias, or a document, stands for.
+func gitlabCIAlias(n *yaml.Node) *yaml.Node {
+	for n != nil {
+		switch {
+		case n.Kind == yaml.AliasNode:
+			n = n.Alias
+		case n.Kind == yaml.DocumentNode && len(n.Content) > 0:
+			n = n.Content[0]
+		default:
+			return n
+		}
+	}
+	return nil
+}
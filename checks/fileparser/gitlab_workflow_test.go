@@ -0,0 +1,45 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+)
+
+func Test_gitlabCIGlob(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "ci/*.yml", path: "ci/build.yml", want: true},
+		{pattern: "ci/*.yml", path: "ci/jobs/build.yml", want: false},
+		{pattern: "ci/**.yml", path: "ci/jobs/build.yml", want: true},
+		{pattern: "ci/**/*.yml", path: "ci/build.yml", want: true},
+		{pattern: "ci/**/*.yml", path: "ci/jobs/deploy/build.yml", want: true},
+		{pattern: "ci/*.yml", path: "ci/build.yaml", want: false},
+		{pattern: "ci.yml", path: "ci_yml", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			t.Parallel()
+			if got := gitlabCIGlob(tt.pattern).MatchString(tt.path); got != tt.want {
+				t.Errorf("gitlabCIGlob(%q).MatchString(%q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -50,11 +50,20 @@ func isMatchingPath(fullpath string, matchPathTo PathMatcher) (bool, error) {
 	return match, nil
 }
 
-func isTestdataFile(fullpath string) bool {
+// IsTestdataFile returns whether a file is in a test data directory.
+func IsTestdataFile(fullpath string) bool {
+	return isTestdataDirFile(fullpath) || isTestSourcesFile(fullpath)
+}
+
+func isTestdataDirFile(fullpath string) bool {
 	// testdata/ or /some/dir/testdata/some/other
 	return strings.HasPrefix(fullpath, "testdata/") ||
-		strings.Contains(fullpath, "/testdata/") ||
-		strings.HasPrefix(fullpath, "src/test/") ||
+		strings.Contains(fullpath, "/testdata/")
+}
+
+// isTestSourcesFile returns whether a file is in the test sources of a Maven or Gradle project.
+func isTestSourcesFile(fullpath string) bool {
+	return strings.HasPrefix(fullpath, "src/test/") ||
 		strings.Contains(fullpath, "/src/test/")
 }
 
@@ -62,6 +71,9 @@ func isTestdataFile(fullpath string) bool {
 type PathMatcher struct {
 	Pattern       string
 	CaseSensitive bool
+	// TestSources includes the files of src/test directories, which are
+	// otherwise filtered out with test data.
+	TestSources bool
 }
 
 // DoWhileTrueOnFileReader takes a filepath, its reader and
@@ -99,7 +111,7 @@ func onMatchingFileDo(repoClient clients.RepoClient, matchPathTo PathMatcher,
 ) error {
 	predicate := func(filepath string) (bool, error) {
 		// Filter out test files.
-		if isTestdataFile(filepath) {
+		if isTestdataDirFile(filepath) || (!matchPathTo.TestSources && isTestSourcesFile(filepath)) {
 			return false, nil
 		}
 		// Filter out files based on path/names using the pattern.
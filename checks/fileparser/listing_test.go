@@ -332,7 +332,7 @@ func Test_isMatchingPath(t *testing.T) {
 	}
 }
 
-func Test_isTestdataFile(t *testing.T) {
+func Test_IsTestdataFile(t *testing.T) {
 	t.Parallel()
 	type args struct {
 		fullpath string
@@ -389,8 +389,8 @@ func Test_isTestdataFile(t *testing.T) {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			if got := isTestdataFile(tt.args.fullpath); got != tt.want {
-				t.Errorf("isTestdataFile() = %v, want %v", got, tt.want)
+			if got := IsTestdataFile(tt.args.fullpath); got != tt.want {
+				t.Errorf("IsTestdataFile() = %v, want %v", got, tt.want)
 			}
 		})
 	}
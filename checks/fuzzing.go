@@ -30,6 +30,7 @@ const CheckFuzzing = "Fuzzing"
 func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.FileBased,
+		checker.GitHistoryBased,
 	}
 	if err := registerCheck(CheckFuzzing, Fuzzing, supportedRequestTypes); err != nil {
 		// this should never happen
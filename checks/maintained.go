@@ -28,7 +28,10 @@ const CheckMaintained = "Maintained"
 
 //nolint:gochecknoinits
 func init() {
-	if err := registerCheck(CheckMaintained, Maintained, nil); err != nil {
+	supportedRequestTypes := []checker.RequestType{
+		checker.GitHistoryBased,
+	}
+	if err := registerCheck(CheckMaintained, Maintained, supportedRequestTypes); err != nil {
 		// this should never happen
 		panic(err)
 	}
@@ -47,6 +47,31 @@ func TestGithubTokenPermissions(t *testing.T) {
 				NumberOfDebug: 5,
 			},
 		},
+		{
+			name: "reusable workflow restricted by its caller",
+			filenames: []string{
+				"./testdata/.github/workflows/github-workflow-permissions-reusable-caller.yaml",
+				"./testdata/.github/workflows/github-workflow-permissions-reusable-callee.yaml",
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         checker.MaxResultScore,
+				NumberOfWarn:  0,
+				NumberOfInfo:  2,
+				NumberOfDebug: 10,
+			},
+		},
+		{
+			name:      "reusable workflow without caller",
+			filenames: []string{"./testdata/.github/workflows/github-workflow-permissions-reusable-callee.yaml"},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  1,
+				NumberOfInfo:  1,
+				NumberOfDebug: 5,
+			},
+		},
 		{
 			name:      "run workflow no codeql write test",
 			filenames: []string{"./testdata/.github/workflows/github-workflow-permissions-run-no-codeql-write.yaml"},
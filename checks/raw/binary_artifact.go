@@ -15,6 +15,9 @@
 package raw
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -36,17 +39,61 @@ import (
 // how many bytes are considered when determining if a file is text or binary.
 const binaryTestLen = 1024
 
+// maxWrapperChecksumSize is the largest file declaring the checksum of a wrapper jar
+// which is read.
+const maxWrapperChecksumSize = 64 << 10
+
+// The jar of the Maven wrapper is verified when its digest is the wrapperSha256Sum of
+// the properties next to it, which mvnw checks before running it, and the digest of an
+// official maven-wrapper release. Other wrappers don't check their jar, so a digest
+// committed next to it proves nothing.
+const (
+	mavenWrapperDir         = ".mvn/wrapper/"
+	mavenWrapperJar         = "maven-wrapper.jar"
+	mavenWrapperProperties  = "maven-wrapper.properties"
+	mavenWrapperChecksumKey = "wrapperSha256Sum"
+)
+
+// officialMavenWrapperDigests are the SHA-256 digests of the maven-wrapper jars released
+// by Apache Maven, as published next to them on Maven Central
+// (org/apache/maven/wrapper/maven-wrapper/<version>/maven-wrapper-<version>.jar.sha256),
+// by version. A jar committed with its own checksum could be anything, so only these
+// are verified.
+var officialMavenWrapperDigests = map[string]string{}
+
+// binaryArtifactScan collects the binary artifacts of a repository, with what's needed
+// to verify its wrapper jars.
+type binaryArtifactScan struct {
+	// digests are the SHA-256 digests of the Maven wrapper jars, by path.
+	digests map[string]string
+	// checksums are the declared SHA-256 digests of Maven wrapper jars, by path.
+	checksums map[string]string
+	files     []checker.File
+}
+
 // BinaryArtifacts retrieves the raw data for the Binary-Artifacts check.
 func BinaryArtifacts(req *checker.CheckRequest) (checker.BinaryArtifactData, error) {
 	c := req.RepoClient
-	files := []checker.File{}
+	scan := binaryArtifactScan{
+		digests:   map[string]string{},
+		checksums: map[string]string{},
+		files:     []checker.File{},
+	}
 	err := fileparser.OnMatchingFileReaderDo(c, fileparser.PathMatcher{
 		Pattern:       "*",
 		CaseSensitive: false,
-	}, checkBinaryFileReader, &files)
+	}, checkBinaryFileReader, &scan)
 	if err != nil {
 		return checker.BinaryArtifactData{}, fmt.Errorf("%w", err)
 	}
+	files := scan.files
+	// Official wrapper jars matching their declared checksum are verified.
+	for i := range files {
+		digest, ok := scan.digests[files[i].Path]
+		if ok && strings.EqualFold(digest, scan.checksums[files[i].Path]) && isOfficialMavenWrapper(digest) {
+			files[i].Type = finding.FileTypeBinaryVerified
+		}
+	}
 	// Ignore validated gradle-wrapper.jar files if present
 	files, err = excludeValidatedGradleWrappers(c, files)
 	if err != nil {
@@ -91,10 +138,10 @@ var checkBinaryFileReader fileparser.DoWhileTrueOnFileReader = func(path string,
 		return false, fmt.Errorf(
 			"checkBinaryFileReader requires exactly one argument: %w", errInvalidArgLength)
 	}
-	pfiles, ok := args[0].(*[]checker.File)
+	scan, ok := args[0].(*binaryArtifactScan)
 	if !ok {
 		return false, fmt.Errorf(
-			"checkBinaryFileReader requires argument of type *[]checker.File: %w", errInvalidArgType)
+			"checkBinaryFileReader requires argument of type *binaryArtifactScan: %w", errInvalidArgType)
 	}
 
 	binaryFileTypes := map[string]bool{
@@ -127,6 +174,17 @@ var checkBinaryFileReader fileparser.DoWhileTrueOnFileReader = func(path string,
 		"whl":    true,
 	}
 
+	if dir, ok := mavenWrapperFile(path, mavenWrapperProperties); ok {
+		content, err := io.ReadAll(io.LimitReader(reader, maxWrapperChecksumSize))
+		if err != nil {
+			return false, fmt.Errorf("reading file: %w", err)
+		}
+		if sum := parseWrapperChecksum(content, mavenWrapperChecksumKey); sum != "" {
+			scan.checksums[dir+mavenWrapperJar] = sum
+		}
+		return true, nil
+	}
+
 	content, err := io.ReadAll(io.LimitReader(reader, binaryTestLen))
 	if err != nil {
 		return false, fmt.Errorf("reading file: %w", err)
@@ -140,28 +198,78 @@ var checkBinaryFileReader fileparser.DoWhileTrueOnFileReader = func(path string,
 		return false, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("filetype.Get:%v", err))
 	}
 
-	exists1 := binaryFileTypes[t.Extension]
-	if exists1 {
-		*pfiles = append(*pfiles, checker.File{
-			Path:   path,
-			Type:   finding.FileTypeBinary,
-			Offset: checker.OffsetDefault,
-		})
-		return true, nil
+	// Archives are checked for executables, and wrapper jars against their checksum,
+	// unless they're too large to read.
+	_, wrapper := mavenWrapperFile(path, mavenWrapperJar)
+	var full []byte
+	if wrapper || isArchive(content) {
+		full, err = io.ReadAll(io.LimitReader(io.MultiReader(bytes.NewReader(content), reader), maxArchiveScanSize+1))
+		if err != nil {
+			return false, fmt.Errorf("reading file: %w", err)
+		}
+		if len(full) > maxArchiveScanSize {
+			full = nil
+		}
+	}
+	format, arch := binaryFormat(content)
+	if format == "" && full != nil && isArchive(content) {
+		format, arch = archiveFormat(full)
+	}
+	if wrapper && full != nil {
+		digest := sha256.Sum256(full)
+		scan.digests[path] = hex.EncodeToString(digest[:])
 	}
 
+	exists1 := binaryFileTypes[t.Extension]
 	exists2 := binaryFileTypes[strings.ReplaceAll(filepath.Ext(path), ".", "")]
-	if !isText(content) && exists2 {
-		*pfiles = append(*pfiles, checker.File{
+	if format != "" || exists1 || (!isText(content) && exists2) {
+		scan.files = append(scan.files, checker.File{
 			Path:   path,
 			Type:   finding.FileTypeBinary,
 			Offset: checker.OffsetDefault,
+			Format: format,
+			Arch:   arch,
 		})
 	}
 
 	return true, nil
 }
 
+// mavenWrapperFile returns whether p is the file name of a Maven wrapper, i.e. in a
+// .mvn/wrapper directory, with the directory.
+func mavenWrapperFile(p, name string) (string, bool) {
+	dir, ok := strings.CutSuffix(p, name)
+	if !ok || (dir != mavenWrapperDir && !strings.HasSuffix(dir, "/"+mavenWrapperDir)) {
+		return "", false
+	}
+	return dir, true
+}
+
+// isOfficialMavenWrapper returns whether digest is the SHA-256 digest of an official
+// maven-wrapper jar.
+func isOfficialMavenWrapper(digest string) bool {
+	_, ok := officialMavenWrapperDigests[strings.ToLower(digest)]
+	return ok
+}
+
+// parseWrapperChecksum returns the SHA-256 digest declared under key in a properties file.
+func parseWrapperChecksum(content []byte, key string) string {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			k, v, ok = strings.Cut(line, ":")
+		}
+		if ok && strings.TrimSpace(k) == key {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
 // determines if the first binaryTestLen bytes are text
 //
 //	A version of golang.org/x/tools/godoc/util modified to allow carriage returns
@@ -189,7 +297,7 @@ func isText(s []byte) bool {
 func gradleWrapperValidated(c clients.RepoClient) (bool, error) {
 	gradleWrapperValidatingWorkflowFile := ""
 	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: false,
 	}, checkWorkflowValidatesGradleWrapper, &gradleWrapperValidatingWorkflowFile)
 	if err != nil {
@@ -235,6 +343,11 @@ func checkWorkflowValidatesGradleWrapper(path string, content []byte, args ...in
 		return false, fmt.Errorf("checkWorkflowValidatesGradleWrapper expects arg[0] of type *string: %w", errInvalidArgType)
 	}
 
+	// Workflows are YAML files, other files of workflow directories don't run.
+	if ext := filepath.Ext(path); ext != ".yml" && ext != ".yaml" {
+		return true, nil
+	}
+
 	action, errs := actionlint.Parse(content)
 	if len(errs) > 0 || action == nil {
 		// Parse fail, so not this file.
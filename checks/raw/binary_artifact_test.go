@@ -20,10 +20,12 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
 
@@ -140,6 +142,16 @@ func TestBinaryArtifacts(t *testing.T) {
 			getFileContentCount: 2,
 			expect:              1,
 		},
+		{
+			name: "gradle-wrapper.jar with verification action in a non-YAML file",
+			err:  nil,
+			files: [][]string{
+				{"../testdata/binaryartifacts/jars/gradle-wrapper.jar"},
+				{"../testdata/binaryartifacts/workflows/verify.txt"},
+			},
+			getFileContentCount: 2,
+			expect:              1,
+		},
 		{
 			name: "gradle-wrapper.jar with verification-failing commit",
 			err:  nil,
@@ -306,3 +318,66 @@ func TestBinaryArtifacts_workflow_runs_unsupported(t *testing.T) {
 		t.Errorf("expected 1 file, got %d", len(got.Files))
 	}
 }
+
+//nolint:paralleltest // Since officialMavenWrapperDigests is modified.
+func TestBinaryArtifacts_officialMavenWrapper(t *testing.T) {
+	// The digest of the test jar, as if it were of an official release.
+	const digest = "fcee86352bb87d1a5983fb85385ce3933f5f7b6d30760470ac2755f91fbedbb8"
+	officialMavenWrapperDigests[digest] = "test"
+	defer delete(officialMavenWrapperDigests, digest)
+
+	c := &checker.CheckRequest{
+		RepoClient: mockRepoFiles(t, "testdata/binary-artifacts"),
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	got, err := BinaryArtifacts(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verified := map[string]bool{}
+	for _, f := range got.Files {
+		verified[f.Path] = f.Type == finding.FileTypeBinaryVerified
+	}
+	// mvnw only runs the jar in .mvn/wrapper, and checks it against wrapperSha256Sum.
+	want := map[string]bool{
+		".mvn/wrapper/maven-wrapper.jar":    true,
+		"bin/tool":                          false,
+		"dist/plugins.zip":                  false,
+		"gradle/wrapper/gradle-wrapper.jar": false,
+		"lib/App.dll":                       false,
+		"tools/wrapper/maven-wrapper.jar":   false,
+	}
+	if diff := cmp.Diff(want, verified); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBinaryArtifacts_formatsAndWrappers(t *testing.T) {
+	t.Parallel()
+	mockRepoClient := mockRepoFiles(t, "testdata/binary-artifacts")
+	c := &checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	got, err := BinaryArtifacts(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binary := func(path string, typ finding.FileType, format checker.BinaryFormat, arch string) checker.File {
+		return checker.File{Path: path, Type: typ, Offset: checker.OffsetDefault, Format: format, Arch: arch}
+	}
+	want := []checker.File{
+		// The digest of the Maven wrapper is wrapperSha256Sum, but not of an official release.
+		binary(".mvn/wrapper/maven-wrapper.jar", finding.FileTypeBinary, checker.BinaryFormatJAR, ""),
+		binary("bin/tool", finding.FileTypeBinary, checker.BinaryFormatELF, "amd64"),
+		binary("dist/plugins.zip", finding.FileTypeBinary, checker.BinaryFormatArchive, "arm64"),
+		// Nothing checks the digest of the Gradle wrapper in gradle-wrapper.jar.sha256.
+		binary("gradle/wrapper/gradle-wrapper.jar", finding.FileTypeBinary, checker.BinaryFormatJAR, ""),
+		binary("lib/App.dll", finding.FileTypeBinary, checker.BinaryFormatDotNet, "386"),
+		// mvnw only runs the jar in .mvn/wrapper.
+		binary("tools/wrapper/maven-wrapper.jar", finding.FileTypeBinary, checker.BinaryFormatJAR, ""),
+	}
+	if diff := cmp.Diff(want, got.Files); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
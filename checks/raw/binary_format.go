@@ -0,0 +1,268 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+const (
+	// maxArchiveScanSize is the largest archive whose entries are checked for executables.
+	maxArchiveScanSize = 64 << 20
+	// maxArchiveEntries is the number of entries of an archive which are checked.
+	maxArchiveEntries = 1000
+)
+
+// elfArchs maps ELF machines to GOARCH names.
+var elfArchs = map[elf.Machine]string{
+	elf.EM_386:       "386",
+	elf.EM_X86_64:    "amd64",
+	elf.EM_ARM:       "arm",
+	elf.EM_AARCH64:   "arm64",
+	elf.EM_PPC:       "ppc",
+	elf.EM_PPC64:     "ppc64",
+	elf.EM_S390:      "s390x",
+	elf.EM_MIPS:      "mips",
+	elf.EM_RISCV:     "riscv",
+	elf.EM_LOONGARCH: "loong64",
+}
+
+// peArchs maps PE machines to GOARCH names.
+var peArchs = map[uint16]string{
+	pe.IMAGE_FILE_MACHINE_I386:  "386",
+	pe.IMAGE_FILE_MACHINE_AMD64: "amd64",
+	pe.IMAGE_FILE_MACHINE_ARMNT: "arm",
+	pe.IMAGE_FILE_MACHINE_ARM64: "arm64",
+}
+
+// machoArchs maps Mach-O CPU types to GOARCH names.
+var machoArchs = map[macho.Cpu]string{
+	macho.Cpu386:   "386",
+	macho.CpuAmd64: "amd64",
+	macho.CpuArm:   "arm",
+	macho.CpuArm64: "arm64",
+	macho.CpuPpc:   "ppc",
+	macho.CpuPpc64: "ppc64",
+}
+
+// binaryFormat identifies executable code from the magic bytes at the start of a file,
+// and returns its format and architecture, or an empty format if it isn't executable.
+func binaryFormat(content []byte) (checker.BinaryFormat, string) {
+	switch {
+	case bytes.HasPrefix(content, []byte(elf.ELFMAG)):
+		return checker.BinaryFormatELF, elfArch(content)
+	case bytes.HasPrefix(content, []byte("MZ")):
+		return peFormat(content)
+	case bytes.HasPrefix(content, []byte{0xca, 0xfe, 0xba, 0xbe}):
+		// Fat Mach-O files and Java classes share a magic. The next word is the number
+		// of architectures of the former, and the version of the latter, at least 45.
+		if len(content) >= 8 && binary.BigEndian.Uint32(content[4:]) >= 45 {
+			return checker.BinaryFormatJavaClass, ""
+		}
+		return checker.BinaryFormatMachO, fatMachOArch(content)
+	case len(content) >= 8 && isMachOMagic(binary.BigEndian.Uint32(content)),
+		len(content) >= 8 && isMachOMagic(binary.LittleEndian.Uint32(content)):
+		return checker.BinaryFormatMachO, machOArch(content)
+	case bytes.HasPrefix(content, []byte("\x00asm\x01\x00\x00\x00")):
+		return checker.BinaryFormatWASM, "wasm"
+	case len(content) >= 8 && bytes.HasPrefix(content, []byte("dex\n")) && content[7] == 0:
+		return checker.BinaryFormatDEX, ""
+	case isPyc(content):
+		return checker.BinaryFormatPyc, ""
+	}
+	return "", ""
+}
+
+func elfArch(content []byte) string {
+	if len(content) < 20 {
+		return ""
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if elf.Data(content[elf.EI_DATA]) == elf.ELFDATA2MSB {
+		order = binary.BigEndian
+	}
+	machine := elf.Machine(order.Uint16(content[18:]))
+	arch := elfArchs[machine]
+	switch {
+	case arch == "riscv" && elf.Class(content[elf.EI_CLASS]) == elf.ELFCLASS64:
+		return "riscv64"
+	case (arch == "ppc64" || arch == "mips") && order == binary.LittleEndian:
+		return arch + "le"
+	}
+	return arch
+}
+
+// peFormat tells PE executables from .NET assemblies, which have a CLR runtime header.
+func peFormat(content []byte) (checker.BinaryFormat, string) {
+	if len(content) < 0x40 {
+		return "", ""
+	}
+	off := int(binary.LittleEndian.Uint32(content[0x3c:]))
+	if off+24 > len(content) || !bytes.Equal(content[off:off+4], []byte("PE\x00\x00")) {
+		return "", ""
+	}
+	arch := peArchs[binary.LittleEndian.Uint16(content[off+4:])]
+	opt := content[off+24:]
+	// The number of data directories and the directories follow the fields of the
+	// optional header, which are wider in PE32+.
+	var dirs int
+	switch {
+	case len(opt) >= 2 && binary.LittleEndian.Uint16(opt) == 0x10b:
+		dirs = 92
+	case len(opt) >= 2 && binary.LittleEndian.Uint16(opt) == 0x20b:
+		dirs = 108
+	default:
+		return checker.BinaryFormatPE, arch
+	}
+	clr := dirs + 4 + pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR*8
+	if len(opt) >= clr+4 && binary.LittleEndian.Uint32(opt[dirs:]) > pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR &&
+		binary.LittleEndian.Uint32(opt[clr:]) != 0 {
+		return checker.BinaryFormatDotNet, arch
+	}
+	return checker.BinaryFormatPE, arch
+}
+
+func isMachOMagic(magic uint32) bool {
+	return magic == macho.Magic32 || magic == macho.Magic64
+}
+
+func machOArch(content []byte) string {
+	var order binary.ByteOrder = binary.BigEndian
+	if isMachOMagic(binary.LittleEndian.Uint32(content)) {
+		order = binary.LittleEndian
+	}
+	return machoArchs[macho.Cpu(order.Uint32(content[4:]))]
+}
+
+// fatMachOArch returns the architectures of a universal binary, e.g. "amd64,arm64".
+func fatMachOArch(content []byte) string {
+	if len(content) < 8 {
+		return ""
+	}
+	var archs []string
+	n := int(binary.BigEndian.Uint32(content[4:]))
+	for i := 0; i < n; i++ {
+		// fat_arch entries are 5 words, the first one being the CPU type.
+		off := 8 + i*20
+		if off+4 > len(content) {
+			break
+		}
+		if arch := machoArchs[macho.Cpu(binary.BigEndian.Uint32(content[off:]))]; arch != "" && !slices.Contains(archs, arch) {
+			archs = append(archs, arch)
+		}
+	}
+	return strings.Join(archs, ",")
+}
+
+// isPyc returns whether content starts with the magic of a Python 2.7 or 3 bytecode file,
+// a version-specific number followed by "\r\n".
+func isPyc(content []byte) bool {
+	if len(content) < 16 || !bytes.Equal(content[2:4], []byte("\r\n")) || isText(content) {
+		return false
+	}
+	magic := binary.LittleEndian.Uint16(content)
+	return magic == 62211 || (magic >= 3000 && magic < 4000)
+}
+
+// isArchive returns whether content starts with the magic of a zip, gzip or tar archive.
+func isArchive(content []byte) bool {
+	return bytes.HasPrefix(content, []byte("PK\x03\x04")) ||
+		bytes.HasPrefix(content, []byte{0x1f, 0x8b}) ||
+		(len(content) >= 262 && bytes.Equal(content[257:262], []byte("ustar")))
+}
+
+// archiveFormat checks the entries of a zip, tar or gzipped tar archive for executables.
+// Jars are the zip archives holding Java classes.
+func archiveFormat(content []byte) (checker.BinaryFormat, string) {
+	if bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		return zipFormat(content)
+	}
+	var r io.Reader = bytes.NewReader(content)
+	if bytes.HasPrefix(content, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return "", ""
+		}
+		defer gz.Close()
+		r = io.LimitReader(gz, maxArchiveScanSize)
+	}
+	tr := tar.NewReader(r)
+	for i := 0; i < maxArchiveEntries; i++ {
+		h, err := tr.Next()
+		if err != nil {
+			// Truncated and compressed files which aren't tar archives end the scan.
+			return "", ""
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		if format, arch := archiveEntryFormat(tr); format != "" {
+			return checker.BinaryFormatArchive, arch
+		}
+	}
+	return "", ""
+}
+
+func zipFormat(content []byte) (checker.BinaryFormat, string) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", ""
+	}
+	for i, f := range zr.File {
+		if i >= maxArchiveEntries {
+			break
+		}
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		format, arch := archiveEntryFormat(rc)
+		rc.Close()
+		switch format {
+		case "":
+		case checker.BinaryFormatJavaClass:
+			return checker.BinaryFormatJAR, ""
+		default:
+			return checker.BinaryFormatArchive, arch
+		}
+	}
+	return "", ""
+}
+
+// archiveEntryFormat identifies an executable entry of an archive from its magic bytes.
+func archiveEntryFormat(r io.Reader) (checker.BinaryFormat, string) {
+	content := make([]byte, binaryTestLen)
+	n, err := io.ReadFull(r, content)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", ""
+	}
+	return binaryFormat(content[:n])
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestBinaryFormat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		file    string
+		content []byte
+		format  checker.BinaryFormat
+		arch    string
+	}{
+		{
+			name:   "ELF",
+			file:   "testdata/binary-artifacts/bin/tool",
+			format: checker.BinaryFormatELF,
+			arch:   "amd64",
+		},
+		{
+			name:    "big-endian 64-bit ELF",
+			content: append([]byte("\x7fELF\x02\x02\x01"), append(make([]byte, 11), 0x00, 0x16)...),
+			format:  checker.BinaryFormatELF,
+			arch:    "s390x",
+		},
+		{
+			name:   ".NET assembly",
+			file:   "testdata/binary-artifacts/lib/App.dll",
+			format: checker.BinaryFormatDotNet,
+			arch:   "386",
+		},
+		{
+			name:    "DOS executable",
+			content: append([]byte("MZ"), make([]byte, 0x40)...),
+		},
+		{
+			name:   "Mach-O",
+			file:   "../testdata/binaryartifacts/executables/darwin-arm64-bt",
+			format: checker.BinaryFormatMachO,
+			arch:   "arm64",
+		},
+		{
+			name: "universal Mach-O",
+			content: []byte{
+				0xca, 0xfe, 0xba, 0xbe, 0, 0, 0, 2,
+				0x01, 0, 0, 0x07, 0, 0, 0, 3, 0, 0, 0x40, 0, 0, 0, 0x10, 0, 0, 0, 0, 14,
+				0x01, 0, 0, 0x0c, 0, 0, 0, 0, 0, 0, 0x80, 0, 0, 0, 0x10, 0, 0, 0, 0, 14,
+			},
+			format: checker.BinaryFormatMachO,
+			arch:   "amd64,arm64",
+		},
+		{
+			name:    "Java class",
+			content: []byte{0xca, 0xfe, 0xba, 0xbe, 0, 0, 0, 0x34, 0, 0x10},
+			format:  checker.BinaryFormatJavaClass,
+		},
+		{
+			name:   "WebAssembly",
+			file:   "../testdata/binaryartifacts/wasms/simple.wasm",
+			format: checker.BinaryFormatWASM,
+			arch:   "wasm",
+		},
+		{
+			name:    "DEX",
+			content: []byte("dex\n035\x00\x00\x01\x02\x03"),
+			format:  checker.BinaryFormatDEX,
+		},
+		{
+			name:    "Python 3.12 bytecode",
+			content: append([]byte{0xcb, 0x0d, '\r', '\n'}, make([]byte, 12)...),
+			format:  checker.BinaryFormatPyc,
+		},
+		{
+			name:    "text starting like Python bytecode",
+			content: []byte("\xcb\x0d\r\nnot bytecode at all"),
+		},
+		{
+			name: "text",
+			file: "../testdata/licensedir/withlicense/LICENSE",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content := tt.content
+			if tt.file != "" {
+				var err error
+				if content, err = os.ReadFile(tt.file); err != nil {
+					t.Fatal(err)
+				}
+				if len(content) > binaryTestLen {
+					content = content[:binaryTestLen]
+				}
+			}
+			format, arch := binaryFormat(content)
+			if format != tt.format || arch != tt.arch {
+				t.Errorf("binaryFormat() = %q, %q, want %q, %q", format, arch, tt.format, tt.arch)
+			}
+		})
+	}
+}
+
+func TestArchiveFormat(t *testing.T) {
+	t.Parallel()
+	elfContent, err := os.ReadFile("testdata/binary-artifacts/bin/tool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tgz bytes.Buffer
+	gz := gzip.NewWriter(&tgz)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string][]byte{"tool/README": []byte("tool\n"), "tool/bin/tool": elfContent} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content []byte
+		format  checker.BinaryFormat
+		arch    string
+	}{
+		{
+			name:   "jar",
+			file:   "../testdata/binaryartifacts/jars/aws-java-sdk-core-1.11.571.jar",
+			format: checker.BinaryFormatJAR,
+		},
+		{
+			name:   "zip with an executable",
+			file:   "testdata/binary-artifacts/dist/plugins.zip",
+			format: checker.BinaryFormatArchive,
+			arch:   "arm64",
+		},
+		{
+			name: "zip of documents",
+			file: "testdata/binary-artifacts/docs/manual.zip",
+		},
+		{
+			name:    "tarball with an executable",
+			content: tgz.Bytes(),
+			format:  checker.BinaryFormatArchive,
+			arch:    "amd64",
+		},
+		{
+			name:    "gzipped text",
+			content: []byte{0x1f, 0x8b, 0x08, 0x00},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content := tt.content
+			if tt.file != "" {
+				var err error
+				if content, err = os.ReadFile(tt.file); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !isArchive(content) {
+				t.Fatal("isArchive() = false")
+			}
+			format, arch := archiveFormat(content)
+			if format != tt.format || arch != tt.arch {
+				t.Errorf("archiveFormat() = %q, %q, want %q, %q", format, arch, tt.format, tt.arch)
+			}
+		})
+	}
+}
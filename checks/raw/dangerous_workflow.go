@@ -58,21 +58,40 @@ func containsUntrustedContextPattern(variable string) bool {
 type triggerName string
 
 var (
+	triggerPullRequest              = triggerName("pull_request")
 	triggerPullRequestTarget        = triggerName("pull_request_target")
 	triggerWorkflowRun              = triggerName("workflow_run")
+	triggerRelease                  = triggerName("release")
+	triggerPush                     = triggerName("push")
 	checkoutUntrustedPullRequestRef = "github.event.pull_request"
 	checkoutUntrustedWorkflowRunRef = "github.event.workflow_run"
+	workflowRunID                   = "github.event.workflow_run.id"
 )
 
+// workflowCaches tracks the caches shared across the workflows of a repository. Caches
+// written by workflows running in the scope of the default branch can be restored by all
+// the workflows of the repository.
+type workflowCaches struct {
+	// untrustedWrites are the steps saving a cache in jobs running untrusted code.
+	untrustedWrites []checker.DangerousWorkflow
+	// releaseRestores is whether release jobs restore caches.
+	releaseRestores bool
+}
+
 // DangerousWorkflow retrieves the raw data for the DangerousWorkflow check.
 func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData, error) {
 	// data is shared across all GitHub workflows.
 	var data checker.DangerousWorkflowData
+	var caches workflowCaches
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: false,
-	}, validateGitHubActionWorkflowPatterns, &data)
+	}, validateGitHubActionWorkflowPatterns, &data, &caches, newLocalUses(c.RepoClient))
 
+	// Caches poisoned by untrusted code are only dangerous if release jobs restore them.
+	if caches.releaseRestores {
+		data.Workflows = append(data.Workflows, caches.untrustedWrites...)
+	}
 	return data, err
 }
 
@@ -85,9 +104,9 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return true, nil
 	}
 
-	if len(args) != 1 {
+	if len(args) != 3 {
 		return false, fmt.Errorf(
-			"validateGitHubActionWorkflowPatterns requires exactly 2 arguments: %w", errInvalidArgLength)
+			"validateGitHubActionWorkflowPatterns requires exactly 3 arguments: %w", errInvalidArgLength)
 	}
 
 	// Verify the type of the data.
@@ -96,6 +115,16 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, fmt.Errorf(
 			"validateGitHubActionWorkflowPatterns expects arg[0] of type *patternCbData: %w", errInvalidArgType)
 	}
+	caches, ok := args[1].(*workflowCaches)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateGitHubActionWorkflowPatterns expects arg[1] of type *workflowCaches: %w", errInvalidArgType)
+	}
+	local, ok := args[2].(*localUses)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateGitHubActionWorkflowPatterns expects arg[2] of type *localUses: %w", errInvalidArgType)
+	}
 
 	if !fileparser.CheckFileContainsCommands(content, "#") {
 		return true, nil
@@ -118,6 +147,20 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
+	// 3. Check for workflow_run workflows running code next to the artifacts of the triggering run.
+	validateArtifactPoisoning(workflow, path, pdata)
+
+	// 4. Check for caches written by untrusted code, and restored by release jobs.
+	collectWorkflowCaches(workflow, path, caches)
+
+	// 5. Check for self-hosted runners running code from forks.
+	validateSelfHostedRunners(workflow, path, pdata)
+
+	// 6. Check local composite actions and reusable workflows in the context of this workflow.
+	if err := validateLocalUses(workflow, path, local, pdata); err != nil {
+		return false, err
+	}
+
 	// TODO: Check other dangerous patterns.
 	return true, nil
 }
@@ -175,23 +218,7 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 		if step == nil || step.Exec == nil {
 			continue
 		}
-		// Check for a step that uses actions/checkout
-		e, ok := step.Exec.(*actionlint.ExecAction)
-		if !ok || e.Uses == nil {
-			continue
-		}
-		if !strings.Contains(e.Uses.Value, "actions/checkout") {
-			continue
-		}
-		// Check for reference. If not defined for a pull_request_target event, this defaults to
-		// the base branch of the pull request.
-		ref, ok := e.Inputs["ref"]
-		if !ok || ref.Value == nil {
-			continue
-		}
-
-		if strings.Contains(ref.Value.Value, checkoutUntrustedPullRequestRef) ||
-			strings.Contains(ref.Value.Value, checkoutUntrustedWorkflowRunRef) {
+		if ref, ok := untrustedCheckoutRef(step); ok {
 			line := fileparser.GetLineNumber(step.Pos)
 			pdata.Workflows = append(pdata.Workflows,
 				checker.DangerousWorkflow{
@@ -200,7 +227,7 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 						Path:    path,
 						Type:    finding.FileTypeSource,
 						Offset:  line,
-						Snippet: ref.Value.Value,
+						Snippet: ref,
 					},
 					Job: createJob(job),
 				},
@@ -210,6 +237,41 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 	return nil
 }
 
+// untrustedCheckoutRef returns the ref of a step checking out the code of a PR.
+func untrustedCheckoutRef(step *actionlint.Step) (string, bool) {
+	if step == nil || step.Exec == nil {
+		return "", false
+	}
+	// Check for a step that uses actions/checkout
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil {
+		return "", false
+	}
+	if !strings.Contains(e.Uses.Value, "actions/checkout") {
+		return "", false
+	}
+	// Check for reference. If not defined for a pull_request_target event, this defaults to
+	// the base branch of the pull request.
+	ref, ok := e.Inputs["ref"]
+	if !ok || ref.Value == nil {
+		return "", false
+	}
+	if strings.Contains(ref.Value.Value, checkoutUntrustedPullRequestRef) ||
+		strings.Contains(ref.Value.Value, checkoutUntrustedWorkflowRunRef) {
+		return ref.Value.Value, true
+	}
+	return "", false
+}
+
+func jobChecksOutUntrustedCode(job *actionlint.Job) bool {
+	for _, step := range job.Steps {
+		if _, ok := untrustedCheckoutRef(step); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func validateScriptInjection(workflow *actionlint.Workflow, path string,
 	pdata *checker.DangerousWorkflowData,
 ) error {
@@ -226,7 +288,8 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 				continue
 			}
 			// Check Run *String for user-controllable (untrustworthy) properties.
-			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path, pdata); err != nil {
+			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path,
+				containsUntrustedContextPattern, nil, pdata); err != nil {
 				return err
 			}
 		}
@@ -234,8 +297,11 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 	return nil
 }
 
+// checkVariablesInScript reports the expressions of script for which isUntrusted is true.
+// caller is the location using the composite action or reusable workflow in path, if any.
 func checkVariablesInScript(script string, pos *actionlint.Pos,
 	job *actionlint.Job, path string,
+	isUntrusted func(string) bool, caller *checker.File,
 	pdata *checker.DangerousWorkflowData,
 ) error {
 	for {
@@ -251,7 +317,7 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 
 		// Check if the variable may be untrustworthy.
 		variable := script[s+3 : s+e]
-		if containsUntrustedContextPattern(variable) {
+		if isUntrusted(variable) {
 			line := fileparser.GetLineNumber(pos)
 			pdata.Workflows = append(pdata.Workflows,
 				checker.DangerousWorkflow{
@@ -261,8 +327,9 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 						Offset:  line,
 						Snippet: variable,
 					},
-					Job:  createJob(job),
-					Type: checker.DangerousWorkflowScriptInjection,
+					Job:    createJob(job),
+					Type:   checker.DangerousWorkflowScriptInjection,
+					Caller: caller,
 				},
 			)
 		}
@@ -270,3 +337,342 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 	}
 	return nil
 }
+
+func validateArtifactPoisoning(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	if !usesEventTrigger(workflow, triggerWorkflowRun) {
+		return
+	}
+
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		// The artifacts of the triggering run may have been uploaded by a fork PR. Extracted in the
+		// workspace, they can overwrite the scripts and configuration of the steps which follow.
+		var download *actionlint.Step
+		var snippet string
+		for _, step := range job.Steps {
+			if step == nil || step.Exec == nil {
+				continue
+			}
+			if download != nil && stepRunsCode(step) {
+				line := fileparser.GetLineNumber(download.Pos)
+				pdata.Workflows = append(pdata.Workflows,
+					checker.DangerousWorkflow{
+						Type: checker.DangerousWorkflowArtifactPoisoning,
+						File: checker.File{
+							Path:    path,
+							Type:    finding.FileTypeSource,
+							Offset:  line,
+							Snippet: snippet,
+						},
+						Job: createJob(job),
+					},
+				)
+				download = nil
+			}
+			if s, ok := workflowRunArtifactDownload(step); ok {
+				download, snippet = step, s
+			}
+		}
+	}
+}
+
+// workflowRunArtifactDownload returns what a step uses to download the artifacts of the
+// workflow run which triggered the workflow in the workspace.
+func workflowRunArtifactDownload(step *actionlint.Step) (string, bool) {
+	switch e := step.Exec.(type) {
+	case *actionlint.ExecAction:
+		if e.Uses == nil {
+			return "", false
+		}
+		uses := e.Uses.Value
+		switch {
+		case strings.Contains(uses, "actions/download-artifact"):
+			if !actionInputContains(e, "run-id", workflowRunID) {
+				return "", false
+			}
+		case strings.Contains(uses, "dawidd6/action-download-artifact"):
+			// Downloads the artifacts of other workflows.
+		case strings.Contains(uses, "actions/github-script"):
+			if !actionInputContains(e, "script", "downloadArtifact") ||
+				!actionInputContains(e, "script", checkoutUntrustedWorkflowRunRef) {
+				return "", false
+			}
+			return uses, !actionInputContains(e, "script", "runner.temp")
+		default:
+			return "", false
+		}
+		return uses, !extractsOutsideWorkspace(actionInput(e, "path"))
+	case *actionlint.ExecRun:
+		if e.Run == nil || !strings.Contains(e.Run.Value, "gh run download") ||
+			!strings.Contains(e.Run.Value, workflowRunID) {
+			return "", false
+		}
+		return "gh run download", !extractsOutsideWorkspace(e.Run.Value)
+	}
+	return "", false
+}
+
+func actionInput(e *actionlint.ExecAction, name string) string {
+	input, ok := e.Inputs[name]
+	if !ok || input == nil || input.Value == nil {
+		return ""
+	}
+	return input.Value.Value
+}
+
+func actionInputContains(e *actionlint.ExecAction, name, substr string) bool {
+	return strings.Contains(actionInput(e, name), substr)
+}
+
+// extractsOutsideWorkspace reports whether a path is outside the workspace of the job, where
+// no step looks for scripts or configuration.
+func extractsOutsideWorkspace(path string) bool {
+	return strings.Contains(path, "runner.temp") || strings.Contains(path, "RUNNER_TEMP") ||
+		strings.Contains(path, "/tmp/")
+}
+
+// stepRunsCode reports whether a step runs code from the workspace: a script, or a local action.
+func stepRunsCode(step *actionlint.Step) bool {
+	switch e := step.Exec.(type) {
+	case *actionlint.ExecRun:
+		return e.Run != nil
+	case *actionlint.ExecAction:
+		return e.Uses != nil && strings.HasPrefix(e.Uses.Value, "./")
+	}
+	return false
+}
+
+func collectWorkflowCaches(workflow *actionlint.Workflow, path string, caches *workflowCaches) {
+	untrusted := usesEventTrigger(workflow, triggerPullRequestTarget) || usesEventTrigger(workflow, triggerWorkflowRun)
+	release := isReleaseWorkflow(workflow)
+
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		if !caches.releaseRestores && (release || isReleaseJob(job)) {
+			for _, step := range job.Steps {
+				if _, restores, _ := cacheStep(step); restores {
+					caches.releaseRestores = true
+					break
+				}
+			}
+		}
+
+		// Caches written in pull_request_target and workflow_run workflows are scoped to the
+		// default branch, so the workflows of all branches and tags can restore them.
+		if !untrusted || !jobChecksOutUntrustedCode(job) {
+			continue
+		}
+		for _, step := range job.Steps {
+			uses, _, saves := cacheStep(step)
+			if !saves {
+				continue
+			}
+			line := fileparser.GetLineNumber(step.Pos)
+			caches.untrustedWrites = append(caches.untrustedWrites,
+				checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowCachePoisoning,
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  line,
+						Snippet: uses,
+					},
+					Job: createJob(job),
+				},
+			)
+		}
+	}
+}
+
+// cacheStep returns whether a step restores and saves a cache. Besides actions/cache, the
+// setup actions of most ecosystems cache dependencies when given the `cache` input.
+func cacheStep(step *actionlint.Step) (uses string, restores, saves bool) {
+	if step == nil {
+		return "", false, false
+	}
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil {
+		return "", false, false
+	}
+	uses = e.Uses.Value
+	name, _, _ := strings.Cut(uses, "@")
+	switch {
+	case strings.EqualFold(name, "actions/cache"):
+		return uses, true, true
+	case strings.EqualFold(name, "actions/cache/restore"):
+		return uses, true, false
+	case strings.EqualFold(name, "actions/cache/save"):
+		return uses, false, true
+	case strings.HasPrefix(strings.ToLower(name), "actions/setup-"):
+		cache := actionInput(e, "cache")
+		caching := cache != "" && cache != "false"
+		return uses, caching, caching
+	}
+	return uses, false, false
+}
+
+// isReleaseWorkflow reports whether a workflow runs for releases or tags.
+func isReleaseWorkflow(workflow *actionlint.Workflow) bool {
+	for _, event := range workflow.On {
+		switch event.EventName() {
+		case string(triggerRelease):
+			return true
+		case string(triggerPush):
+			if e, ok := event.(*actionlint.WebhookEvent); ok && e.Tags != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isReleaseJob reports whether a job is named after releases or publishing.
+func isReleaseJob(job *actionlint.Job) bool {
+	var names []string
+	if job.ID != nil {
+		names = append(names, job.ID.Value)
+	}
+	if job.Name != nil {
+		names = append(names, job.Name.Value)
+	}
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if strings.Contains(name, "release") || strings.Contains(name, "publish") {
+			return true
+		}
+	}
+	return false
+}
+
+func validateSelfHostedRunners(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	pullRequest := usesEventTrigger(workflow, triggerPullRequest)
+	pullRequestTarget := usesEventTrigger(workflow, triggerPullRequestTarget)
+	if !pullRequest && !pullRequestTarget {
+		return
+	}
+
+	for _, job := range workflow.Jobs {
+		if job == nil || job.RunsOn == nil || jobExcludesForks(job) {
+			continue
+		}
+		// pull_request_target jobs run the code of the base branch, unless they check out the PR.
+		if !pullRequest && !jobChecksOutUntrustedCode(job) {
+			continue
+		}
+		for _, label := range job.RunsOn.Labels {
+			if label == nil || !strings.EqualFold(label.Value, "self-hosted") {
+				continue
+			}
+			line := fileparser.GetLineNumber(label.Pos)
+			pdata.Workflows = append(pdata.Workflows,
+				checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowSelfHostedRunner,
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  line,
+						Snippet: runnerLabels(job.RunsOn),
+					},
+					Job: createJob(job),
+				},
+			)
+			break
+		}
+	}
+}
+
+// jobExcludesForks reports whether the condition of a job excludes PRs from forks, e.g.
+// `github.event.pull_request.head.repo.full_name == github.repository`.
+func jobExcludesForks(job *actionlint.Job) bool {
+	if job.If == nil {
+		return false
+	}
+	cond := strings.TrimSpace(job.If.Value)
+	if strings.HasPrefix(cond, "${{") && strings.HasSuffix(cond, "}}") {
+		cond = strings.TrimSuffix(strings.TrimPrefix(cond, "${{"), "}}")
+	}
+	expr, err := actionlint.NewExprParser().Parse(actionlint.NewExprLexer(cond + "}}"))
+	if err != nil {
+		return false
+	}
+	return excludesForks(expr)
+}
+
+// excludesForks reports whether expr is only true for PRs from the repository itself.
+func excludesForks(expr actionlint.ExprNode) bool {
+	switch n := expr.(type) {
+	case *actionlint.LogicalOpNode:
+		if n.Kind == actionlint.LogicalOpNodeKindAnd {
+			return excludesForks(n.Left) || excludesForks(n.Right)
+		}
+		return excludesForks(n.Left) && excludesForks(n.Right)
+	case *actionlint.NotOpNode:
+		// !github.event.pull_request.head.repo.fork
+		return isHeadRepoProperty(n.Operand, "fork")
+	case *actionlint.CompareOpNode:
+		return compareExcludesForks(n.Kind, n.Left, n.Right) || compareExcludesForks(n.Kind, n.Right, n.Left)
+	default:
+		return false
+	}
+}
+
+// compareExcludesForks reports whether comparing the head repository of a PR to
+// operand, with the operator kind, only holds for PRs from the repository itself.
+func compareExcludesForks(kind actionlint.CompareOpNodeKind, head, operand actionlint.ExprNode) bool {
+	switch {
+	case isHeadRepoProperty(head, "fork"):
+		b, ok := operand.(*actionlint.BoolNode)
+		return ok && (kind == actionlint.CompareOpNodeKindEq && !b.Value ||
+			kind == actionlint.CompareOpNodeKindNotEq && b.Value)
+	case isHeadRepoProperty(head, "full_name"):
+		if kind != actionlint.CompareOpNodeKindEq {
+			return false
+		}
+		if _, ok := operand.(*actionlint.StringNode); ok {
+			return true
+		}
+		switch exprPath(operand) {
+		case "github.repository", "github.event.repository.full_name", "github.event.pull_request.base.repo.full_name":
+			return true
+		}
+	}
+	return false
+}
+
+// isHeadRepoProperty reports whether expr is the property of the head repository
+// of a PR, e.g. `github.event.pull_request.head.repo.fork`.
+func isHeadRepoProperty(expr actionlint.ExprNode, property string) bool {
+	return strings.HasSuffix(exprPath(expr), ".head.repo."+property)
+}
+
+// exprPath returns the dotted path of a property dereference, e.g. `github.repository`,
+// or "" for other expressions.
+func exprPath(expr actionlint.ExprNode) string {
+	switch n := expr.(type) {
+	case *actionlint.VariableNode:
+		return strings.ToLower(n.Name)
+	case *actionlint.ObjectDerefNode:
+		if receiver := exprPath(n.Receiver); receiver != "" {
+			return receiver + "." + n.Property
+		}
+	}
+	return ""
+}
+
+func runnerLabels(runner *actionlint.Runner) string {
+	labels := make([]string, 0, len(runner.Labels))
+	for _, label := range runner.Labels {
+		if label != nil {
+			labels = append(labels, label.Value)
+		}
+	}
+	return strings.Join(labels, ", ")
+}
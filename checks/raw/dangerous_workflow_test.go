@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
@@ -150,6 +151,21 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-script-injection-wildcard.yml",
 			expected: ret{nb: 1},
 		},
+		{
+			name:     "run workflow_run artifact poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-artifact-poisoning.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "run cache poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-cache-poisoning.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "run self-hosted runner",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-self-hosted-runner.yml",
+			expected: ret{nb: 3},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -159,9 +175,10 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil)
+			// Local actions used by the workflow are read too.
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("../testdata/" + file)
-			})
+			}).AnyTimes()
 
 			req := &checker.CheckRequest{
 				Ctx:        context.Background(),
@@ -184,3 +201,81 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubDangerousWorkflowCachePoisoning(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		dir      string
+		expected []checker.DangerousWorkflowType
+	}{
+		{
+			name: "cache restored by a release workflow",
+			dir:  "testdata/workflow-caches/release",
+			expected: []checker.DangerousWorkflowType{
+				checker.DangerousWorkflowUntrustedCheckout,
+				checker.DangerousWorkflowCachePoisoning,
+			},
+		},
+		{
+			name: "cache not restored by release jobs",
+			dir:  "testdata/workflow-caches/no-release",
+			expected: []checker.DangerousWorkflowType{
+				checker.DangerousWorkflowUntrustedCheckout,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := &checker.CheckRequest{
+				Ctx:        context.Background(),
+				RepoClient: mockRepoFiles(t, tt.dir),
+			}
+			dw, err := DangerousWorkflow(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []checker.DangerousWorkflowType
+			for _, w := range dw.Workflows {
+				got = append(got, w.Type)
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobExcludesForks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cond string
+		want bool
+	}{
+		{cond: "github.event.pull_request.head.repo.full_name == github.repository", want: true},
+		{cond: "github.repository == github.event.pull_request.head.repo.full_name", want: true},
+		{cond: "github.event.pull_request.head.repo.full_name == 'ossf/scorecard'", want: true},
+		{cond: "${{ github.event.pull_request.head.repo.fork == false }}", want: true},
+		{cond: "github.event.pull_request.head.repo.fork != true", want: true},
+		{cond: "!github.event.pull_request.head.repo.fork", want: true},
+		{cond: "github.event_name == 'push' && !github.event.pull_request.head.repo.fork", want: true},
+		{cond: "github.event.pull_request.head.repo.fork == true", want: false},
+		{cond: "github.event.pull_request.head.repo.fork", want: false},
+		{cond: "!github.event.pull_request.head.repo.fork == false", want: false},
+		{cond: "github.event.pull_request.head.repo.full_name != github.repository", want: false},
+		{cond: "github.event.pull_request.head.repo.full_name == github.event.pull_request.head.repo.full_name", want: false},
+		{cond: "github.event.pull_request.head.repo.fork == false || github.actor == 'octocat'", want: false},
+		{cond: "contains(github.event.pull_request.head.repo.full_name, 'ossf')", want: false},
+		{cond: "github.event.pull_request.head.repo.fork ==", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cond, func(t *testing.T) {
+			t.Parallel()
+			job := &actionlint.Job{If: &actionlint.String{Value: tt.cond}}
+			if got := jobExcludesForks(job); got != tt.want {
+				t.Errorf("jobExcludesForks(%q) = %t, want %t", tt.cond, got, tt.want)
+			}
+		})
+	}
+}
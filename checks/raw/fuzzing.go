@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/ossf/scorecard/v5/checker"
@@ -44,25 +45,39 @@ type languageFuzzConfig struct {
 	filePatterns []string
 }
 
+// Configurations for fuzzers detected by their configuration files.
+type configFuzzConfig struct {
+	URL, Desc *string
+	Name      string
+	// Patterns are according to path.Match.
+	filePatterns []string
+}
+
 // Contains fuzzing specifications for programming languages.
 // Please use the type Language defined in clients/languages.go rather than a raw string.
-var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
+// A language may have several fuzzers, and a fuzzer may support several languages,
+// in which case the harnesses found in each language are reported together.
+var languageFuzzSpecs = map[clients.LanguageName][]languageFuzzConfig{
 	// Default fuzz patterns for Go.
 	clients.Go: {
-		filePatterns: []string{"*_test.go"},
-		funcPattern:  `func\s+Fuzz\w+\s*\(\w+\s+\*testing.F\)`,
-		Name:         fuzzers.BuiltInGo,
-		URL:          asPointer("https://go.dev/doc/fuzz/"),
-		Desc: asPointer(
-			"Go fuzzing intelligently walks through the source code to report failures and find vulnerabilities."),
+		{
+			filePatterns: []string{"*_test.go"},
+			funcPattern:  `func\s+Fuzz\w+\s*\(\w+\s+\*testing.F\)`,
+			Name:         fuzzers.BuiltInGo,
+			URL:          asPointer("https://go.dev/doc/fuzz/"),
+			Desc: asPointer(
+				"Go fuzzing intelligently walks through the source code to report failures and find vulnerabilities."),
+		},
 	},
 	// Fuzz patterns for Erlang based on property-based testing.
 	clients.Erlang: {
-		filePatterns: []string{"*.erl", "*.hrl"},
-		// Look for direct imports of QuickCheck or Proper,
-		funcPattern: `-include_lib\("(eqc|proper)/include/(eqc|proper).hrl"\)\.`,
-		Name:        fuzzers.PropertyBasedErlang,
-		Desc:        propertyBasedDescription("Erlang"),
+		{
+			filePatterns: []string{"*.erl", "*.hrl"},
+			// Look for direct imports of QuickCheck or Proper,
+			funcPattern: `-include_lib\("(eqc|proper)/include/(eqc|proper).hrl"\)\.`,
+			Name:        fuzzers.PropertyBasedErlang,
+			Desc:        propertyBasedDescription("Erlang"),
+		},
 	},
 	// Fuzz patterns for Haskell based on property-based testing.
 	//
@@ -78,30 +93,36 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 	//
 	// This is not an exhaustive list.
 	clients.Haskell: {
-		filePatterns: []string{"*.hs", "*.lhs"},
-		// Look for direct imports of QuickCheck, Hedgehog, validity, or SmallCheck,
-		// or their indirect imports through the higher-level Hspec or Tasty testing frameworks.
-		funcPattern: `import\s+(qualified\s+)?Test\.((Hspec|Tasty)\.)?(QuickCheck|Hedgehog|Validity|SmallCheck)`,
-		Name:        fuzzers.PropertyBasedHaskell,
-		Desc:        propertyBasedDescription("Haskell"),
+		{
+			filePatterns: []string{"*.hs", "*.lhs"},
+			// Look for direct imports of QuickCheck, Hedgehog, validity, or SmallCheck,
+			// or their indirect imports through the higher-level Hspec or Tasty testing frameworks.
+			funcPattern: `import\s+(qualified\s+)?Test\.((Hspec|Tasty)\.)?(QuickCheck|Hedgehog|Validity|SmallCheck)`,
+			Name:        fuzzers.PropertyBasedHaskell,
+			Desc:        propertyBasedDescription("Haskell"),
+		},
 	},
 
 	// Fuzz patterns for Elixir based on property-based testing.
 	clients.Elixir: {
-		filePatterns: []string{"*.ex", "*.exs"},
-		// Look for direct imports of PropCheck, and StreamData.
-		funcPattern: `use\s+(PropCheck|ExUnitProperties)`,
-		Name:        fuzzers.PropertyBasedElixir,
-		Desc:        propertyBasedDescription("Elixir"),
+		{
+			filePatterns: []string{"*.ex", "*.exs"},
+			// Look for direct imports of PropCheck, and StreamData.
+			funcPattern: `use\s+(PropCheck|ExUnitProperties)`,
+			Name:        fuzzers.PropertyBasedElixir,
+			Desc:        propertyBasedDescription("Elixir"),
+		},
 	},
 
 	// Fuzz patterns for Gleam based on property-based testing.
 	clients.Gleam: {
-		filePatterns: []string{"*.gleam"},
-		// Look for direct imports of PropCheck, and StreamData.
-		funcPattern: `import\s+qcheck`, // Gleam library
-		Name:        fuzzers.PropertyBasedGleam,
-		Desc:        propertyBasedDescription("Gleam"),
+		{
+			filePatterns: []string{"*.gleam"},
+			// Look for direct imports of PropCheck, and StreamData.
+			funcPattern: `import\s+qcheck`, // Gleam library
+			Name:        fuzzers.PropertyBasedGleam,
+			Desc:        propertyBasedDescription("Gleam"),
+		},
 	},
 	// Fuzz patterns for JavaScript and TypeScript based on property-based testing.
 	//
@@ -113,66 +134,220 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 	//
 	// This is not an exhaustive list.
 	clients.JavaScript: {
-		filePatterns: []string{"*.js"},
-		// Look for direct imports of fast-check and its test runners integrations.
-		funcPattern: `(from\s+['"](fast-check|@fast-check/(ava|jest|vitest))['"]|` +
-			`require\(\s*['"](fast-check|@fast-check/(ava|jest|vitest))['"]\s*\))`,
-		Name: fuzzers.PropertyBasedJavaScript,
-		Desc: propertyBasedDescription("JavaScript"),
+		{
+			filePatterns: []string{"*.js"},
+			// Look for direct imports of fast-check and its test runners integrations.
+			funcPattern: `(from\s+['"](fast-check|@fast-check/(ava|jest|vitest))['"]|` +
+				`require\(\s*['"](fast-check|@fast-check/(ava|jest|vitest))['"]\s*\))`,
+			Name: fuzzers.PropertyBasedJavaScript,
+			Desc: propertyBasedDescription("JavaScript"),
+		},
+		jazzerJSFuzzConfig("*.js"),
 	},
 	clients.TypeScript: {
-		filePatterns: []string{"*.ts"},
-		// Look for direct imports of fast-check and its test runners integrations.
-		funcPattern: `(from\s+['"](fast-check|@fast-check/(ava|jest|vitest))['"]|` +
-			`require\(\s*['"](fast-check|@fast-check/(ava|jest|vitest))['"]\s*\))`,
-		Name: fuzzers.PropertyBasedTypeScript,
-		Desc: propertyBasedDescription("TypeScript"),
+		{
+			filePatterns: []string{"*.ts"},
+			// Look for direct imports of fast-check and its test runners integrations.
+			funcPattern: `(from\s+['"](fast-check|@fast-check/(ava|jest|vitest))['"]|` +
+				`require\(\s*['"](fast-check|@fast-check/(ava|jest|vitest))['"]\s*\))`,
+			Name: fuzzers.PropertyBasedTypeScript,
+			Desc: propertyBasedDescription("TypeScript"),
+		},
+		jazzerJSFuzzConfig("*.ts"),
 	},
 	clients.Python: {
-		filePatterns: []string{"*.py"},
-		funcPattern:  `import atheris`,
-		Name:         fuzzers.PythonAtheris,
-		Desc: asPointer(
-			"Python fuzzing by way of Atheris"),
+		{
+			filePatterns: []string{"*.py"},
+			funcPattern:  `import atheris`,
+			Name:         fuzzers.PythonAtheris,
+			Desc: asPointer(
+				"Python fuzzing by way of Atheris"),
+		},
+		// https://hypothesis.readthedocs.io/
+		{
+			filePatterns: []string{"*.py"},
+			funcPattern:  `^\s*(from\s+hypothesis(\.\w+)*\s+import\b|import\s+hypothesis\b)`,
+			Name:         fuzzers.PropertyBasedPython,
+			URL:          asPointer("https://hypothesis.readthedocs.io/"),
+			Desc:         propertyBasedDescription("Python"),
+		},
 	},
 	clients.C: {
-		filePatterns: []string{"*.c"},
-		funcPattern:  `LLVMFuzzerTestOneInput`,
-		Name:         fuzzers.CLibFuzzer,
-		Desc: asPointer(
-			"Fuzzed with C LibFuzzer"),
+		{
+			filePatterns: []string{"*.c"},
+			funcPattern:  `LLVMFuzzerTestOneInput`,
+			Name:         fuzzers.CLibFuzzer,
+			Desc: asPointer(
+				"Fuzzed with C LibFuzzer"),
+		},
+		aflPlusPlusFuzzConfig("*.c"),
+		honggfuzzFuzzConfig("*.c"),
+		ciFuzzFuzzConfig("*.c"),
 	},
 	clients.Cpp: {
-		filePatterns: []string{"*.cc", "*.cpp"},
-		funcPattern:  `LLVMFuzzerTestOneInput`,
-		Name:         fuzzers.CppLibFuzzer,
-		Desc: asPointer(
-			"Fuzzed with cpp LibFuzzer"),
+		{
+			filePatterns: []string{"*.cc", "*.cpp"},
+			funcPattern:  `LLVMFuzzerTestOneInput`,
+			Name:         fuzzers.CppLibFuzzer,
+			Desc: asPointer(
+				"Fuzzed with cpp LibFuzzer"),
+		},
+		aflPlusPlusFuzzConfig("*.cc", "*.cpp"),
+		honggfuzzFuzzConfig("*.cc", "*.cpp"),
+		ciFuzzFuzzConfig("*.cc", "*.cpp"),
 	},
 	clients.Rust: {
-		filePatterns: []string{"*.rs"},
-		funcPattern:  `libfuzzer_sys`,
-		Name:         fuzzers.RustCargoFuzz,
-		Desc: asPointer(
-			"Fuzzed with Cargo-fuzz"),
+		{
+			filePatterns: []string{"*.rs"},
+			funcPattern:  `libfuzzer_sys`,
+			Name:         fuzzers.RustCargoFuzz,
+			Desc: asPointer(
+				"Fuzzed with Cargo-fuzz"),
+		},
+		// https://github.com/rust-fuzz/afl.rs
+		{
+			filePatterns: []string{"*.rs"},
+			funcPattern:  `\bafl::fuzz`,
+			Name:         fuzzers.AFLPlusPlus,
+			URL:          asPointer("https://aflplus.plus/"),
+			Desc:         asPointer("Fuzzed with AFL++"),
+		},
+		// https://github.com/rust-fuzz/honggfuzz-rs
+		{
+			filePatterns: []string{"*.rs"},
+			funcPattern:  `\bhonggfuzz::fuzz`,
+			Name:         fuzzers.Honggfuzz,
+			URL:          asPointer("https://github.com/google/honggfuzz"),
+			Desc:         asPointer("Fuzzed with honggfuzz"),
+		},
+		// Based on the import of one of these packages:
+		// * https://github.com/proptest-rs/proptest
+		// * https://github.com/BurntSushi/quickcheck
+		{
+			filePatterns: []string{"*.rs"},
+			funcPattern:  `\buse\s+(proptest|quickcheck(_macros)?)::|\bproptest!|#\[quickcheck\]`,
+			Name:         fuzzers.PropertyBasedRust,
+			Desc:         propertyBasedDescription("Rust"),
+		},
 	},
 	clients.Java: {
-		filePatterns: []string{"*.java"},
-		funcPattern:  `com.code_intelligence.jazzer.api.FuzzedDataProvider;`,
-		Name:         fuzzers.JavaJazzerFuzzer,
-		Desc: asPointer(
-			"Fuzzed with Jazzer fuzzer"),
+		{
+			filePatterns: []string{"*.java"},
+			funcPattern:  `com.code_intelligence.jazzer.api.FuzzedDataProvider;`,
+			Name:         fuzzers.JavaJazzerFuzzer,
+			Desc: asPointer(
+				"Fuzzed with Jazzer fuzzer"),
+		},
+		// https://jqwik.net/
+		{
+			filePatterns: []string{"*.java"},
+			funcPattern:  `import\s+net\.jqwik\.api\.`,
+			Name:         fuzzers.PropertyBasedJava,
+			URL:          asPointer("https://jqwik.net/"),
+			Desc:         propertyBasedDescription("Java"),
+		},
 	},
 	clients.Swift: {
-		filePatterns: []string{"*.swift"},
-		funcPattern:  `LLVMFuzzerTestOneInput`,
-		Name:         fuzzers.SwiftLibFuzzer,
-		Desc: asPointer(
-			"Fuzzed with Swift LibFuzzer"),
+		{
+			filePatterns: []string{"*.swift"},
+			funcPattern:  `LLVMFuzzerTestOneInput`,
+			Name:         fuzzers.SwiftLibFuzzer,
+			Desc: asPointer(
+				"Fuzzed with Swift LibFuzzer"),
+		},
+	},
+	// https://fscheck.github.io/FsCheck/
+	clients.CSharp: {
+		{
+			filePatterns: []string{"*.cs"},
+			funcPattern:  `using\s+FsCheck\b`,
+			Name:         fuzzers.PropertyBasedDotNet,
+			URL:          asPointer("https://fscheck.github.io/FsCheck/"),
+			Desc:         propertyBasedDescription(".NET"),
+		},
+	},
+	clients.FSharp: {
+		{
+			filePatterns: []string{"*.fs", "*.fsx"},
+			funcPattern:  `open\s+FsCheck\b`,
+			Name:         fuzzers.PropertyBasedDotNet,
+			URL:          asPointer("https://fscheck.github.io/FsCheck/"),
+			Desc:         propertyBasedDescription(".NET"),
+		},
 	},
 	// TODO: add more language-specific fuzz patterns & configs.
 }
 
+// Configurations for fuzzers detected by their configuration files, whatever the languages
+// of the project.
+var configFuzzSpecs = []configFuzzConfig{
+	{
+		// https://docs.mayhem.security/code-testing/reference/mayhemfile/
+		filePatterns: []string{"Mayhemfile", "Mayhemfile.yml", "Mayhemfile.yaml"},
+		Name:         fuzzers.Mayhem,
+		URL:          asPointer("https://www.mayhem.security/"),
+		Desc:         asPointer("Fuzzed with Mayhem"),
+	},
+	{
+		// https://github.com/CodeIntelligenceTesting/cifuzz
+		filePatterns: []string{"cifuzz.yaml", ".code-intelligence/project.yaml"},
+		Name:         fuzzers.CIFuzz,
+		URL:          asPointer("https://github.com/CodeIntelligenceTesting/cifuzz"),
+		Desc:         asPointer("Fuzzed with CI Fuzz"),
+	},
+}
+
+// aflPlusPlusFuzzConfig matches the AFL++ persistent mode and deferred initialization macros.
+// https://github.com/AFLplusplus/AFLplusplus/blob/stable/instrumentation/README.persistent_mode.md
+func aflPlusPlusFuzzConfig(filePatterns ...string) languageFuzzConfig {
+	return languageFuzzConfig{
+		filePatterns: filePatterns,
+		funcPattern:  `__AFL_(LOOP|FUZZ_TESTCASE_BUF|FUZZ_INIT|INIT)\b`,
+		Name:         fuzzers.AFLPlusPlus,
+		URL:          asPointer("https://aflplus.plus/"),
+		Desc:         asPointer("Fuzzed with AFL++"),
+	}
+}
+
+// honggfuzzFuzzConfig matches the honggfuzz persistent mode.
+// https://github.com/google/honggfuzz/blob/master/docs/PersistentFuzzing.md
+func honggfuzzFuzzConfig(filePatterns ...string) languageFuzzConfig {
+	return languageFuzzConfig{
+		filePatterns: filePatterns,
+		funcPattern:  `\bHF_ITER\s*\(|#include\s*[<"]libhfuzz/`,
+		Name:         fuzzers.Honggfuzz,
+		URL:          asPointer("https://github.com/google/honggfuzz"),
+		Desc:         asPointer("Fuzzed with honggfuzz"),
+	}
+}
+
+// ciFuzzFuzzConfig matches the fuzz tests of CI Fuzz.
+// https://github.com/CodeIntelligenceTesting/cifuzz
+func ciFuzzFuzzConfig(filePatterns ...string) languageFuzzConfig {
+	return languageFuzzConfig{
+		filePatterns: filePatterns,
+		funcPattern:  `#include\s*[<"]cifuzz/cifuzz\.h[>"]`,
+		Name:         fuzzers.CIFuzz,
+		URL:          asPointer("https://github.com/CodeIntelligenceTesting/cifuzz"),
+		Desc:         asPointer("Fuzzed with CI Fuzz"),
+	}
+}
+
+// jazzerJSFuzzConfig matches the fuzz targets of Jazzer.js, which export a fuzz function,
+// or use its Jest integration.
+// https://github.com/CodeIntelligenceTesting/jazzer.js
+func jazzerJSFuzzConfig(filePatterns ...string) languageFuzzConfig {
+	return languageFuzzConfig{
+		filePatterns: filePatterns,
+		funcPattern: `((from|import)\s+['"]@jazzer\.js/(core|jest-runner)['"]|` +
+			`require\(\s*['"]@jazzer\.js/(core|jest-runner)['"]\s*\)|` +
+			`module\.exports\.fuzz\s*=)`,
+		Name: fuzzers.JavaScriptJazzerFuzzer,
+		URL:  asPointer("https://github.com/CodeIntelligenceTesting/jazzer.js"),
+		Desc: asPointer("Fuzzed with Jazzer.js"),
+	}
+}
+
 // Fuzzing runs Fuzzing check.
 func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 	var detectedFuzzers []checker.Tool
@@ -186,7 +361,12 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 				Name: fuzzers.ClusterFuzzLite,
 				URL:  asPointer("https://github.com/google/clusterfuzzlite"),
 				Desc: asPointer("continuous fuzzing solution that runs as part of Continuous Integration (CI) workflows"),
-				// TODO: File.
+				Files: []checker.File{
+					{
+						Path: ".clusterfuzzlite/Dockerfile",
+						Type: finding.FileTypeSource,
+					},
+				},
 			},
 		)
 	}
@@ -206,28 +386,44 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 		)
 	}
 
+	configFuzzers, e := checkFuzzConfigs(c)
+	if e != nil {
+		return checker.FuzzingData{}, e
+	}
+	detectedFuzzers = addFuzzers(detectedFuzzers, configFuzzers...)
+
 	langs, err := c.RepoClient.ListProgrammingLanguages()
 	if err != nil {
 		return checker.FuzzingData{}, fmt.Errorf("cannot get langs of repo: %w", err)
 	}
 	prominentLangs := getProminentLanguages(langs)
 	for _, lang := range prominentLangs {
-		usingFuzzFunc, files, e := checkFuzzFunc(c, lang)
+		_, langFuzzers, e := checkFuzzFunc(c, lang)
 		if e != nil {
 			return checker.FuzzingData{}, fmt.Errorf("%w", e)
 		}
-		if usingFuzzFunc {
-			detectedFuzzers = append(detectedFuzzers,
-				checker.Tool{
-					Name:  languageFuzzSpecs[lang].Name,
-					URL:   languageFuzzSpecs[lang].URL,
-					Desc:  languageFuzzSpecs[lang].Desc,
-					Files: files,
-				},
-			)
+		detectedFuzzers = addFuzzers(detectedFuzzers, langFuzzers...)
+	}
+
+	jobs, e := getFuzzingJobs(c.RepoClient, c.Dlogger)
+	if e != nil {
+		return checker.FuzzingData{}, e
+	}
+	return checker.FuzzingData{Fuzzers: detectedFuzzers, Jobs: jobs}, nil
+}
+
+// addFuzzers adds fuzzers to detected, merging the files of the fuzzers detected
+// in several languages or configuration files.
+func addFuzzers(detected []checker.Tool, fuzzers ...checker.Tool) []checker.Tool {
+	for i := range fuzzers {
+		j := slices.IndexFunc(detected, func(t checker.Tool) bool { return t.Name == fuzzers[i].Name })
+		if j < 0 {
+			detected = append(detected, fuzzers[i])
+			continue
 		}
+		detected[j].Files = append(detected[j].Files, fuzzers[i].Files...)
 	}
-	return checker.FuzzingData{Fuzzers: detectedFuzzers}, nil
+	return detected
 }
 
 func checkCFLite(c *checker.CheckRequest) (bool, error) {
@@ -263,43 +459,94 @@ func checkOSSFuzz(c *checker.CheckRequest) (bool, error) {
 	return result.Hits > 0, nil
 }
 
-func checkFuzzFunc(c *checker.CheckRequest, lang clients.LanguageName) (bool, []checker.File, error) {
+// checkFuzzConfigs returns the fuzzers whose configuration files are in the repository.
+func checkFuzzConfigs(c *checker.CheckRequest) ([]checker.Tool, error) {
+	var detected []checker.Tool
+	for i := range configFuzzSpecs {
+		spec := &configFuzzSpecs[i]
+		var files []checker.File
+		for _, filePattern := range spec.filePatterns {
+			err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+				Pattern:       filePattern,
+				CaseSensitive: true,
+			}, func(path string, content []byte, args ...interface{}) (bool, error) {
+				files = append(files, checker.File{
+					Path: path,
+					Type: finding.FileTypeSource,
+				})
+				return true, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error when OnMatchingFileContentDo: %w", err)
+			}
+		}
+		if len(files) > 0 {
+			detected = append(detected, checker.Tool{
+				Name:  spec.Name,
+				URL:   spec.URL,
+				Desc:  spec.Desc,
+				Files: files,
+			})
+		}
+	}
+	return detected, nil
+}
+
+// checkFuzzFunc returns the fuzzers whose fuzz functions, for the language lang,
+// are in the repository.
+func checkFuzzFunc(c *checker.CheckRequest, lang clients.LanguageName) (bool, []checker.Tool, error) {
 	if c.RepoClient == nil {
 		return false, nil, nil
 	}
-	data := filesWithPatternStr{
-		files: make([]checker.File, 0),
-	}
 	// Search language-specified fuzz func patterns in the hashmap.
-	pattern, found := languageFuzzSpecs[lang]
+	specs, found := languageFuzzSpecs[lang]
 	if !found {
 		// If the fuzz patterns for the current language not supported yet,
-		// we return it as false (not found), nil (no files), and nil (no errors).
+		// we return it as false (not found), nil (no fuzzers), and nil (no errors).
 		return false, nil, nil
 	}
-	// Get patterns for file and func.
-	// We use the file pattern in the matcher to match the test files,
-	// and put the func pattern in var data to match file contents (func names).
-	filePatterns, funcPattern := pattern.filePatterns, pattern.funcPattern
-	var dataFiles []checker.File
-	for _, filePattern := range filePatterns {
-		matcher := fileparser.PathMatcher{
-			Pattern:       filePattern,
-			CaseSensitive: false,
+	var detected []checker.Tool
+	for i := range specs {
+		spec := &specs[i]
+		// We use the file pattern in the matcher to match the test files,
+		// and put the func pattern in var data to match file contents (func names).
+		data := filesWithPatternStr{
+			pattern: spec.funcPattern,
+		}
+		for _, filePattern := range spec.filePatterns {
+			// Fuzz tests and property tests of JVM projects are in their test sources.
+			matcher := fileparser.PathMatcher{
+				Pattern:       filePattern,
+				CaseSensitive: false,
+				TestSources:   isJVMLanguage(lang),
+			}
+			err := fileparser.OnMatchingFileContentDo(c.RepoClient, matcher, getFuzzFunc, &data)
+			if err != nil {
+				return false, nil, fmt.Errorf("error when OnMatchingFileContentDo: %w", err)
+			}
 		}
-		data.pattern = funcPattern
-		err := fileparser.OnMatchingFileContentDo(c.RepoClient, matcher, getFuzzFunc, &data)
-		if err != nil {
-			return false, nil, fmt.Errorf("error when OnMatchingFileContentDo: %w", err)
+		if len(data.files) > 0 {
+			detected = addFuzzers(detected, checker.Tool{
+				Name:  spec.Name,
+				URL:   spec.URL,
+				Desc:  spec.Desc,
+				Files: data.files,
+			})
 		}
-		dataFiles = append(dataFiles, data.files...)
 	}
+	// No fuzz funcs matched for this language if detected is empty.
+	return len(detected) > 0, detected, nil
+}
 
-	if len(dataFiles) == 0 {
-		// This means no fuzz funcs matched for this language.
-		return false, nil, nil
+// isJVMLanguage returns whether lang is built with Maven or Gradle, whose test
+// sources are in src/test directories.
+func isJVMLanguage(lang clients.LanguageName) bool {
+	switch lang {
+	case clients.Java, clients.Kotlin, clients.Scala:
+		return true
+	default:
+		return false
 	}
-	return true, dataFiles, nil
 }
 
 // This is the callback func for interface OnMatchingFileContentDo
@@ -0,0 +1,274 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/fuzzers"
+)
+
+// fuzzingCITool describes how to detect a CI job running a fuzzer.
+type fuzzingCITool struct {
+	// uses matches the actions running the fuzzer, without their version.
+	uses *regexp.Regexp
+	// command matches the commands running the fuzzer.
+	command *regexp.Regexp
+	// image matches the container images running the fuzzer.
+	image  *regexp.Regexp
+	fuzzer string
+}
+
+var fuzzingCITools = []fuzzingCITool{
+	{
+		fuzzer: fuzzers.ClusterFuzzLite,
+		uses:   regexp.MustCompile(`^google/clusterfuzzlite/actions/run_fuzzers$`),
+		image:  regexp.MustCompile(`^gcr\.io/oss-fuzz-base/clusterfuzzlite-run-fuzzers([:@]|$)`),
+	},
+	{
+		// CIFuzz runs the fuzzers of projects integrated with OSS-Fuzz on pull requests.
+		fuzzer: fuzzers.OSSFuzz,
+		uses:   regexp.MustCompile(`^google/oss-fuzz/infra/cifuzz/actions/run_fuzzers$`),
+	},
+	{
+		fuzzer:  fuzzers.BuiltInGo,
+		command: regexp.MustCompile(`\bgo\s+test\b[^\n;&|]*\s-(test\.)?fuzz[= ]\S`),
+	},
+	{
+		fuzzer:  fuzzers.RustCargoFuzz,
+		command: regexp.MustCompile(`\bcargo\s+(\+\S+\s+)?fuzz\s+run\b`),
+	},
+	{
+		fuzzer: fuzzers.AFLPlusPlus,
+		command: regexp.MustCompile(`(?m)(^\s*|[;&|(]\s*|(\bexec|\btimeout\s+\S+)\s+|/)afl-fuzz(\s|$)|` +
+			`\bcargo\s+afl\s+fuzz\b`),
+		image: regexp.MustCompile(`^(docker\.io/)?aflplusplus/aflplusplus([:@]|$)`),
+	},
+	{
+		fuzzer: fuzzers.Honggfuzz,
+		command: regexp.MustCompile(`(?m)(^\s*|[;&|(]\s*|(\bexec|\btimeout\s+\S+)\s+|/)honggfuzz\s|` +
+			`\bcargo\s+hfuzz\s+run\b`),
+	},
+	{
+		fuzzer:  fuzzers.JavaJazzerFuzzer,
+		command: regexp.MustCompile(`(?m)(^\s*|[;&|(]\s*|/)jazzer\s|\bjazzer_standalone\.jar\b`),
+	},
+	{
+		fuzzer:  fuzzers.JavaScriptJazzerFuzzer,
+		command: regexp.MustCompile(`\bnpx\s+jazzer\s`),
+	},
+	{
+		fuzzer:  fuzzers.Mayhem,
+		uses:    regexp.MustCompile(`^ForAllSecure/(mcode|mapi)-action$`),
+		command: regexp.MustCompile(`\bmayhem\s+run\b`),
+	},
+	{
+		fuzzer:  fuzzers.CIFuzz,
+		uses:    regexp.MustCompile(`^CodeIntelligenceTesting/github-actions/(start-fuzzing|run-fuzz-tests)$`),
+		command: regexp.MustCompile(`\bcifuzz\s+(run|remote-run|container\s+(run|remote-run))\b`),
+	},
+}
+
+// fuzzingJobsFound records the fuzzers found in a CI job, so that each is reported once per job.
+type fuzzingJobsFound struct {
+	jobs  *[]checker.FuzzingJob
+	found map[string]bool
+}
+
+func (f *fuzzingJobsFound) add(fuzzer, path string, line uint, snippet string) {
+	if f.found[fuzzer] {
+		return
+	}
+	f.found[fuzzer] = true
+	*f.jobs = append(*f.jobs, checker.FuzzingJob{
+		Fuzzer: fuzzer,
+		File: checker.File{
+			Path:    path,
+			Type:    finding.FileTypeSource,
+			Offset:  line,
+			Snippet: snippet,
+		},
+	})
+}
+
+func (f *fuzzingJobsFound) matchUses(uses, path string, line uint) {
+	action, _, _ := strings.Cut(uses, "@")
+	for i := range fuzzingCITools {
+		t := &fuzzingCITools[i]
+		if t.uses != nil && t.uses.MatchString(action) {
+			f.add(t.fuzzer, path, line, uses)
+		}
+	}
+}
+
+func (f *fuzzingJobsFound) matchCommand(command, path string, line uint) {
+	for i := range fuzzingCITools {
+		t := &fuzzingCITools[i]
+		if t.command == nil {
+			continue
+		}
+		if loc := t.command.FindStringIndex(command); loc != nil {
+			f.add(t.fuzzer, path, line, commandLine(command, loc[0]))
+		}
+	}
+}
+
+func (f *fuzzingJobsFound) matchImage(image, path string, line uint) {
+	for i := range fuzzingCITools {
+		t := &fuzzingCITools[i]
+		if t.image != nil && t.image.MatchString(image) {
+			f.add(t.fuzzer, path, line, image)
+		}
+	}
+}
+
+// getFuzzingJobs returns the jobs of GitHub workflows and GitLab CI pipelines running fuzzers.
+func getFuzzingJobs(c clients.RepoClient, dl checker.DetailLogger) ([]checker.FuzzingJob, error) {
+	var jobs []checker.FuzzingJob
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
+		CaseSensitive: false,
+	}, searchGitHubWorkflowFuzzingJobs, &jobs, dl)
+	if err != nil {
+		return nil, err
+	}
+	err = fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       fileparser.GitLabCIFile,
+		CaseSensitive: true,
+	}, searchGitLabCIFuzzingJobs, &jobs, c)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// searchGitHubWorkflowFuzzingJobs finds the jobs of GitHub workflows running fuzzers,
+// with actions, commands or container images.
+var searchGitHubWorkflowFuzzingJobs fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowFuzzingJobs requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	jobs, ok := args[0].(*[]checker.FuzzingJob)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowFuzzingJobs expects arg[0] of type *[]checker.FuzzingJob: %w", errInvalidArgType)
+	}
+	dl, ok := args[1].(checker.DetailLogger)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowFuzzingJobs expects arg[1] of type checker.DetailLogger: %w", errInvalidArgType)
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		// A workflow GitHub can't run doesn't fuzz, and shouldn't fail the check.
+		dl.Debug(&checker.LogMessage{
+			Path: path,
+			Type: finding.FileTypeSource,
+			Text: fmt.Sprintf("skipping workflow: %v", fileparser.FormatActionlintError(errs)),
+		})
+		return true, nil
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		found := &fuzzingJobsFound{jobs: jobs, found: make(map[string]bool)}
+		if job.Container != nil && job.Container.Image != nil {
+			found.matchImage(job.Container.Image.Value, path, fileparser.GetLineNumber(job.Container.Image.Pos))
+		}
+		for _, step := range job.Steps {
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				if e.Uses == nil {
+					continue
+				}
+				line := fileparser.GetLineNumber(e.Uses.Pos)
+				if image, ok := strings.CutPrefix(e.Uses.Value, "docker://"); ok {
+					found.matchImage(image, path, line)
+					continue
+				}
+				found.matchUses(e.Uses.Value, path, line)
+			case *actionlint.ExecRun:
+				if e.Run != nil {
+					found.matchCommand(e.Run.Value, path, fileparser.GetLineNumber(e.Run.Pos))
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// searchGitLabCIFuzzingJobs finds the jobs of the GitLab CI configuration running fuzzers,
+// with commands or container images.
+var searchGitLabCIFuzzingJobs fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, _ := fileparser.IsGitLabCIFile(path); !ok {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"searchGitLabCIFuzzingJobs requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	jobs, ok := args[0].(*[]checker.FuzzingJob)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCIFuzzingJobs expects arg[0] of type *[]checker.FuzzingJob: %w", errInvalidArgType)
+	}
+	client, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCIFuzzingJobs expects arg[1] of type clients.RepoClient: %w", errInvalidArgType)
+	}
+
+	pipeline, err := fileparser.ParseGitLabCI(client, path, content)
+	if err != nil {
+		// Invalid configurations are reported by Pinned-Dependencies, and don't run any job.
+		return true, nil
+	}
+
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		found := &fuzzingJobsFound{jobs: jobs, found: make(map[string]bool)}
+		for _, image := range job.Images {
+			found.matchImage(image.Value, image.Path, image.Line)
+		}
+		for _, command := range job.Commands {
+			found.matchCommand(command.Value, command.Path, command.Line)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/fuzzers"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestGetFuzzingJobs(t *testing.T) {
+	t.Parallel()
+	client := mockRepoFiles(t, "testdata/fuzzing-jobs")
+	dl := scut.TestDetailLogger{}
+	got, err := getFuzzingJobs(client, &dl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The invalid workflow is skipped.
+	if details := dl.Flush(); len(details) != 1 || details[0].Msg.Path != ".github/workflows/invalid.yml" {
+		t.Errorf("unexpected details: %v", details)
+	}
+	want := []checker.FuzzingJob{
+		{
+			Fuzzer: fuzzers.ClusterFuzzLite,
+			File: checker.File{
+				Path:    ".github/workflows/cflite.yml",
+				Offset:  16,
+				Snippet: "google/clusterfuzzlite/actions/run_fuzzers@v1",
+			},
+		},
+		{
+			Fuzzer: fuzzers.BuiltInGo,
+			File: checker.File{
+				Path:    ".github/workflows/fuzz.yml",
+				Offset:  12,
+				Snippet: "go test ./parser -run '^$' -fuzz=FuzzParse -fuzztime=10m",
+			},
+		},
+		{
+			Fuzzer: fuzzers.Mayhem,
+			File: checker.File{
+				Path:    ".github/workflows/fuzz.yml",
+				Offset:  24,
+				Snippet: "ForAllSecure/mcode-action@v1",
+			},
+		},
+		{
+			Fuzzer: fuzzers.RustCargoFuzz,
+			File: checker.File{
+				Path:    ".github/workflows/fuzz.yml",
+				Offset:  20,
+				Snippet: "cargo +nightly fuzz run parse -- -max_total_time=600",
+			},
+		},
+		{
+			Fuzzer: fuzzers.AFLPlusPlus,
+			File: checker.File{
+				Path:    ".gitlab-ci.yml",
+				Offset:  2,
+				Snippet: "aflplusplus/aflplusplus:v4.21c",
+			},
+		},
+		{
+			Fuzzer: fuzzers.Honggfuzz,
+			File: checker.File{
+				Path:    ".gitlab-ci.yml",
+				Offset:  10,
+				Snippet: "honggfuzz --run_time 600 -i corpus -- ./fuzz/parse ___FILE___",
+			},
+		},
+	}
+	for i := range want {
+		want[i].File.Type = finding.FileTypeSource
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
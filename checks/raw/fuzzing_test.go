@@ -23,10 +23,12 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/internal/fuzzers"
 )
 
 // Test_checkOSSFuzz is a test function for checkOSSFuzz.
@@ -216,14 +218,16 @@ func Test_fuzzFileAndFuncMatchPattern(t *testing.T) {
 				t.Errorf("retrieve supported language error")
 			}
 			var found bool
-			for _, fileMatchPattern := range langSpecs.filePatterns {
-				fileMatch, err := path.Match(fileMatchPattern, tt.fileName)
-				if (fileMatch != tt.expectedFileMatch || err != nil) && !tt.wantErr {
-					t.Errorf("fileMatch = %v, want %v for %v", fileMatch, tt.expectedFileMatch, tt.name)
+			for _, langSpec := range langSpecs {
+				for _, fileMatchPattern := range langSpec.filePatterns {
+					fileMatch, err := path.Match(fileMatchPattern, tt.fileName)
+					if (fileMatch != tt.expectedFileMatch || err != nil) && !tt.wantErr {
+						t.Errorf("fileMatch = %v, want %v for %v", fileMatch, tt.expectedFileMatch, tt.name)
+					}
+					funcRegexPattern := langSpec.funcPattern
+					r := regexp.MustCompile(funcRegexPattern)
+					found = found || r.MatchString(tt.fileContent)
 				}
-				funcRegexPattern := langSpecs.funcPattern
-				r := regexp.MustCompile(funcRegexPattern)
-				found = found || r.MatchString(tt.fileContent)
 			}
 
 			if (found != tt.expectedFuncMatch) && !tt.wantErr {
@@ -603,6 +607,225 @@ func Test_checkFuzzFunc(t *testing.T) {
 	}
 }
 
+func Test_checkFuzzFuncFuzzers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		lang        clients.LanguageName
+		fileName    string
+		fileContent string
+		want        []string
+	}{
+		{
+			name:        "C AFL++ persistent mode",
+			lang:        clients.C,
+			fileName:    "fuzz/parse.c",
+			fileContent: "int main(void) {\n  while (__AFL_LOOP(10000)) {\n    parse(buf, len);\n  }\n}",
+			want:        []string{fuzzers.AFLPlusPlus},
+		},
+		{
+			name:     "C++ libFuzzer harness with honggfuzz",
+			lang:     clients.Cpp,
+			fileName: "fuzz/parse.cc",
+			fileContent: "#include <libhfuzz/libhfuzz.h>\n" +
+				"extern \"C\" int LLVMFuzzerTestOneInput(const uint8_t *data, size_t size) { return 0; }",
+			want: []string{fuzzers.CppLibFuzzer, fuzzers.Honggfuzz},
+		},
+		{
+			name:        "C++ CI Fuzz test",
+			lang:        clients.Cpp,
+			fileName:    "fuzz/parse_fuzz_test.cpp",
+			fileContent: "#include <cifuzz/cifuzz.h>\nFUZZ_TEST(const uint8_t *data, size_t size) {}",
+			want:        []string{fuzzers.CIFuzz},
+		},
+		{
+			name:        "Rust afl.rs",
+			lang:        clients.Rust,
+			fileName:    "fuzz/src/main.rs",
+			fileContent: "fn main() {\n    afl::fuzz!(|data: &[u8]| { parse(data); });\n}",
+			want:        []string{fuzzers.AFLPlusPlus},
+		},
+		{
+			name:        "Rust honggfuzz-rs",
+			lang:        clients.Rust,
+			fileName:    "hfuzz/src/main.rs",
+			fileContent: "use honggfuzz::fuzz;",
+			want:        []string{fuzzers.Honggfuzz},
+		},
+		{
+			name:        "Rust proptest",
+			lang:        clients.Rust,
+			fileName:    "src/lib.rs",
+			fileContent: "#[cfg(test)]\nmod tests {\n    use proptest::prelude::*;\n}",
+			want:        []string{fuzzers.PropertyBasedRust},
+		},
+		{
+			name:        "Rust quickcheck attribute",
+			lang:        clients.Rust,
+			fileName:    "src/lib.rs",
+			fileContent: "#[quickcheck]\nfn reverse_twice(xs: Vec<u32>) -> bool { true }",
+			want:        []string{fuzzers.PropertyBasedRust},
+		},
+		{
+			name:        "Python Hypothesis",
+			lang:        clients.Python,
+			fileName:    "tests/test_parse.py",
+			fileContent: "from hypothesis import given\nfrom hypothesis import strategies as st",
+			want:        []string{fuzzers.PropertyBasedPython},
+		},
+		{
+			name:        "Python hypothesis in a comment",
+			lang:        clients.Python,
+			fileName:    "tests/test_parse.py",
+			fileContent: "# We could import hypothesis here.",
+		},
+		{
+			name:        "Java jqwik",
+			lang:        clients.Java,
+			fileName:    "src/test/java/ParserProperties.java",
+			fileContent: "import net.jqwik.api.*;",
+			want:        []string{fuzzers.PropertyBasedJava},
+		},
+		{
+			name:        "Python Hypothesis in Java test sources",
+			lang:        clients.Python,
+			fileName:    "src/test/resources/gen_fixtures.py",
+			fileContent: "from hypothesis import given",
+		},
+		{
+			name:        "JavaScript Jazzer.js fuzz target",
+			lang:        clients.JavaScript,
+			fileName:    "fuzz/parse.fuzz.js",
+			fileContent: "module.exports.fuzz = function (data) {\n  parse(data.toString());\n};",
+			want:        []string{fuzzers.JavaScriptJazzerFuzzer},
+		},
+		{
+			name:        "TypeScript Jazzer.js Jest integration",
+			lang:        clients.TypeScript,
+			fileName:    "fuzz/parse.fuzz.ts",
+			fileContent: "import \"@jazzer.js/jest-runner\";",
+			want:        []string{fuzzers.JavaScriptJazzerFuzzer},
+		},
+		{
+			name:        "C# FsCheck",
+			lang:        clients.CSharp,
+			fileName:    "Tests/ParserProperties.cs",
+			fileContent: "using FsCheck;\nusing FsCheck.Xunit;",
+			want:        []string{fuzzers.PropertyBasedDotNet},
+		},
+		{
+			name:        "F# FsCheck",
+			lang:        clients.FSharp,
+			fileName:    "Tests/ParserProperties.fs",
+			fileContent: "open FsCheck",
+			want:        []string{fuzzers.PropertyBasedDotNet},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockClient := mockrepo.NewMockRepoClient(ctrl)
+			mockClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					if ok, err := predicate(tt.fileName); err != nil || !ok {
+						return nil, err
+					}
+					return []string{tt.fileName}, nil
+				}).AnyTimes()
+			mockClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.fileContent)), nil
+			}).AnyTimes()
+			req := checker.CheckRequest{
+				RepoClient: mockClient,
+			}
+			found, tools, err := checkFuzzFunc(&req, tt.lang)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, tool := range tools {
+				got = append(got, tool.Name)
+				for _, f := range tool.Files {
+					if f.Path != tt.fileName {
+						t.Errorf("%s reported in %s, want %s", tool.Name, f.Path, tt.fileName)
+					}
+				}
+			}
+			if found != (len(tt.want) > 0) {
+				t.Errorf("checkFuzzFunc() = %v, want %v", found, len(tt.want) > 0)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_checkFuzzConfigs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		files []string
+		want  map[string][]string
+	}{
+		{
+			name:  "Mayhemfile",
+			files: []string{"Mayhemfile", "src/main.c"},
+			want:  map[string][]string{fuzzers.Mayhem: {"Mayhemfile"}},
+		},
+		{
+			name:  "CI Fuzz",
+			files: []string{"cifuzz.yaml", "fuzz/Mayhemfile.yml"},
+			want: map[string][]string{
+				fuzzers.Mayhem: {"fuzz/Mayhemfile.yml"},
+				fuzzers.CIFuzz: {"cifuzz.yaml"},
+			},
+		},
+		{
+			name:  "no configuration",
+			files: []string{"README.md", "mayhem.md"},
+			want:  map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockClient := mockrepo.NewMockRepoClient(ctrl)
+			mockClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var matched []string
+					for _, f := range tt.files {
+						if ok, err := predicate(f); err != nil || ok {
+							matched = append(matched, f)
+						}
+					}
+					return matched, nil
+				}).AnyTimes()
+			mockClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("")), nil
+			}).AnyTimes()
+			req := checker.CheckRequest{
+				RepoClient: mockClient,
+			}
+			tools, err := checkFuzzConfigs(&req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := map[string][]string{}
+			for _, tool := range tools {
+				for _, f := range tool.Files {
+					got[tool.Name] = append(got[tool.Name], f.Path)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_getProminentLanguages(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
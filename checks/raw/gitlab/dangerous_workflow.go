@@ -0,0 +1,215 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+var (
+	// untrustedVariable matches the predefined variables an attacker controls,
+	// through the title or description of a merge request, or a commit message,
+	// branch or tag name. Slugs are sanitized by GitLab.
+	// See https://docs.gitlab.com/ee/ci/variables/predefined_variables.html.
+	untrustedVariable = regexp.MustCompile(`(\$\{?(env:)?|%)(` +
+		`CI_MERGE_REQUEST_TITLE|` +
+		`CI_MERGE_REQUEST_DESCRIPTION|` +
+		`CI_MERGE_REQUEST_SOURCE_BRANCH_NAME|` +
+		`CI_EXTERNAL_PULL_REQUEST_SOURCE_BRANCH_NAME|` +
+		`CI_COMMIT_MESSAGE|` +
+		`CI_COMMIT_TITLE|` +
+		`CI_COMMIT_DESCRIPTION|` +
+		`CI_COMMIT_TAG_MESSAGE|` +
+		`CI_COMMIT_AUTHOR|` +
+		`CI_COMMIT_BRANCH|` +
+		`CI_COMMIT_REF_NAME|` +
+		`CI_COMMIT_TAG)\b`)
+
+	// evaluatingCommand matches the commands which run their arguments as code.
+	// Unlike GitHub expressions, GitLab variables are passed to the shell as
+	// environment variables, so they are only dangerous when evaluated again.
+	evaluatingCommand = regexp.MustCompile(`(?i)(^|[\s;&|(\x60])(` +
+		`eval|` +
+		`iex|` +
+		`invoke-expression|` +
+		`(ba|da|k|z)?sh\s+(-\w+\s+)*-c|` +
+		`(pwsh|powershell)(\.exe)?\s+(-\w+\s+)*-c(ommand)?|` +
+		`cmd(\.exe)?\s+/c|` +
+		`(python[0-9.]*|node|perl|ruby|php)\s+(-\w+\s+)*-[ce]` +
+		`)(\s|$)`)
+
+	// sameProject matches the conditions restricting merge request pipelines
+	// to the branches of the project, excluding forks.
+	sameProject = regexp.MustCompile(
+		`CI_MERGE_REQUEST_SOURCE_PROJECT_(ID|PATH)\s*==\s*\$\{?CI_PROJECT_(ID|PATH)|` +
+			`CI_PROJECT_(ID|PATH)\}?\s*==\s*\$\{?CI_MERGE_REQUEST_SOURCE_PROJECT_(ID|PATH)`)
+	forkProject = regexp.MustCompile(
+		`CI_MERGE_REQUEST_SOURCE_PROJECT_(ID|PATH)\s*!=\s*\$\{?CI_PROJECT_(ID|PATH)|` +
+			`CI_PROJECT_(ID|PATH)\}?\s*!=\s*\$\{?CI_MERGE_REQUEST_SOURCE_PROJECT_(ID|PATH)`)
+	mergeRequestPipeline = regexp.MustCompile(`merge_request_event|CI_MERGE_REQUEST_`)
+)
+
+// credentialKeywords give jobs access to credentials: secrets from external
+// providers, OIDC tokens, and the protected variables of environments.
+var credentialKeywords = []string{"secrets", "id_tokens", "environment"}
+
+// DangerousWorkflow retrieves the dangerous patterns of the GitLab CI configuration.
+func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData, error) {
+	var data checker.DangerousWorkflowData
+	matchedFiles, err := c.RepoClient.ListFiles(fileparser.IsGitLabCIFile)
+	if err != nil {
+		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	for _, fp := range matchedFiles {
+		fr, err := c.RepoClient.GetFileReader(fp)
+		if err != nil {
+			return data, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		fc, err := io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return data, fmt.Errorf("reading from file: %w", err)
+		}
+		if !fileparser.CheckFileContainsCommands(fc, "#") {
+			continue
+		}
+
+		pipeline, err := fileparser.ParseGitLabCI(c.RepoClient, fp, fc)
+		if err != nil {
+			return data, fmt.Errorf("parsing GitLab CI: %w", err)
+		}
+		data.NumWorkflows++
+		data.Workflows = append(data.Workflows, scriptInjections(pipeline)...)
+		data.Workflows = append(data.Workflows, untrustedMergeRequests(pipeline)...)
+	}
+	return data, nil
+}
+
+// scriptInjections returns the commands evaluating attacker controlled variables.
+// Commands of templates shared by several jobs are reported once.
+func scriptInjections(pipeline *fileparser.GitLabCIPipeline) []checker.DangerousWorkflow {
+	var workflows []checker.DangerousWorkflow
+	reported := map[fileparser.GitLabCIValue]bool{}
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for _, command := range job.Script {
+			if reported[command] || !evaluatingCommand.MatchString(command.Value) {
+				continue
+			}
+			variable := untrustedVariable.FindString(command.Value)
+			if variable == "" {
+				continue
+			}
+			reported[command] = true
+			workflows = append(workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowScriptInjection,
+				File: checker.File{
+					Path:    command.Path,
+					Type:    finding.FileTypeSource,
+					Offset:  command.Line,
+					Snippet: strings.TrimSpace(command.Value),
+				},
+				Job: createJob(job),
+			})
+		}
+	}
+	return workflows
+}
+
+// untrustedMergeRequests returns the jobs with access to credentials which
+// run the code of merge requests from forks.
+func untrustedMergeRequests(pipeline *fileparser.GitLabCIPipeline) []checker.DangerousWorkflow {
+	var workflows []checker.DangerousWorkflow
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		if !hasCredentials(job) {
+			continue
+		}
+		trigger, ok := forkMergeRequestTrigger(job, pipeline.WorkflowRules)
+		if !ok {
+			continue
+		}
+		workflows = append(workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowUntrustedCheckout,
+			File: checker.File{
+				Path:    trigger.Path,
+				Type:    finding.FileTypeSource,
+				Offset:  trigger.Line,
+				Snippet: trigger.Value,
+			},
+			Job: createJob(job),
+		})
+	}
+	return workflows
+}
+
+func hasCredentials(job *fileparser.GitLabCIJob) bool {
+	for _, keyword := range credentialKeywords {
+		if job.Has(keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// forkMergeRequestTrigger returns the condition running a job for merge requests
+// from forks, if any. Jobs without rules follow the rules of the workflow.
+func forkMergeRequestTrigger(job *fileparser.GitLabCIJob,
+	workflowRules []fileparser.GitLabCIRule,
+) (fileparser.GitLabCIValue, bool) {
+	for _, ref := range job.Only {
+		if ref.Value == "merge_requests" {
+			return ref, true
+		}
+	}
+	if job.Has("rules") {
+		return forkMergeRequestRule(job.Rules)
+	}
+	if job.Has("only") {
+		return fileparser.GitLabCIValue{}, false
+	}
+	return forkMergeRequestRule(workflowRules)
+}
+
+// forkMergeRequestRule returns the first rule matching merge requests from forks.
+// Rules are evaluated in order, so forks can be excluded by an earlier rule.
+func forkMergeRequestRule(rules []fileparser.GitLabCIRule) (fileparser.GitLabCIValue, bool) {
+	for _, rule := range rules {
+		cond := rule.If.Value
+		if rule.When == "never" {
+			if forkProject.MatchString(cond) {
+				return fileparser.GitLabCIValue{}, false
+			}
+			continue
+		}
+		if mergeRequestPipeline.MatchString(cond) && !sameProject.MatchString(cond) {
+			return rule.If, true
+		}
+	}
+	return fileparser.GitLabCIValue{}, false
+}
+
+func createJob(job *fileparser.GitLabCIJob) *checker.WorkflowJob {
+	name := job.Name
+	return &checker.WorkflowJob{Name: &name, ID: &name}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// mockRepoFiles serves the files of a testdata directory as a repository.
+func mockRepoFiles(t *testing.T, dir string) clients.RepoClient {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("listing %s: %v", dir, err)
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+		func(predicate func(string) (bool, error)) ([]string, error) {
+			var matched []string
+			for _, f := range files {
+				if ok, err := predicate(f); err != nil || ok {
+					matched = append(matched, f)
+				}
+			}
+			return matched, nil
+		}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+		return os.Open(filepath.Join(dir, f))
+	}).AnyTimes()
+	return mockRepoClient
+}
+
+func TestDangerousWorkflow(t *testing.T) {
+	t.Parallel()
+	dangerousWorkflow := func(dwType checker.DangerousWorkflowType, job, path string, line uint,
+		snippet string,
+	) checker.DangerousWorkflow {
+		return checker.DangerousWorkflow{
+			Type: dwType,
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  line,
+				Snippet: snippet,
+			},
+			Job: &checker.WorkflowJob{Name: &job, ID: &job},
+		}
+	}
+	tests := []struct {
+		name string
+		dir  string
+		want checker.DangerousWorkflowData
+	}{
+		{
+			name: "no pipeline",
+			dir:  "./testdata/no-pipeline",
+		},
+		{
+			name: "includes, extends, anchors and references",
+			dir:  "./testdata/dangerous-workflow",
+			want: checker.DangerousWorkflowData{
+				NumWorkflows: 1,
+				Workflows: []checker.DangerousWorkflow{
+					dangerousWorkflow(checker.DangerousWorkflowScriptInjection, "lint",
+						".gitlab-ci.yml", 14, `eval "echo Built $CI_COMMIT_TITLE"`),
+					dangerousWorkflow(checker.DangerousWorkflowScriptInjection, "changelog",
+						"ci/templates.yml", 4, `sh -c "echo '* ${CI_MERGE_REQUEST_DESCRIPTION}' >> CHANGELOG.md"`),
+					dangerousWorkflow(checker.DangerousWorkflowScriptInjection, "release-notes",
+						".gitlab-ci.yml", 33, `python3 -c "print('$CI_COMMIT_MESSAGE')"`),
+					dangerousWorkflow(checker.DangerousWorkflowUntrustedCheckout, "deploy-review",
+						"ci/jobs/deploy.yml", 4, `$CI_PIPELINE_SOURCE == "merge_request_event"`),
+					dangerousWorkflow(checker.DangerousWorkflowUntrustedCheckout, "publish",
+						".gitlab-ci.yml", 9, `$CI_PIPELINE_SOURCE == "merge_request_event"`),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := &checker.CheckRequest{RepoClient: mockRepoFiles(t, tt.dir)}
+			got, err := DangerousWorkflow(req)
+			if err != nil {
+				t.Fatalf("DangerousWorkflow: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DangerousWorkflow() (-want,+got): %s", diff)
+			}
+		})
+	}
+}
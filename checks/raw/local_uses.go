@@ -0,0 +1,378 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// maxLocalUsesDepth bounds how deep reusable workflows and composite actions are followed.
+const maxLocalUsesDepth = 10
+
+var inputsContextRegex = regexp.MustCompile(`\binputs\.([A-Za-z_][\w-]*)`)
+
+// localUses follows the composite actions and reusable workflows that workflows use with
+// `uses: ./path`. They run in the context of the calling workflow, so patterns that depend on
+// the workflow triggers or on the values of inputs can only be found from the caller.
+type localUses struct {
+	resolver *fileparser.LocalUsesResolver
+	// analyzed are the composite actions already checked for untrusted contexts. Unlike
+	// reusable workflows, composite actions aren't checked on their own.
+	analyzed map[string]bool
+	// active are the composite actions and reusable workflows being followed, to stop on cycles.
+	active map[string]bool
+}
+
+func newLocalUses(client clients.RepoClient) *localUses {
+	return &localUses{
+		resolver: fileparser.NewLocalUsesResolver(client),
+		analyzed: make(map[string]bool),
+		active:   make(map[string]bool),
+	}
+}
+
+// localCall is a call to a composite action or reusable workflow.
+type localCall struct {
+	// caller is the `uses` of the step or job making the call.
+	caller *checker.File
+	// inputs are the inputs of the call holding untrusted data, mapped to the untrusted expression.
+	inputs map[string]string
+	// untrustedTrigger is whether the calling workflow runs on events of forks with a privileged token.
+	untrustedTrigger bool
+	// direct is whether to report untrusted contexts used directly by the callee.
+	direct bool
+}
+
+func validateLocalUses(workflow *actionlint.Workflow, path string, l *localUses,
+	pdata *checker.DangerousWorkflowData,
+) error {
+	untrustedTrigger := usesEventTrigger(workflow, triggerPullRequestTarget) ||
+		usesEventTrigger(workflow, triggerWorkflowRun)
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		if err := l.followJob(workflow.Jobs[id], path, untrustedTrigger, nil, 0, pdata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *localUses) followJob(job *actionlint.Job, path string, untrustedTrigger bool,
+	inputs map[string]string, depth int, pdata *checker.DangerousWorkflowData,
+) error {
+	if job == nil || depth >= maxLocalUsesDepth {
+		return nil
+	}
+	if job.WorkflowCall != nil && job.WorkflowCall.Uses != nil {
+		workflow, calleePath := l.resolver.ReusableWorkflow(job.WorkflowCall.Uses.Value)
+		if workflow != nil && !l.active[calleePath] {
+			with := make(map[string]string)
+			for name, input := range job.WorkflowCall.Inputs {
+				if input != nil && input.Value != nil {
+					with[name] = input.Value.Value
+				}
+			}
+			call := localCall{
+				caller:           usesLocation(path, job.WorkflowCall.Uses),
+				inputs:           taintedInputs(with, inputs),
+				untrustedTrigger: untrustedTrigger,
+			}
+			l.active[calleePath] = true
+			err := l.followReusableWorkflow(workflow, calleePath, &call, depth, pdata)
+			delete(l.active, calleePath)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return l.followSteps(job.Steps, path, job, untrustedTrigger, inputs, depth, pdata)
+}
+
+func (l *localUses) followReusableWorkflow(workflow *actionlint.Workflow, path string, call *localCall,
+	depth int, pdata *checker.DangerousWorkflowData,
+) error {
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		if err := checkCalledSteps(job.Steps, path, job, call, pdata); err != nil {
+			return err
+		}
+		if err := l.followJob(job, path, call.untrustedTrigger, call.inputs, depth+1, pdata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *localUses) followSteps(steps []*actionlint.Step, path string, job *actionlint.Job,
+	untrustedTrigger bool, inputs map[string]string, depth int, pdata *checker.DangerousWorkflowData,
+) error {
+	if depth >= maxLocalUsesDepth {
+		return nil
+	}
+	for _, step := range steps {
+		uses := fileparser.GetUses(step)
+		if uses == nil {
+			continue
+		}
+		action := l.resolver.CompositeAction(uses.Value)
+		if action == nil || l.active[action.Path] {
+			continue
+		}
+		call := localCall{
+			caller:           usesLocation(path, uses),
+			inputs:           taintedInputs(actionInputs(step), inputs),
+			untrustedTrigger: untrustedTrigger,
+			direct:           !l.analyzed[action.Path],
+		}
+		l.analyzed[action.Path] = true
+		l.active[action.Path] = true
+		err := checkCalledSteps(action.Steps, action.Path, job, &call, pdata)
+		if err == nil {
+			err = l.followSteps(action.Steps, action.Path, job, untrustedTrigger, call.inputs, depth+1, pdata)
+		}
+		delete(l.active, action.Path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCalledSteps checks the steps of a composite action or reusable workflow in the
+// context of the call.
+func checkCalledSteps(steps []*actionlint.Step, path string, job *actionlint.Job, call *localCall,
+	pdata *checker.DangerousWorkflowData,
+) error {
+	isUntrusted := func(variable string) bool {
+		if call.direct && containsUntrustedContextPattern(variable) {
+			return true
+		}
+		origin, ok := taintedInputReference(variable, call.inputs)
+		return ok && containsUntrustedContextPattern(origin)
+	}
+	for _, step := range steps {
+		if step == nil || step.Exec == nil {
+			continue
+		}
+		if call.untrustedTrigger {
+			if ref, ok := calledCheckoutRef(step, call.inputs); ok {
+				pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowUntrustedCheckout,
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  fileparser.GetLineNumber(step.Pos),
+						Snippet: ref,
+					},
+					Job:    createJob(job),
+					Caller: call.caller,
+				})
+			}
+		}
+		run, ok := step.Exec.(*actionlint.ExecRun)
+		if !ok || run.Run == nil {
+			continue
+		}
+		if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path,
+			isUntrusted, call.caller, pdata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// calledCheckoutRef returns the ref of a step checking out the code of a PR, either directly
+// or through an input of the call.
+func calledCheckoutRef(step *actionlint.Step, inputs map[string]string) (string, bool) {
+	if ref, ok := untrustedCheckoutRef(step); ok {
+		return ref, true
+	}
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil || !strings.Contains(e.Uses.Value, "actions/checkout") {
+		return "", false
+	}
+	ref, ok := e.Inputs["ref"]
+	if !ok || ref.Value == nil {
+		return "", false
+	}
+	if origin, ok := taintedInputReference(ref.Value.Value, inputs); ok && isUntrustedRef(origin) {
+		return ref.Value.Value, true
+	}
+	return "", false
+}
+
+func isUntrustedRef(value string) bool {
+	return strings.Contains(value, checkoutUntrustedPullRequestRef) ||
+		strings.Contains(value, checkoutUntrustedWorkflowRunRef)
+}
+
+// taintedInputs returns the inputs of a call holding untrusted data, mapped to the untrusted
+// expression. parent are the tainted inputs of the workflow or action making the call.
+func taintedInputs(with, parent map[string]string) map[string]string {
+	tainted := make(map[string]string)
+	for name, value := range with {
+		name = strings.ToLower(name)
+		if containsUntrustedContextPattern(value) || isUntrustedRef(value) {
+			tainted[name] = value
+			continue
+		}
+		if origin, ok := taintedInputReference(value, parent); ok {
+			tainted[name] = origin
+		}
+	}
+	return tainted
+}
+
+// taintedInputReference returns the untrusted expression behind the first tainted input
+// referenced by expr.
+func taintedInputReference(expr string, tainted map[string]string) (string, bool) {
+	for _, m := range inputsContextRegex.FindAllStringSubmatch(expr, -1) {
+		if origin, ok := tainted[strings.ToLower(m[1])]; ok {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+func actionInputs(step *actionlint.Step) map[string]string {
+	with := make(map[string]string)
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok {
+		return with
+	}
+	for name, input := range e.Inputs {
+		if input != nil && input.Value != nil {
+			with[name] = input.Value.Value
+		}
+	}
+	return with
+}
+
+func usesLocation(path string, uses *actionlint.String) *checker.File {
+	return &checker.File{
+		Path:    path,
+		Type:    finding.FileTypeSource,
+		Offset:  fileparser.GetLineNumber(uses.Pos),
+		Snippet: uses.Value,
+	}
+}
+
+// collectLocalActionPinning checks the dependencies of the composite actions that workflows use
+// with `uses: ./path`. Reusable workflows are workflow files, so they're checked on their own.
+func collectLocalActionPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
+		CaseSensitive: false,
+	}, validateLocalActionPinning, newLocalUses(c.RepoClient), r)
+}
+
+var validateLocalActionPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(pathfn) {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateLocalActionPinning requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	l, ok := args[0].(*localUses)
+	if !ok {
+		return false, fmt.Errorf("validateLocalActionPinning expects arg[0] of type *localUses: %w", errInvalidArgType)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[1])
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		l.resolver.WalkCompositeActions(pathfn, job.Steps,
+			func(callerPath string, step *actionlint.Step, action *fileparser.CompositeAction) {
+				if l.analyzed[action.Path] {
+					return
+				}
+				l.analyzed[action.Path] = true
+				validateCompositeActionPinning(action, usesLocation(callerPath, fileparser.GetUses(step)), pdata)
+			})
+	}
+	return true, nil
+}
+
+func validateCompositeActionPinning(action *fileparser.CompositeAction, caller *checker.File,
+	pdata *checker.PinningDependenciesData,
+) {
+	githubVarRegex := regexp.MustCompile(`{{[^{}]*}}`)
+	taintedFiles := make(map[string]bool)
+	first := len(pdata.Dependencies)
+	for _, step := range action.Steps {
+		switch exec := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			// Local actions are followed rather than pinned.
+			if exec.Uses == nil || strings.HasPrefix(exec.Uses.Value, "./") {
+				continue
+			}
+			pdata.Dependencies = append(pdata.Dependencies, actionDependency(action.Path, exec.Uses))
+		case *actionlint.ExecRun:
+			// Run steps of composite actions must declare their shell.
+			if exec.Run == nil || exec.Shell == nil {
+				continue
+			}
+			shell := exec.Shell.Value
+			line := uint(exec.Run.Pos.Line)
+			script := githubVarRegex.ReplaceAll([]byte(exec.Run.Value), []byte("GITHUB_REDACTED_VAR"))
+			if kind, ok := windowsScriptForShell(shell); ok {
+				validateWindowsScript(kind, action.Path, line, script, taintedFiles, pdata)
+				continue
+			}
+			if !isSupportedShell(shell) {
+				continue
+			}
+			if err := validateShellFile(action.Path, line, line, script, taintedFiles, pdata); err != nil {
+				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+					Msg: asPointer(err.Error()),
+				})
+			}
+		}
+	}
+	for i := first; i < len(pdata.Dependencies); i++ {
+		if pdata.Dependencies[i].Location != nil {
+			pdata.Dependencies[i].Caller = caller
+		}
+	}
+}
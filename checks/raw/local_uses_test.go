@@ -0,0 +1,147 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func callerString(f *checker.File) string {
+	if f == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", f.Path, f.Offset)
+}
+
+func TestGithubDangerousWorkflowLocalUses(t *testing.T) {
+	t.Parallel()
+	type result struct {
+		Type   checker.DangerousWorkflowType
+		File   string
+		Caller string
+	}
+	want := []result{
+		{
+			Type: checker.DangerousWorkflowScriptInjection,
+			File: ".github/workflows/build.yml:34",
+		},
+		{
+			Type:   checker.DangerousWorkflowUntrustedCheckout,
+			File:   ".github/workflows/build.yml:29",
+			Caller: ".github/workflows/pr-target.yml:30",
+		},
+		{
+			Type:   checker.DangerousWorkflowScriptInjection,
+			File:   ".github/workflows/build.yml:32",
+			Caller: ".github/workflows/pr-target.yml:30",
+		},
+		{
+			Type:   checker.DangerousWorkflowUntrustedCheckout,
+			File:   ".github/actions/greet/action.yml:23",
+			Caller: ".github/workflows/pr-target.yml:25",
+		},
+		{
+			Type:   checker.DangerousWorkflowScriptInjection,
+			File:   ".github/actions/greet/action.yml:26",
+			Caller: ".github/workflows/pr-target.yml:25",
+		},
+		{
+			Type:   checker.DangerousWorkflowScriptInjection,
+			File:   ".github/actions/greet/action.yml:28",
+			Caller: ".github/workflows/pr-target.yml:25",
+		},
+		{
+			Type:   checker.DangerousWorkflowScriptInjection,
+			File:   ".github/actions/nested/action.yaml:22",
+			Caller: ".github/actions/greet/action.yml:33",
+		},
+	}
+
+	req := &checker.CheckRequest{
+		Ctx:        context.Background(),
+		RepoClient: mockRepoFiles(t, "testdata/local-uses"),
+	}
+	dw, err := DangerousWorkflow(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []result
+	for i := range dw.Workflows {
+		w := &dw.Workflows[i]
+		got = append(got, result{
+			Type:   w.Type,
+			File:   callerString(&w.File),
+			Caller: callerString(w.Caller),
+		})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCollectLocalActionPinning(t *testing.T) {
+	t.Parallel()
+	type result struct {
+		Type   checker.DependencyUseType
+		File   string
+		Caller string
+		Pinned bool
+	}
+	want := []result{
+		{
+			Type:   checker.DependencyUseTypeGHAction,
+			File:   ".github/actions/greet/action.yml:23",
+			Caller: ".github/workflows/pr-target.yml:25",
+		},
+		{
+			Type:   checker.DependencyUseTypeDownloadThenRun,
+			File:   ".github/actions/greet/action.yml:32",
+			Caller: ".github/workflows/pr-target.yml:25",
+		},
+		{
+			Type:   checker.DependencyUseTypeGHAction,
+			File:   ".github/actions/nested/action.yaml:21",
+			Caller: ".github/actions/greet/action.yml:33",
+		},
+	}
+
+	req := &checker.CheckRequest{
+		Ctx:        context.Background(),
+		RepoClient: mockRepoFiles(t, "testdata/local-uses"),
+	}
+	var r checker.PinningDependenciesData
+	if err := collectLocalActionPinning(req, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []result
+	for i := range r.Dependencies {
+		d := &r.Dependencies[i]
+		got = append(got, result{
+			Type:   d.Type,
+			File:   callerString(d.Location),
+			Caller: callerString(d.Caller),
+			Pinned: d.Pinned != nil && *d.Pinned,
+		})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,747 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/dotnet/csproj"
+	"github.com/ossf/scorecard/v5/internal/dotnet/lockfile"
+)
+
+// lockfileEcosystem describes how the manifests of an ecosystem are locked.
+type lockfileEcosystem struct {
+	isManifest func(base string) bool
+	// declaresDependencies reports whether a manifest needs a lockfile.
+	declaresDependencies func(content []byte) (bool, error)
+	name                 string
+	// lockfiles are the names of the lockfiles of the manifests, in order of preference.
+	lockfiles []string
+	// workspaces is whether the lockfile at the root of a workspace locks its members.
+	workspaces bool
+}
+
+var (
+	goRequireRegex = regexp.MustCompile(`(?m)^\s*require\b`)
+	gemRegex       = regexp.MustCompile(`(?m)^\s*(gem|gemspec)\b`)
+
+	lockfileEcosystems = []lockfileEcosystem{
+		{
+			name:                 "npm",
+			isManifest:           isNamed("package.json"),
+			declaresDependencies: npmDeclaresDependencies,
+			lockfiles:            []string{"package-lock.json", "npm-shrinkwrap.json", "yarn.lock", "pnpm-lock.yaml"},
+			workspaces:           true,
+		},
+		{
+			name:                 "cargo",
+			isManifest:           isNamed("Cargo.toml"),
+			declaresDependencies: containsAny("dependencies]", "dependencies."),
+			lockfiles:            []string{"Cargo.lock"},
+			workspaces:           true,
+		},
+		{
+			name:                 "go",
+			isManifest:           isNamed("go.mod"),
+			declaresDependencies: matches(goRequireRegex),
+			lockfiles:            []string{"go.sum"},
+		},
+		{
+			name:                 "pypi",
+			isManifest:           isNamed("pyproject.toml"),
+			declaresDependencies: containsAny("dependencies"),
+			lockfiles:            []string{"poetry.lock", "uv.lock", "pdm.lock"},
+			workspaces:           true,
+		},
+		{
+			name:                 "pypi",
+			isManifest:           isNamed("Pipfile"),
+			declaresDependencies: containsAny("[packages]", "[dev-packages]"),
+			lockfiles:            []string{"Pipfile.lock"},
+		},
+		{
+			name:                 "rubygems",
+			isManifest:           isNamed("Gemfile", "gems.rb"),
+			declaresDependencies: matches(gemRegex),
+			lockfiles:            []string{"Gemfile.lock", "gems.locked"},
+		},
+		{
+			name:                 "composer",
+			isManifest:           isNamed("composer.json"),
+			declaresDependencies: composerDeclaresDependencies,
+			lockfiles:            []string{"composer.lock"},
+		},
+		{
+			name: "nuget",
+			isManifest: func(base string) bool {
+				ext := path.Ext(base)
+				return ext == ".csproj" || ext == ".fsproj" || ext == ".vbproj"
+			},
+			declaresDependencies: csproj.HasPackageReferences,
+			lockfiles:            []string{"packages.lock.json"},
+		},
+	}
+
+	// lockfileIntegrity counts the dependencies of a lockfile, and those pinned by a hash.
+	lockfileIntegrity = map[string]func(content []byte) (dependencies, hashed int, err error){
+		"package-lock.json":   npmLockfileIntegrity,
+		"npm-shrinkwrap.json": npmLockfileIntegrity,
+		"yarn.lock":           yarnLockfileIntegrity,
+		"pnpm-lock.yaml":      pnpmLockfileIntegrity,
+		"Cargo.lock":          cargoLockfileIntegrity,
+		"go.sum":              goSumIntegrity,
+		"poetry.lock":         pythonLockfileIntegrity,
+		"uv.lock":             pythonLockfileIntegrity,
+		"pdm.lock":            pythonLockfileIntegrity,
+		"Pipfile.lock":        pipfileLockfileIntegrity,
+		"Gemfile.lock":        gemfileLockfileIntegrity,
+		"gems.locked":         gemfileLockfileIntegrity,
+		"composer.lock":       composerLockfileIntegrity,
+		"packages.lock.json":  lockfile.CountHashedPackages,
+	}
+)
+
+func isNamed(names ...string) func(string) bool {
+	return func(base string) bool {
+		for _, name := range names {
+			if base == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func containsAny(substrings ...string) func([]byte) (bool, error) {
+	return func(content []byte) (bool, error) {
+		for _, s := range substrings {
+			if bytes.Contains(content, []byte(s)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func matches(re *regexp.Regexp) func([]byte) (bool, error) {
+	return func(content []byte) (bool, error) {
+		return re.Match(content), nil
+	}
+}
+
+// isRequirementsFile reports whether a file is a pip requirements file, or
+// the input pip-compile locks into one, with the given extension.
+func isRequirementsFile(base, ext string) bool {
+	return strings.HasPrefix(strings.ToLower(base), "requirements") && path.Ext(base) == ext
+}
+
+// isLockfileCandidate reports whether a file may be a manifest or a lockfile. Those of
+// dependencies and test fixtures aren't the project's.
+func isLockfileCandidate(pathfn string) bool {
+	if fileIsInVendorDir(pathfn) {
+		return false
+	}
+	for _, dir := range strings.Split(path.Dir(pathfn), "/") {
+		if dir == "node_modules" || dir == "testdata" || dir == "fixtures" {
+			return false
+		}
+	}
+
+	base := path.Base(pathfn)
+	if _, ok := lockfileIntegrity[base]; ok {
+		return true
+	}
+	if isRequirementsFile(base, ".txt") || isRequirementsFile(base, ".in") {
+		return true
+	}
+	for i := range lockfileEcosystems {
+		if lockfileEcosystems[i].isManifest(base) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectLockfiles pairs the manifests of the repository with the lockfiles pinning their
+// dependencies, and checks whether those lockfiles pin dependencies by hash.
+func collectLockfiles(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	files, err := c.RepoClient.ListFiles(func(f string) (bool, error) {
+		return isLockfileCandidate(f), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error during ListFiles: %w", err)
+	}
+	present := make(map[string]bool)
+	for _, f := range files {
+		present[f] = true
+	}
+	sort.Strings(files)
+
+	// Files which can't be read are reported, other manifests are still checked.
+	lockfiles := make(map[string]string)
+	for _, f := range files {
+		m, ok, err := lockManifest(c.RepoClient, f, present)
+		if err != nil {
+			r.ProcessingErrors = append(r.ProcessingErrors, lockfileError(f, err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		r.Manifests = append(r.Manifests, m)
+		if m.Lockfile != "" {
+			lockfiles[m.Lockfile] = m.Ecosystem
+		}
+	}
+
+	paths := make([]string, 0, len(lockfiles))
+	for p := range lockfiles {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		content, err := readRepoFile(c.RepoClient, p)
+		if err != nil {
+			r.ProcessingErrors = append(r.ProcessingErrors, lockfileError(p, err))
+			continue
+		}
+		integrity, ok := lockfileIntegrity[path.Base(p)]
+		if !ok {
+			integrity = requirementsIntegrity
+		}
+		dependencies, hashed, err := integrity(content)
+		if err != nil {
+			r.ProcessingErrors = append(r.ProcessingErrors, lockfileError(p, err))
+			continue
+		}
+		r.Lockfiles = append(r.Lockfiles, checker.Lockfile{
+			Ecosystem:    lockfiles[p],
+			File:         checker.File{Path: p, Type: finding.FileTypeSource},
+			Dependencies: dependencies,
+			Hashed:       hashed,
+		})
+	}
+	return nil
+}
+
+func lockfileError(p string, err error) checker.ElementError {
+	return checker.ElementError{
+		Err: sce.WithMessage(sce.ErrScorecardInternal, err.Error()),
+		Location: finding.Location{
+			Path: p,
+			Type: finding.FileTypeSource,
+		},
+	}
+}
+
+// lockManifest returns the manifest a file is, with its lockfile. It returns false
+// if the file isn't a manifest, or doesn't declare dependencies.
+func lockManifest(client clients.RepoClient, pathfn string, present map[string]bool,
+) (checker.DependencyManifest, bool, error) {
+	base := path.Base(pathfn)
+	m := checker.DependencyManifest{
+		File: checker.File{Path: pathfn, Type: finding.FileTypeSource},
+	}
+
+	switch {
+	case isRequirementsFile(base, ".in"):
+		// pip-compile locks requirements.in into requirements.txt.
+		m.Ecosystem = "pypi"
+		if lock := strings.TrimSuffix(pathfn, ".in") + ".txt"; present[lock] {
+			m.Lockfile = lock
+		}
+		return m, true, nil
+	case isRequirementsFile(base, ".txt"):
+		if present[strings.TrimSuffix(pathfn, ".txt")+".in"] {
+			return m, false, nil
+		}
+		content, err := readRepoFile(client, pathfn)
+		if err != nil {
+			return m, false, err
+		}
+		requirements := parseRequirements(content)
+		if len(requirements) == 0 {
+			return m, false, nil
+		}
+		// Requirements pinning every version are their own lockfile.
+		m.Ecosystem = "pypi"
+		if requirementsArePinned(requirements) {
+			m.Lockfile = pathfn
+		}
+		return m, true, nil
+	}
+
+	for i := range lockfileEcosystems {
+		e := &lockfileEcosystems[i]
+		if !e.isManifest(base) {
+			continue
+		}
+		content, err := readRepoFile(client, pathfn)
+		if err != nil {
+			return m, false, err
+		}
+		// Invalid manifests can't be installed from either.
+		if declares, err := e.declaresDependencies(content); err != nil || !declares {
+			return m, false, nil
+		}
+		m.Ecosystem = e.name
+		m.Lockfile = findLockfile(e, path.Dir(pathfn), present)
+		return m, true, nil
+	}
+	return m, false, nil
+}
+
+func findLockfile(e *lockfileEcosystem, dir string, present map[string]bool) string {
+	for {
+		for _, name := range e.lockfiles {
+			if lock := path.Join(dir, name); present[lock] {
+				return lock
+			}
+		}
+		if !e.workspaces || dir == "." || dir == "/" {
+			return ""
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+func readRepoFile(client clients.RepoClient, pathfn string) ([]byte, error) {
+	reader, err := client.GetFileReader(pathfn)
+	if err != nil {
+		return nil, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading from file: %w", err)
+	}
+	return content, nil
+}
+
+// pinnedGitSource reports whether a source is a Git repository, and whether it's pinned to a commit.
+func pinnedGitSource(source string) (git, pinned bool) {
+	if !strings.HasPrefix(source, "git+") && !strings.HasPrefix(source, "git:") &&
+		!strings.HasPrefix(source, "github:") && !strings.Contains(source, ".git#") {
+		return false, false
+	}
+	_, ref, _ := strings.Cut(source, "#")
+	return true, gitCommitHashRegex.MatchString(strings.TrimPrefix(ref, "commit="))
+}
+
+func npmDeclaresDependencies(content []byte) (bool, error) {
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return false, fmt.Errorf("parsing package.json: %w", err)
+	}
+	for _, key := range []string{"dependencies", "devDependencies", "optionalDependencies", "peerDependencies"} {
+		var deps map[string]string
+		if json.Unmarshal(manifest[key], &deps) == nil && len(deps) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func composerDeclaresDependencies(content []byte) (bool, error) {
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return false, fmt.Errorf("parsing composer.json: %w", err)
+	}
+	for _, deps := range []map[string]string{manifest.Require, manifest.RequireDev} {
+		for name := range deps {
+			// The platform isn't installed by Composer.
+			if name != "php" && !strings.HasPrefix(name, "ext-") && !strings.HasPrefix(name, "lib-") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+type npmLockedPackage struct {
+	Dependencies map[string]npmLockedPackage `json:"dependencies"`
+	Version      string                      `json:"version"`
+	Resolved     string                      `json:"resolved"`
+	Integrity    string                      `json:"integrity"`
+	Link         bool                        `json:"link"`
+}
+
+func npmLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var lock struct {
+		// Packages is set by lockfile versions 2 and 3, Dependencies by versions 1 and 2.
+		Packages     map[string]npmLockedPackage `json:"packages"`
+		Dependencies map[string]npmLockedPackage `json:"dependencies"`
+	}
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing npm lockfile: %w", err)
+	}
+
+	var count func(p *npmLockedPackage)
+	count = func(p *npmLockedPackage) {
+		for _, d := range p.Dependencies {
+			count(&d)
+		}
+		if p.Link {
+			return
+		}
+		source := p.Resolved
+		if source == "" {
+			// Version 1 lockfiles set the source of Git dependencies as their version.
+			source = p.Version
+		}
+		git, pinned := pinnedGitSource(source)
+		switch {
+		case p.Integrity != "":
+			dependencies++
+			hashed++
+		case git:
+			dependencies++
+			if pinned {
+				hashed++
+			}
+		case p.Resolved != "" && !strings.HasPrefix(p.Resolved, "file:"):
+			dependencies++
+		}
+	}
+
+	if len(lock.Packages) > 0 {
+		for name, p := range lock.Packages {
+			// The root package is the project.
+			if name != "" {
+				p.Dependencies = nil
+				count(&p)
+			}
+		}
+		return dependencies, hashed, nil
+	}
+	count(&npmLockedPackage{Dependencies: lock.Dependencies, Link: true})
+	return dependencies, hashed, nil
+}
+
+// yarnLocalProtocols are the protocols of dependencies of the project itself.
+var yarnLocalProtocols = []string{"@workspace:", "@link:", "@portal:", "@file:", "@patch:"}
+
+func yarnLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	for _, entry := range strings.Split(string(content), "\n\n") {
+		var header, resolution string
+		var hasHash bool
+		scanner := bufio.NewScanner(strings.NewReader(entry))
+		for scanner.Scan() {
+			line := scanner.Text()
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+			case header == "" && !strings.HasPrefix(line, " "):
+				header = line
+			// Classic lockfiles set resolved and integrity, Berry ones resolution and checksum.
+			case strings.HasPrefix(trimmed, "resolved "), strings.HasPrefix(trimmed, "resolution: "):
+				_, resolution, _ = strings.Cut(trimmed, " ")
+				resolution = strings.Trim(resolution, `"`)
+			case strings.HasPrefix(trimmed, "integrity "), strings.HasPrefix(trimmed, "checksum: "):
+				hasHash = true
+			}
+		}
+		if header == "" || strings.HasPrefix(header, "__metadata") || resolution == "" {
+			continue
+		}
+		local := false
+		for _, protocol := range yarnLocalProtocols {
+			local = local || strings.Contains(resolution, protocol)
+		}
+		if local {
+			continue
+		}
+
+		dependencies++
+		if _, pinned := pinnedGitSource(resolution[strings.LastIndex(resolution, "@")+1:]); hasHash || pinned {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func pnpmLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var lock struct {
+		Packages map[string]struct {
+			Resolution map[string]string `yaml:"resolution"`
+		} `yaml:"packages"`
+	}
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing pnpm-lock.yaml: %w", err)
+	}
+	for _, p := range lock.Packages {
+		resolution := p.Resolution
+		if resolution["directory"] != "" {
+			continue
+		}
+		dependencies++
+		if resolution["integrity"] != "" || gitCommitHashRegex.MatchString(resolution["commit"]) {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func cargoLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var lock struct {
+		// Version 1 lockfiles keep checksums in the metadata.
+		Metadata map[string]string `toml:"metadata"`
+		Package  []struct {
+			Name     string `toml:"name"`
+			Version  string `toml:"version"`
+			Source   string `toml:"source"`
+			Checksum string `toml:"checksum"`
+		} `toml:"package"`
+	}
+	if _, err := toml.Decode(string(content), &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing Cargo.lock: %w", err)
+	}
+	for _, p := range lock.Package {
+		// Packages without a source are members of the workspace.
+		if p.Source == "" {
+			continue
+		}
+		dependencies++
+		_, pinned := pinnedGitSource(p.Source)
+		metadata := lock.Metadata[fmt.Sprintf("checksum %s %s (%s)", p.Name, p.Version, p.Source)]
+		if p.Checksum != "" || pinned || metadata != "" {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func goSumIntegrity(content []byte) (dependencies, hashed int, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		dependencies++
+		if len(fields) == 3 && strings.HasPrefix(fields[2], "h1:") {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+type pythonLockedFile struct {
+	Hash string `toml:"hash"`
+}
+
+// pythonLockfileIntegrity handles the lockfiles of Poetry, uv and PDM.
+func pythonLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var lock struct {
+		// Poetry lockfiles before version 2 keep hashes in the metadata.
+		Metadata struct {
+			Files map[string][]pythonLockedFile `toml:"files"`
+		} `toml:"metadata"`
+		Package []struct {
+			Name string `toml:"name"`
+			// Source is a table in Poetry and uv lockfiles, with different keys.
+			Source map[string]any     `toml:"source"`
+			Files  []pythonLockedFile `toml:"files"`
+			Sdist  *pythonLockedFile  `toml:"sdist"`
+			Wheels []pythonLockedFile `toml:"wheels"`
+		} `toml:"package"`
+	}
+	if _, err := toml.Decode(string(content), &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing Python lockfile: %w", err)
+	}
+
+	for _, p := range lock.Package {
+		source := func(key string) string {
+			s, _ := p.Source[key].(string)
+			return s
+		}
+		if t := source("type"); t == "directory" || t == "file" {
+			continue
+		}
+		if source("editable") != "" || source("virtual") != "" || source("directory") != "" || source("path") != "" {
+			continue
+		}
+		dependencies++
+
+		files := append(append(p.Files, p.Wheels...), lock.Metadata.Files[p.Name]...)
+		if p.Sdist != nil {
+			files = append(files, *p.Sdist)
+		}
+		hasHash := false
+		for _, f := range files {
+			hasHash = hasHash || f.Hash != ""
+		}
+		_, pinned := pinnedGitSource("git+" + source("git"))
+		if hasHash || pinned || gitCommitHashRegex.MatchString(source("resolved_reference")) {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func pipfileLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var lock map[string]json.RawMessage
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing Pipfile.lock: %w", err)
+	}
+	for _, section := range []string{"default", "develop"} {
+		var packages map[string]struct {
+			Path     string   `json:"path"`
+			Ref      string   `json:"ref"`
+			Hashes   []string `json:"hashes"`
+			Editable bool     `json:"editable"`
+		}
+		if err := json.Unmarshal(lock[section], &packages); err != nil && lock[section] != nil {
+			return 0, 0, fmt.Errorf("parsing Pipfile.lock: %w", err)
+		}
+		for _, p := range packages {
+			if p.Editable || p.Path != "" {
+				continue
+			}
+			dependencies++
+			if len(p.Hashes) > 0 || gitCommitHashRegex.MatchString(p.Ref) {
+				hashed++
+			}
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func gemfileLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	var section, revision string
+	var gems []string
+	checksums := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+		case !strings.HasPrefix(line, " "):
+			section, revision = trimmed, ""
+		case section == "GIT" && strings.HasPrefix(trimmed, "revision: "):
+			revision = strings.TrimPrefix(trimmed, "revision: ")
+		// Specs are indented by four spaces, their dependencies by six.
+		case strings.HasPrefix(line, "    ") && !strings.HasPrefix(line, "     "):
+			switch section {
+			case "GEM":
+				gems = append(gems, trimmed)
+			case "GIT":
+				dependencies++
+				if gitCommitHashRegex.MatchString(revision) {
+					hashed++
+				}
+			}
+		// Bundler records checksums since version 2.6.
+		case section == "CHECKSUMS":
+			if gem, checksum, ok := strings.Cut(trimmed, " sha256="); ok && checksum != "" {
+				checksums[gem] = true
+			}
+		}
+	}
+	for _, gem := range gems {
+		dependencies++
+		if checksums[gem] {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+func composerLockfileIntegrity(content []byte) (dependencies, hashed int, err error) {
+	type reference struct {
+		Type      string `json:"type"`
+		Reference string `json:"reference"`
+		Shasum    string `json:"shasum"`
+	}
+	type lockedPackage struct {
+		Dist   reference `json:"dist"`
+		Source reference `json:"source"`
+	}
+	var lock struct {
+		Packages    []lockedPackage `json:"packages"`
+		PackagesDev []lockedPackage `json:"packages-dev"`
+	}
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return 0, 0, fmt.Errorf("parsing composer.lock: %w", err)
+	}
+	for _, p := range append(lock.Packages, lock.PackagesDev...) {
+		if p.Dist.Type == "path" {
+			continue
+		}
+		dependencies++
+		// Distributions of repositories are archives of the commit they reference.
+		if p.Dist.Shasum != "" || gitCommitHashRegex.MatchString(p.Dist.Reference) ||
+			gitCommitHashRegex.MatchString(p.Source.Reference) {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
+
+// parseRequirements returns the requirements of a pip requirements file, with their options.
+func parseRequirements(content []byte) []string {
+	var requirements []string
+	text := strings.ReplaceAll(string(content), "\\\n", " ")
+	for _, line := range strings.Split(text, "\n") {
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		// Options include other requirements files, and editable local projects.
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") {
+			continue
+		}
+		requirements = append(requirements, line)
+	}
+	return requirements
+}
+
+func requirementsArePinned(requirements []string) bool {
+	for _, r := range requirements {
+		if !strings.Contains(r, "==") {
+			return false
+		}
+	}
+	return true
+}
+
+func requirementsIntegrity(content []byte) (dependencies, hashed int, err error) {
+	for _, r := range parseRequirements(content) {
+		dependencies++
+		if strings.Contains(r, "--hash") {
+			hashed++
+		}
+	}
+	return dependencies, hashed, nil
+}
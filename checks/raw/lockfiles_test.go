@@ -0,0 +1,121 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestCollectLockfiles(t *testing.T) {
+	t.Parallel()
+	c := &checker.CheckRequest{RepoClient: mockRepoFiles(t, "testdata/lockfiles")}
+	var r checker.PinningDependenciesData
+	if err := collectLockfiles(c, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type manifest struct{ path, ecosystem, lockfile string }
+	var manifests []manifest
+	for _, m := range r.Manifests {
+		manifests = append(manifests, manifest{m.File.Path, m.Ecosystem, m.Lockfile})
+	}
+	type lock struct {
+		path                 string
+		dependencies, hashed int
+	}
+	var lockfiles []lock
+	for _, l := range r.Lockfiles {
+		lockfiles = append(lockfiles, lock{l.File.Path, l.Dependencies, l.Hashed})
+	}
+	if len(r.ProcessingErrors) != 0 {
+		t.Errorf("unexpected processing errors: %v", r.ProcessingErrors)
+	}
+
+	wantManifests := []manifest{
+		{"Gemfile", "rubygems", "Gemfile.lock"},
+		{"ci/requirements.in", "pypi", "ci/requirements.txt"},
+		{"crates/core/Cargo.toml", "cargo", "Cargo.lock"},
+		{"docs/requirements.txt", "pypi", ""},
+		{"frontend/package.json", "npm", "frontend/pnpm-lock.yaml"},
+		{"go.mod", "go", "go.sum"},
+		{"package.json", "npm", "package-lock.json"},
+		{"packages/ui/package.json", "npm", "package-lock.json"},
+		{"python/pyproject.toml", "pypi", "python/poetry.lock"},
+		{"requirements.txt", "pypi", "requirements.txt"},
+		{"scripts/Pipfile", "pypi", "scripts/Pipfile.lock"},
+		{"src/App/App.csproj", "nuget", "src/App/packages.lock.json"},
+		{"src/Lib/Lib.csproj", "nuget", ""},
+		{"tools/yarn-app/package.json", "npm", "tools/yarn-app/yarn.lock"},
+		{"uv-app/pyproject.toml", "pypi", "uv-app/uv.lock"},
+		{"web/composer.json", "composer", "web/composer.lock"},
+	}
+	if diff := cmp.Diff(wantManifests, manifests, cmp.AllowUnexported(manifest{})); diff != "" {
+		t.Errorf("mismatch in manifests (-want +got):\n%s", diff)
+	}
+
+	wantLockfiles := []lock{
+		{"Cargo.lock", 1, 1},
+		{"Gemfile.lock", 2, 1},
+		{"ci/requirements.txt", 1, 0},
+		{"frontend/pnpm-lock.yaml", 1, 1},
+		{"go.sum", 2, 2},
+		{"package-lock.json", 3, 2},
+		{"python/poetry.lock", 2, 2},
+		{"requirements.txt", 1, 1},
+		{"scripts/Pipfile.lock", 1, 1},
+		{"src/App/packages.lock.json", 1, 1},
+		{"tools/yarn-app/yarn.lock", 2, 1},
+		{"uv-app/uv.lock", 1, 1},
+		{"web/composer.lock", 1, 1},
+	}
+	if diff := cmp.Diff(wantLockfiles, lockfiles, cmp.AllowUnexported(lock{})); diff != "" {
+		t.Errorf("mismatch in lockfiles (-want +got):\n%s", diff)
+	}
+}
+
+func TestCollectLockfiles_unreadableFile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{"go.mod", "go.sum", "requirements.txt"}, nil)
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+		if f == "go.sum" {
+			return nil, errors.New("read error")
+		}
+		return os.Open(filepath.Join("testdata/lockfiles", f))
+	}).AnyTimes()
+	c := &checker.CheckRequest{RepoClient: mockRepoClient}
+	var r checker.PinningDependenciesData
+	if err := collectLockfiles(c, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.ProcessingErrors) != 1 || r.ProcessingErrors[0].Location.Path != "go.sum" {
+		t.Errorf("processing errors = %v, want an error for go.sum", r.ProcessingErrors)
+	}
+	// The other lockfile is still checked.
+	if len(r.Lockfiles) != 1 || r.Lockfiles[0].File.Path != "requirements.txt" {
+		t.Errorf("lockfiles = %v, want requirements.txt", r.Lockfiles)
+	}
+}
@@ -47,21 +47,112 @@ var permissionsOfInterest = []permission{
 
 type permissionCbData struct {
 	results checker.TokenPermissionsData
+	// calls are the calls to the reusable workflows of the repository.
+	calls *reusableWorkflowCalls
+}
+
+// reusableWorkflowCaller is a job calling a reusable workflow of the repository.
+type reusableWorkflowCaller struct {
+	path string
+	// declared is whether the calling job or its workflow declares permissions.
+	declared bool
+}
+
+type reusableWorkflowCalls struct {
+	callers map[string][]reusableWorkflowCaller
+	// callOnly are the workflows only triggered by workflow_call.
+	callOnly map[string]bool
 }
 
 // TokenPermissions runs Token-Permissions check.
 func TokenPermissions(c *checker.CheckRequest) (checker.TokenPermissionsData, error) {
+	calls := reusableWorkflowCalls{
+		callers:  make(map[string][]reusableWorkflowCaller),
+		callOnly: make(map[string]bool),
+	}
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
+		CaseSensitive: false,
+	}, collectReusableWorkflowCalls, &calls)
+	if err != nil {
+		return checker.TokenPermissionsData{}, err
+	}
+
 	// data is shared across all GitHub workflows.
-	var data permissionCbData
+	data := permissionCbData{calls: &calls}
 
-	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: false,
 	}, validateGitHubActionTokenPermissions, &data)
 
 	return data.results, err
 }
 
+// collectReusableWorkflowCalls records the local reusable workflows each workflow calls.
+var collectReusableWorkflowCalls fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"collectReusableWorkflowCalls requires exactly 1 arguments: %w", errInvalidArgLength)
+	}
+	calls, ok := args[0].(*reusableWorkflowCalls)
+	if !ok {
+		return false, fmt.Errorf(
+			"collectReusableWorkflowCalls requires arg[0] of type *reusableWorkflowCalls: %w", errInvalidArgType)
+	}
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+
+	calls.callOnly[path] = len(workflow.On) > 0
+	for _, event := range workflow.On {
+		if event.EventName() != "workflow_call" {
+			calls.callOnly[path] = false
+		}
+	}
+	for _, job := range workflow.Jobs {
+		if job == nil || job.WorkflowCall == nil || job.WorkflowCall.Uses == nil {
+			continue
+		}
+		callee, ok := fileparser.LocalUsesPath(job.WorkflowCall.Uses.Value)
+		if !ok || !fileparser.IsWorkflowFile(callee) {
+			continue
+		}
+		calls.callers[callee] = append(calls.callers[callee], reusableWorkflowCaller{
+			path:     path,
+			declared: workflow.Permissions != nil || job.Permissions != nil,
+		})
+	}
+	return true, nil
+}
+
+// restricted returns whether the token of a reusable workflow is restricted by all its
+// callers. Reusable workflows get the permissions of the calling job, so they don't need
+// to declare permissions if the callers do.
+func (r *reusableWorkflowCalls) restricted(path string, visiting map[string]bool) bool {
+	if r == nil || !r.callOnly[path] || len(r.callers[path]) == 0 || visiting[path] {
+		return false
+	}
+	visiting[path] = true
+	defer delete(visiting, path)
+	for _, caller := range r.callers[path] {
+		if !caller.declared && !r.restricted(caller.path, visiting) {
+			return false
+		}
+	}
+	return true
+}
+
 // Check file content.
 var validateGitHubActionTokenPermissions fileparser.DoWhileTrueOnFileContent = func(path string,
 	content []byte,
@@ -270,6 +361,9 @@ func validateTopLevelPermissions(workflow *actionlint.Workflow, path string,
 ) error {
 	// Check if permissions are set explicitly.
 	if workflow.Permissions == nil {
+		if pdata.calls.restricted(path, make(map[string]bool)) {
+			return nil
+		}
 		permLoc := checker.PermissionLocationTop
 		pdata.results.TokenPermissions = append(pdata.results.TokenPermissions,
 			checker.TokenPermission{
@@ -24,6 +24,7 @@ import (
 
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
@@ -73,6 +74,31 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Actions and script downloads of local composite actions.
+	if err := collectLocalActionPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// GitLab CI images, includes and script downloads.
+	if err := collectGitLabCIPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Azure Pipelines images, templates, tasks and script downloads.
+	if err := collectAzurePipelinesPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Kubernetes, Kustomize, Helm and Compose images.
+	if err := collectManifestImagePinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Lockfiles of manifests.
+	if err := collectLockfiles(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Nuget Post Processing
 	if err := postProcessNugetDependencies(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -302,6 +328,11 @@ var validateShellScriptIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFileCon
 
 	pdata := dataAsPinnedDependenciesPointer(args[0])
 
+	if kind, ok := windowsScriptForFile(pathfn); ok {
+		validateWindowsScript(kind, pathfn, 0, content, map[string]bool{}, pdata)
+		return true, nil
+	}
+
 	// Validate the file type.
 	if !isSupportedShellScriptFile(pathfn, content) {
 		return true, nil
@@ -579,9 +610,95 @@ var validateDockerfilesPinning fileparser.DoWhileTrueOnFileContent = func(
 	return true, nil
 }
 
+// containerImageRegex matches container images pinned by digest.
+var containerImageRegex = regexp.MustCompile(`@sha256:[a-f\d]{64}$`)
+
+// containerImageDependency returns the dependency on a container image used by a CI configuration.
+func containerImageDependency(pathfn string, line uint, image string, t checker.DependencyUseType) checker.Dependency {
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    line,
+			EndOffset: line,
+			Snippet:   image,
+		},
+		Pinned: asBoolPointer(containerImageRegex.MatchString(image)),
+		Type:   t,
+	}
+	name, digest, _ := strings.Cut(image, "@")
+	// The tag follows the last colon, unless it's the port of the registry.
+	var tag string
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	dep.Name = asPointer(name)
+	switch {
+	case digest != "":
+		dep.PinnedAt = asPointer(digest)
+	case tag != "":
+		dep.PinnedAt = asPointer(tag)
+	}
+	return dep
+}
+
+func applyContainerImagePinningRemediations(d []checker.Dependency) {
+	for i := range d {
+		rr := &d[i]
+		switch rr.Type {
+		case checker.DependencyUseTypeGitLabCIContainerImage, checker.DependencyUseTypeAzurePipelinesContainerImage,
+			checker.DependencyUseTypeManifestContainerImage:
+			if !*rr.Pinned {
+				rr.Remediation = remediation.CreateDockerfilePinningRemediation(rr, remediation.CraneDigester{})
+			}
+		default:
+		}
+	}
+}
+
+// appendUniquePinningResults appends the results of a CI configuration to r, once
+// per location, as its jobs may share images and scripts.
+func appendUniquePinningResults(r, results *checker.PinningDependenciesData) {
+	type key struct {
+		t                  checker.DependencyUseType
+		path, snippet, msg string
+		offset, endOffset  uint
+	}
+	seen := make(map[key]bool)
+	for _, dep := range results.Dependencies {
+		var k key
+		if dep.Location != nil {
+			k = key{dep.Type, dep.Location.Path, dep.Location.Snippet, "", dep.Location.Offset, dep.Location.EndOffset}
+		}
+		if dep.Msg != nil {
+			k.msg = *dep.Msg
+		}
+		if !seen[k] {
+			seen[k] = true
+			r.Dependencies = append(r.Dependencies, dep)
+		}
+	}
+
+	type errorKey struct {
+		path, err string
+		line      uint
+	}
+	seenErrors := make(map[errorKey]bool)
+	for _, e := range results.ProcessingErrors {
+		k := errorKey{path: e.Location.Path, err: e.Err.Error()}
+		if e.Location.LineStart != nil {
+			k.line = *e.Location.LineStart
+		}
+		if !seenErrors[k] {
+			seenErrors[k] = true
+			r.ProcessingErrors = append(r.ProcessingErrors, e)
+		}
+	}
+}
+
 func collectGitHubWorkflowScriptInsecureDownloads(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
 	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: false,
 	}, validateGitHubWorkflowIsFreeOfInsecureDownloads, r)
 }
@@ -665,13 +782,16 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 				}
 				return false, err
 			}
-			// Skip unsupported shells. We don't support Windows shells or some Unix shells.
+			// We replace the `${{ github.variable }}` to avoid shell parsing failures.
+			script := githubVarRegex.ReplaceAll([]byte(run), []byte("GITHUB_REDACTED_VAR"))
+			if kind, ok := windowsScriptForShell(shell); ok {
+				validateWindowsScript(kind, pathfn, uint(execRun.Run.Pos.Line), script, taintedFiles, pdata)
+				continue
+			}
+			// Skip unsupported shells. We don't support some Unix shells.
 			if !isSupportedShell(shell) {
 				continue
 			}
-
-			// We replace the `${{ github.variable }}` to avoid shell parsing failures.
-			script := githubVarRegex.ReplaceAll([]byte(run), []byte("GITHUB_REDACTED_VAR"))
 			if err := validateShellFile(pathfn, uint(execRun.Run.Pos.Line), uint(execRun.Run.Pos.Line),
 				script, taintedFiles, pdata); err != nil {
 				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
@@ -687,7 +807,7 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 // Check pinning of github actions in workflows.
 func collectGitHubActionsWorkflowPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: true,
 	}, validateGitHubActionWorkflow, r)
 	if err != nil {
@@ -768,31 +888,35 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 				continue
 			}
 
-			dep := checker.Dependency{
-				Location: &checker.File{
-					Path:      pathfn,
-					Type:      finding.FileTypeSource,
-					Offset:    uint(execAction.Uses.Pos.Line),
-					EndOffset: uint(execAction.Uses.Pos.Line), // `Uses` always span a single line.
-					Snippet:   execAction.Uses.Value,
-				},
-				Pinned: asBoolPointer(isActionDependencyPinned(execAction.Uses.Value)),
-				Type:   checker.DependencyUseTypeGHAction,
-			}
-			parts := strings.SplitN(execAction.Uses.Value, "@", 2)
-			if len(parts) > 0 {
-				dep.Name = asPointer(parts[0])
-				if len(parts) > 1 {
-					dep.PinnedAt = asPointer(parts[1])
-				}
-			}
-			pdata.Dependencies = append(pdata.Dependencies, dep)
+			pdata.Dependencies = append(pdata.Dependencies, actionDependency(pathfn, execAction.Uses))
 		}
 	}
 
 	return true, nil
 }
 
+func actionDependency(pathfn string, uses *actionlint.String) checker.Dependency {
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(uses.Pos.Line),
+			EndOffset: uint(uses.Pos.Line), // `Uses` always span a single line.
+			Snippet:   uses.Value,
+		},
+		Pinned: asBoolPointer(isActionDependencyPinned(uses.Value)),
+		Type:   checker.DependencyUseTypeGHAction,
+	}
+	parts := strings.SplitN(uses.Value, "@", 2)
+	if len(parts) > 0 {
+		dep.Name = asPointer(parts[0])
+		if len(parts) > 1 {
+			dep.PinnedAt = asPointer(parts[1])
+		}
+	}
+	return dep
+}
+
 func isActionDependencyPinned(actionUses string) bool {
 	localActionRegex := regexp.MustCompile(`^\..+[^/]`)
 	if localActionRegex.MatchString(actionUses) {
@@ -807,3 +931,29 @@ func isActionDependencyPinned(actionUses string) bool {
 	dockerhubActionRegex := regexp.MustCompile(`docker://.*@sha256:[a-fA-F\d]{64}`)
 	return dockerhubActionRegex.MatchString(actionUses)
 }
+
+func yamlLookup(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlItems(n *yaml.Node) []*yaml.Node {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return n.Content
+}
+
+func yamlScalar(n *yaml.Node) string {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.Value
+}
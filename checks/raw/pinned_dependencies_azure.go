@@ -0,0 +1,308 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+var (
+	// azureTaskVersionRegex matches tasks pinned to a full version, rather than a major one.
+	azureTaskVersionRegex = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	// azureExpressionRegex matches template expressions, which fail shell parsing.
+	azureExpressionRegex = regexp.MustCompile(`{{[^{}]*}}`)
+)
+
+type azurePipelinesFile struct {
+	path string
+	root *yaml.Node
+}
+
+type azurePipelinesRepository struct {
+	name, ref string
+}
+
+// azurePipelines holds the resources of the Azure Pipelines configurations of a repository.
+// Templates use the resources of the pipelines which include them, so these are shared.
+type azurePipelines struct {
+	files        []azurePipelinesFile
+	repositories map[string]azurePipelinesRepository
+	containers   map[string]bool
+}
+
+// Check pinning of container images, templates, tasks and scripts in Azure Pipelines.
+func collectAzurePipelinesPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	var pipelines azurePipelines
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*.y*ml",
+		CaseSensitive: false,
+	}, parseAzurePipelinesFile, &pipelines, r)
+	if err != nil {
+		return err
+	}
+
+	start := len(r.Dependencies)
+	validateAzurePipelines(&pipelines, r)
+	applyContainerImagePinningRemediations(r.Dependencies[start:])
+	return nil
+}
+
+var parseAzurePipelinesFile fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, _ := fileparser.IsAzurePipelinesFile(pathfn); !ok {
+		return true, nil
+	}
+
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"parseAzurePipelinesFile requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pipelines, ok := args[0].(*azurePipelines)
+	if !ok {
+		return false, fmt.Errorf("parseAzurePipelinesFile expects arg[0] of type *azurePipelines: %w", errInvalidArgType)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[1])
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		pdata.ProcessingErrors = append(pdata.ProcessingErrors, checker.ElementError{
+			Err: sce.WithMessage(sce.ErrScorecardInternal, err.Error()),
+			Location: finding.Location{
+				Path: pathfn,
+				Type: finding.FileTypeSource,
+			},
+		})
+		return true, nil
+	}
+	if len(doc.Content) > 0 {
+		pipelines.files = append(pipelines.files, azurePipelinesFile{path: pathfn, root: doc.Content[0]})
+	}
+	return true, nil
+}
+
+// validateAzurePipelines checks if the Azure Pipelines configurations use unpinned container
+// images, templates and tasks, or download dependencies that are unpinned.
+func validateAzurePipelines(pipelines *azurePipelines, r *checker.PinningDependenciesData) {
+	pipelines.repositories = make(map[string]azurePipelinesRepository)
+	pipelines.containers = make(map[string]bool)
+	for _, f := range pipelines.files {
+		resources := yamlLookup(f.root, "resources")
+		for _, repo := range yamlItems(yamlLookup(resources, "repositories")) {
+			alias := yamlScalar(yamlLookup(repo, "repository"))
+			pipelines.repositories[alias] = azurePipelinesRepository{
+				name: yamlScalar(yamlLookup(repo, "name")),
+				ref:  yamlScalar(yamlLookup(repo, "ref")),
+			}
+		}
+		for _, container := range yamlItems(yamlLookup(resources, "containers")) {
+			pipelines.containers[yamlScalar(yamlLookup(container, "container"))] = true
+		}
+	}
+
+	for _, f := range pipelines.files {
+		w := azurePipelinesWalker{azurePipelines: pipelines, path: f.path}
+		w.walk(f.root, false)
+		appendUniquePinningResults(r, &w.results)
+	}
+}
+
+type azurePipelinesWalker struct {
+	*azurePipelines
+	path    string
+	results checker.PinningDependenciesData
+}
+
+// walk records the dependencies of a node and its children. Windows is
+// whether the enclosing job runs on Windows, where scripts run with cmd.
+func (w *azurePipelinesWalker) walk(n *yaml.Node, windows bool) {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			w.walk(c, windows)
+		}
+	case yaml.MappingNode:
+		if pool := yamlLookup(n, "pool"); pool != nil {
+			windows = azurePoolIsWindows(pool)
+		}
+		isTask := yamlLookup(n, "task") != nil
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i].Value, n.Content[i+1]
+			switch key {
+			case "resources":
+				for _, container := range yamlItems(yamlLookup(value, "containers")) {
+					w.image(yamlLookup(container, "image"))
+				}
+				continue
+			case "template":
+				w.template(value)
+			case "task":
+				w.task(n, value, windows)
+				continue
+			case "inputs":
+				// The inputs of tasks are analyzed with their task.
+				if isTask {
+					continue
+				}
+			case "container":
+				w.container(value)
+			case "services":
+				for j := 1; value.Kind == yaml.MappingNode && j < len(value.Content); j += 2 {
+					w.container(value.Content[j])
+				}
+			case "bash":
+				w.script(value)
+			case "script":
+				if !windows {
+					w.script(value)
+				}
+			}
+			w.walk(value, windows)
+		}
+	}
+}
+
+// image records a container image.
+func (w *azurePipelinesWalker) image(n *yaml.Node) {
+	if image := yamlScalar(n); image != "" {
+		w.results.Dependencies = append(w.results.Dependencies, containerImageDependency(
+			w.path, uint(n.Line), image, checker.DependencyUseTypeAzurePipelinesContainerImage))
+	}
+}
+
+// container records the image of a job container or service, which
+// is either an image, or the alias of a container resource.
+func (w *azurePipelinesWalker) container(n *yaml.Node) {
+	if n.Kind == yaml.MappingNode {
+		w.image(yamlLookup(n, "image"))
+		return
+	}
+	if !w.containers[yamlScalar(n)] {
+		w.image(n)
+	}
+}
+
+// template records a template used from a repository resource, as template: file@alias.
+func (w *azurePipelinesWalker) template(n *yaml.Node) {
+	reference := yamlScalar(n)
+	i := strings.LastIndex(reference, "@")
+	if i < 0 || reference[i+1:] == "self" {
+		return
+	}
+	repo, ok := w.repositories[reference[i+1:]]
+	if !ok {
+		// The repository is declared by a pipeline outside of the repository.
+		return
+	}
+
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      w.path,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(n.Line),
+			EndOffset: uint(n.Line),
+			Snippet:   reference,
+		},
+		Name:   asPointer(repo.name),
+		Pinned: asBoolPointer(gitCommitHashRegex.MatchString(repo.ref)),
+		Type:   checker.DependencyUseTypeAzurePipelinesTemplate,
+	}
+	if repo.ref != "" {
+		dep.PinnedAt = asPointer(repo.ref)
+	}
+	if !*dep.Pinned {
+		dep.Remediation = remediation.CreateAzurePipelinesPinningRemediation(&dep)
+	}
+	w.results.Dependencies = append(w.results.Dependencies, dep)
+}
+
+// task records a task, and analyzes the inline scripts of the Bash and CmdLine tasks.
+func (w *azurePipelinesWalker) task(step, n *yaml.Node, windows bool) {
+	reference := yamlScalar(n)
+	if reference == "" {
+		return
+	}
+	name, version, _ := strings.Cut(reference, "@")
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      w.path,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(n.Line),
+			EndOffset: uint(n.Line),
+			Snippet:   reference,
+		},
+		Name:   asPointer(name),
+		Pinned: asBoolPointer(azureTaskVersionRegex.MatchString(version)),
+		Type:   checker.DependencyUseTypeAzurePipelinesTask,
+	}
+	if version != "" {
+		dep.PinnedAt = asPointer(version)
+	}
+	if !*dep.Pinned {
+		dep.Remediation = remediation.CreateAzurePipelinesPinningRemediation(&dep)
+	}
+	w.results.Dependencies = append(w.results.Dependencies, dep)
+
+	inputs := yamlLookup(step, "inputs")
+	switch {
+	case strings.EqualFold(name, "Bash"):
+		// Bash runs a file unless the target type is inline, on every platform.
+		if strings.EqualFold(yamlScalar(yamlLookup(inputs, "targetType")), "inline") {
+			w.script(yamlLookup(inputs, "script"))
+		}
+	case strings.EqualFold(name, "CmdLine") && !windows:
+		w.script(yamlLookup(inputs, "script"))
+	}
+}
+
+// script runs an inline script through the shell script analysis.
+func (w *azurePipelinesWalker) script(n *yaml.Node) {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return
+	}
+	// Block scalars start on the line after their indicator.
+	line := uint(n.Line) - 1
+	if n.Style == yaml.LiteralStyle || n.Style == yaml.FoldedStyle {
+		line++
+	}
+	// We replace the `${{ parameters.variable }}` to avoid shell parsing failures.
+	script := azureExpressionRegex.ReplaceAll([]byte(n.Value), []byte("AZURE_REDACTED_VAR"))
+	if err := validateShellFile(w.path, line, line, script, map[string]bool{}, &w.results); err != nil {
+		w.results.Dependencies = append(w.results.Dependencies, checker.Dependency{
+			Msg: asPointer(err.Error()),
+		})
+	}
+}
+
+func azurePoolIsWindows(pool *yaml.Node) bool {
+	image := yamlScalar(pool)
+	if pool.Kind == yaml.MappingNode {
+		image = yamlScalar(yamlLookup(pool, "vmImage")) + " " + yamlScalar(yamlLookup(pool, "name"))
+	}
+	image = strings.ToLower(image)
+	return strings.Contains(image, "windows") || strings.Contains(image, "-win")
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestValidateAzurePipelines(t *testing.T) {
+	t.Parallel()
+	var r checker.PinningDependenciesData
+	var pipelines azurePipelines
+	for _, f := range []string{"azure-pipelines.yml", ".azure-pipelines/local.yml"} {
+		content, err := os.ReadFile(filepath.Join("testdata/azure-pipelines-pinning", f))
+		if err != nil {
+			t.Fatalf("cannot read file: %v", err)
+		}
+		if _, err := parseAzurePipelinesFile(f, content, &pipelines, &r); err != nil {
+			t.Fatalf("parseAzurePipelinesFile: %v", err)
+		}
+	}
+	validateAzurePipelines(&pipelines, &r)
+
+	want := []ciDependency{
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesContainerImage,
+			Path:    "azure-pipelines.yml",
+			Snippet: "ubuntu:22.04",
+			Line:    13,
+		},
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesContainerImage,
+			Path:    "azure-pipelines.yml",
+			Snippet: "mcr.microsoft.com/dotnet/sdk@sha256:9e3b6a5f2a4c1d8e7f6b5a4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e",
+			Line:    15,
+			Pinned:  true,
+		},
+		// The job container is the alias of a container resource.
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesContainerImage,
+			Path:    "azure-pipelines.yml",
+			Snippet: "redis:7",
+			Line:    23,
+		},
+		{
+			Type:       checker.DependencyUseTypeAzurePipelinesTask,
+			Path:       "azure-pipelines.yml",
+			Snippet:    "NodeTool@0",
+			Line:       25,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesTask,
+			Path:    "azure-pipelines.yml",
+			Snippet: "UseDotNet@2.210.1",
+			Line:    26,
+			Pinned:  true,
+		},
+		{
+			Type:    checker.DependencyUseTypeDownloadThenRun,
+			Path:    "azure-pipelines.yml",
+			Snippet: "curl -sSL https://example.com/install.sh | bash",
+			Line:    28,
+		},
+		{
+			Type:    checker.DependencyUseTypePipCommand,
+			Path:    "azure-pipelines.yml",
+			Snippet: "pip install requests",
+			Line:    29,
+		},
+		{
+			Type:       checker.DependencyUseTypeAzurePipelinesTask,
+			Path:       "azure-pipelines.yml",
+			Snippet:    "Bash@3",
+			Line:       30,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeNpmCommand,
+			Path:    "azure-pipelines.yml",
+			Snippet: "npm install -g typescript",
+			Line:    33,
+		},
+		// Neither PowerShell scripts, nor the scripts of Windows jobs, are analyzed.
+		{
+			Type:       checker.DependencyUseTypeAzurePipelinesTask,
+			Path:       "azure-pipelines.yml",
+			Snippet:    "PowerShell@2",
+			Line:       34,
+			Remediated: true,
+		},
+		{
+			Type:       checker.DependencyUseTypeAzurePipelinesTemplate,
+			Path:       "azure-pipelines.yml",
+			Snippet:    ".azure-pipelines/jobs.yml@templates",
+			Line:       43,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesTemplate,
+			Path:    "azure-pipelines.yml",
+			Snippet: "jobs/release.yml@pinned",
+			Line:    44,
+			Pinned:  true,
+		},
+		// Templates use the repository resources of the pipelines including them.
+		{
+			Type:    checker.DependencyUseTypeAzurePipelinesContainerImage,
+			Path:    ".azure-pipelines/local.yml",
+			Snippet: "node:20",
+			Line:    3,
+		},
+		{
+			Type:       checker.DependencyUseTypeAzurePipelinesTemplate,
+			Path:       ".azure-pipelines/local.yml",
+			Snippet:    "steps.yml@templates",
+			Line:       5,
+			Remediated: true,
+		},
+	}
+	if diff := cmp.Diff(want, ciDependencies(&r)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if len(r.ProcessingErrors) != 0 {
+		t.Errorf("unexpected processing errors: %v", r.ProcessingErrors)
+	}
+}
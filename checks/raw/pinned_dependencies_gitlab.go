@@ -0,0 +1,156 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+// gitlabIntegrityRegex matches the integrity of remote includes, which GitLab verifies.
+var gitlabIntegrityRegex = regexp.MustCompile(`^sha256-[A-Za-z0-9+/]{43}=$`)
+
+// Check pinning of container images, includes and scripts in GitLab CI.
+func collectGitLabCIPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	start := len(r.Dependencies)
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.GitLabCIFile,
+		CaseSensitive: true,
+	}, validateGitLabCI, r, c.RepoClient)
+	if err != nil {
+		return err
+	}
+
+	applyContainerImagePinningRemediations(r.Dependencies[start:])
+	return nil
+}
+
+// validateGitLabCI checks if the GitLab CI configuration, and the local files it includes,
+// use unpinned container images and includes, or download dependencies that are unpinned.
+var validateGitLabCI fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, _ := fileparser.IsGitLabCIFile(pathfn); !ok {
+		return true, nil
+	}
+
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateGitLabCI requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	client, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf("validateGitLabCI expects arg[1] of type clients.RepoClient: %w", errInvalidArgType)
+	}
+
+	pipeline, err := fileparser.ParseGitLabCI(client, pathfn, content)
+	if err != nil {
+		// Report the file as skipped, other configurations may still be analyzed.
+		pdata.ProcessingErrors = append(pdata.ProcessingErrors, checker.ElementError{
+			Err: sce.WithMessage(sce.ErrScorecardInternal, err.Error()),
+			Location: finding.Location{
+				Path: pathfn,
+				Type: finding.FileTypeSource,
+			},
+		})
+		return true, nil
+	}
+
+	// Jobs share their defaults and templates, which are reported once.
+	var results checker.PinningDependenciesData
+	for i := range pipeline.Includes {
+		results.Dependencies = append(results.Dependencies, gitlabCIIncludeDependency(&pipeline.Includes[i]))
+	}
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for _, image := range job.Images {
+			results.Dependencies = append(results.Dependencies, containerImageDependency(
+				image.Path, image.Line, image.Value, checker.DependencyUseTypeGitLabCIContainerImage))
+		}
+		validateGitLabCIJobScript(job, &results)
+	}
+	appendUniquePinningResults(pdata, &results)
+
+	return true, nil
+}
+
+func gitlabCIIncludeDependency(include *fileparser.GitLabCIInclude) checker.Dependency {
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      include.Source.Path,
+			Type:      finding.FileTypeSource,
+			Offset:    include.Source.Line,
+			EndOffset: include.Source.Line,
+			Snippet:   include.Source.Value,
+		},
+		Name: asPointer(include.Source.Value),
+		Type: checker.DependencyUseTypeGitLabCIInclude,
+	}
+
+	var pinned bool
+	switch include.Type {
+	case "remote":
+		// GitLab fails the pipeline if the file doesn't match its integrity.
+		pinned = gitlabIntegrityRegex.MatchString(include.Integrity)
+		if include.Integrity != "" {
+			dep.PinnedAt = asPointer(include.Integrity)
+		}
+	case "project", "component":
+		pinned = gitCommitHashRegex.MatchString(include.Ref)
+		if include.Type == "component" {
+			dep.Name = asPointer(strings.TrimSuffix(include.Source.Value, "@"+include.Ref))
+		}
+		if include.Ref != "" {
+			dep.PinnedAt = asPointer(include.Ref)
+		}
+	}
+	dep.Pinned = asBoolPointer(pinned)
+	if !pinned {
+		dep.Remediation = remediation.CreateGitLabCIIncludePinningRemediation(&dep, include.Type)
+	}
+	return dep
+}
+
+// validateGitLabCIJobScript runs the commands of a job through the shell script analysis.
+func validateGitLabCIJobScript(job *fileparser.GitLabCIJob, r *checker.PinningDependenciesData) {
+	// Windows runners run scripts with PowerShell, which we don't support.
+	for _, tag := range job.Tags {
+		if strings.Contains(strings.ToLower(tag.Value), "windows") {
+			return
+		}
+	}
+
+	// Commands of a job run in the same shell, one after the other.
+	taintedFiles := make(map[string]bool)
+	for _, command := range job.Commands {
+		if err := validateShellFile(command.Path, command.Line-1, command.Line-1,
+			[]byte(command.Value), taintedFiles, r); err != nil {
+			r.Dependencies = append(r.Dependencies, checker.Dependency{
+				Msg: asPointer(err.Error()),
+			})
+		}
+	}
+}
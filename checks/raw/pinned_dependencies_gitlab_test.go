@@ -0,0 +1,196 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+// ciDependency summarizes a dependency of a CI configuration.
+type ciDependency struct {
+	Type    checker.DependencyUseType
+	Path    string
+	Snippet string
+	Line    uint
+	Pinned  bool
+	// Remediated is whether the dependency has a remediation.
+	Remediated bool
+}
+
+func ciDependencies(r *checker.PinningDependenciesData) []ciDependency {
+	var deps []ciDependency
+	for _, d := range r.Dependencies {
+		if d.Location == nil {
+			continue
+		}
+		deps = append(deps, ciDependency{
+			Type:       d.Type,
+			Path:       d.Location.Path,
+			Snippet:    d.Location.Snippet,
+			Line:       d.Location.Offset,
+			Pinned:     d.Pinned != nil && *d.Pinned,
+			Remediated: d.Remediation != nil,
+		})
+	}
+	return deps
+}
+
+func mockRepoFiles(t *testing.T, dir string) clients.RepoClient {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("listing %s: %v", dir, err)
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+		func(predicate func(string) (bool, error)) ([]string, error) {
+			var matched []string
+			for _, f := range files {
+				if ok, err := predicate(f); err != nil || ok {
+					matched = append(matched, f)
+				}
+			}
+			return matched, nil
+		}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+		return os.Open(filepath.Join(dir, f))
+	}).AnyTimes()
+	return mockRepoClient
+}
+
+func TestValidateGitLabCI(t *testing.T) {
+	t.Parallel()
+	client := mockRepoFiles(t, "testdata/gitlab-ci-pinning")
+	content, err := os.ReadFile("testdata/gitlab-ci-pinning/.gitlab-ci.yml")
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+
+	var r checker.PinningDependenciesData
+	if _, err := validateGitLabCI(".gitlab-ci.yml", content, &r, client); err != nil {
+		t.Fatalf("validateGitLabCI: %v", err)
+	}
+
+	want := []ciDependency{
+		{
+			Type:       checker.DependencyUseTypeGitLabCIInclude,
+			Path:       ".gitlab-ci.yml",
+			Snippet:    "https://example.com/ci/lint.yml",
+			Line:       3,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeGitLabCIInclude,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "https://example.com/ci/verified.yml",
+			Line:    4,
+			Pinned:  true,
+		},
+		{
+			Type:       checker.DependencyUseTypeGitLabCIInclude,
+			Path:       ".gitlab-ci.yml",
+			Snippet:    "my-group/templates",
+			Line:       6,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeGitLabCIInclude,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "my-group/templates",
+			Line:    9,
+			Pinned:  true,
+		},
+		{
+			Type:       checker.DependencyUseTypeGitLabCIInclude,
+			Path:       ".gitlab-ci.yml",
+			Snippet:    "$CI_SERVER_FQDN/my-group/components/sast@1.0.0",
+			Line:       12,
+			Remediated: true,
+		},
+		{
+			Type:    checker.DependencyUseTypeGitLabCIContainerImage,
+			Path:    "ci/build.yml",
+			Snippet: "golang@sha256:9e3b6a5f2a4c1d8e7f6b5a4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e",
+			Line:    2,
+			Pinned:  true,
+		},
+		{
+			Type:    checker.DependencyUseTypeGitLabCIContainerImage,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "postgres@sha256:3c5c7ff2f0b0e4f0a3b5f4c4c9b4c9dd4b1f7e7ea5bcbe0e8d6c1b8f6f1a2b3c",
+			Line:    18,
+			Pinned:  true,
+		},
+		{
+			Type:    checker.DependencyUseTypeGoCommand,
+			Path:    "ci/build.yml",
+			Snippet: "go install github.com/example/tool@latest",
+			Line:    4,
+		},
+		// Jobs inheriting the default image report it once.
+		{
+			Type:    checker.DependencyUseTypeGitLabCIContainerImage,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "ruby:3.3",
+			Line:    16,
+		},
+		{
+			Type:    checker.DependencyUseTypeDownloadThenRun,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "curl -sSL https://example.com/install.sh | bash",
+			Line:    24,
+		},
+		{
+			Type:    checker.DependencyUseTypeGitLabCIContainerImage,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "alpine:3.20",
+			Line:    28,
+		},
+		{
+			Type:    checker.DependencyUseTypePipCommand,
+			Path:    ".gitlab-ci.yml",
+			Snippet: "pip install requests",
+			Line:    32,
+		},
+		// The scripts of Windows jobs aren't analyzed.
+	}
+	if diff := cmp.Diff(want, ciDependencies(&r)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if len(r.ProcessingErrors) != 0 {
+		t.Errorf("unexpected processing errors: %v", r.ProcessingErrors)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// kubernetesContainerKeys are the keys of the containers of Kubernetes pod specs.
+var kubernetesContainerKeys = map[string]bool{
+	"containers":          true,
+	"initContainers":      true,
+	"ephemeralContainers": true,
+}
+
+// Check pinning of container images in Kubernetes manifests, Kustomizations, Helm values and Compose files.
+func collectManifestImagePinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	charts, err := c.RepoClient.ListFiles(func(f string) (bool, error) {
+		return path.Base(f) == "Chart.yaml", nil
+	})
+	if err != nil {
+		return fmt.Errorf("error during ListFiles: %w", err)
+	}
+	chartDirs := make(map[string]bool)
+	for _, chart := range charts {
+		chartDirs[path.Dir(chart)] = true
+	}
+
+	start := len(r.Dependencies)
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*.y*ml",
+		CaseSensitive: false,
+	}, validateManifestImages, r, chartDirs)
+	if err != nil {
+		return err
+	}
+
+	applyContainerImagePinningRemediations(r.Dependencies[start:])
+	return nil
+}
+
+// validateManifestImages checks if a Kubernetes manifest, Kustomization, Helm values or Compose
+// file references container images by tag rather than by digest.
+var validateManifestImages fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateManifestImages requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	chartDirs, ok := args[1].(map[string]bool)
+	if !ok {
+		return false, fmt.Errorf("validateManifestImages expects arg[1] of type map[string]bool: %w", errInvalidArgType)
+	}
+
+	if fileIsInVendorDir(pathfn) {
+		return true, nil
+	}
+
+	base := strings.ToLower(path.Base(pathfn))
+	m := manifestImages{path: pathfn, r: pdata}
+	var validate func(doc *yaml.Node)
+	switch {
+	case strings.TrimSuffix(strings.TrimSuffix(base, ".yaml"), ".yml") == "kustomization":
+		validate = m.kustomization
+	case strings.HasPrefix(base, "docker-compose") || strings.HasPrefix(base, "compose."):
+		validate = m.compose
+	case chartDirs[path.Dir(pathfn)] && strings.HasPrefix(base, "values"):
+		validate = m.helmValues
+	case bytes.Contains(content, []byte("{{")):
+		// Templates, e.g. those of Helm charts, aren't valid manifests until rendered.
+		return true, nil
+	}
+
+	docs, err := decodeYAMLDocuments(content)
+	if err != nil {
+		// Any YAML file may be a Kubernetes manifest, so only the others are reported.
+		if validate != nil {
+			pdata.ProcessingErrors = append(pdata.ProcessingErrors, checker.ElementError{
+				Err: sce.WithMessage(sce.ErrScorecardInternal, err.Error()),
+				Location: finding.Location{
+					Path: pathfn,
+					Type: finding.FileTypeSource,
+				},
+			})
+		}
+		return true, nil
+	}
+
+	if validate == nil {
+		validate = m.kubernetes
+	}
+	for _, doc := range docs {
+		if len(doc.Content) > 0 {
+			validate(doc.Content[0])
+		}
+	}
+	return true, nil
+}
+
+func decodeYAMLDocuments(content []byte) ([]*yaml.Node, error) {
+	var docs []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding YAML: %w", err)
+		}
+		docs = append(docs, &doc)
+	}
+}
+
+// manifestImages records the images referenced by a file.
+type manifestImages struct {
+	r    *checker.PinningDependenciesData
+	path string
+}
+
+func (m *manifestImages) image(image string, line, endLine uint) {
+	if image == "" {
+		return
+	}
+	dep := containerImageDependency(m.path, line, image, checker.DependencyUseTypeManifestContainerImage)
+	dep.Location.EndOffset = endLine
+	m.r.Dependencies = append(m.r.Dependencies, dep)
+}
+
+// scalarImage records an image referenced by a string.
+func (m *manifestImages) scalarImage(n *yaml.Node) {
+	if n != nil && n.Kind == yaml.ScalarNode {
+		m.image(n.Value, uint(n.Line), uint(n.Line))
+	}
+}
+
+// kubernetes records the images of the containers of the pod specs of a manifest,
+// wherever they are nested, e.g. in Deployments, CronJobs or Lists.
+func (m *manifestImages) kubernetes(n *yaml.Node) {
+	if yamlLookup(n, "apiVersion") == nil || yamlLookup(n, "kind") == nil {
+		return
+	}
+	m.podSpecs(n)
+}
+
+func (m *manifestImages) podSpecs(n *yaml.Node) {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			m.podSpecs(c)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i].Value, n.Content[i+1]
+			if kubernetesContainerKeys[key] {
+				for _, container := range yamlItems(value) {
+					m.scalarImage(yamlLookup(container, "image"))
+				}
+				continue
+			}
+			m.podSpecs(value)
+		}
+	}
+}
+
+// compose records the images of the services of a Compose file.
+func (m *manifestImages) compose(n *yaml.Node) {
+	services := yamlLookup(n, "services")
+	for i := 1; services != nil && services.Kind == yaml.MappingNode && i < len(services.Content); i += 2 {
+		m.scalarImage(yamlLookup(services.Content[i], "image"))
+	}
+}
+
+// kustomization records the images a Kustomization sets the tag or digest of.
+// Those only renamed keep the tag of the manifests, which are analyzed themselves.
+func (m *manifestImages) kustomization(n *yaml.Node) {
+	for _, entry := range yamlItems(yamlLookup(n, "images")) {
+		name := yamlLookup(entry, "name")
+		tag, digest := yamlLookup(entry, "newTag"), yamlLookup(entry, "digest")
+		if name == nil || (tag == nil && digest == nil) {
+			continue
+		}
+		image := yamlScalar(name)
+		if newName := yamlScalar(yamlLookup(entry, "newName")); newName != "" {
+			image = newName
+		}
+		m.image(imageReference(image, yamlScalar(tag), yamlScalar(digest)), uint(entry.Line), lastLine(entry))
+	}
+}
+
+// helmValues records the images of the values of a Helm chart: the strings and hashes
+// of keys ending with image, the latter as charts conventionally define them,
+//
+//	image:
+//	  registry: docker.io
+//	  repository: bitnami/nginx
+//	  tag: 1.25.3
+//	  digest: ""
+func (m *manifestImages) helmValues(n *yaml.Node) {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			m.helmValues(c)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i].Value, n.Content[i+1]
+			if !strings.HasSuffix(strings.ToLower(key), "image") {
+				m.helmValues(value)
+				continue
+			}
+			if value.Kind == yaml.ScalarNode {
+				m.scalarImage(value)
+				continue
+			}
+			repository := yamlScalar(yamlLookup(value, "repository"))
+			if repository == "" {
+				m.helmValues(value)
+				continue
+			}
+			if registry := yamlScalar(yamlLookup(value, "registry")); registry != "" {
+				repository = registry + "/" + repository
+			}
+			image := imageReference(repository,
+				yamlScalar(yamlLookup(value, "tag")), yamlScalar(yamlLookup(value, "digest")))
+			m.image(image, uint(value.Line), lastLine(value))
+		}
+	}
+}
+
+// imageReference returns the reference of an image from its name, tag and digest.
+func imageReference(name, tag, digest string) string {
+	if tag != "" {
+		name += ":" + tag
+	}
+	if digest != "" {
+		name += "@" + digest
+	}
+	return name
+}
+
+// lastLine returns the last line of a node.
+func lastLine(n *yaml.Node) uint {
+	line := uint(n.Line)
+	for _, c := range n.Content {
+		if l := lastLine(c); l > line {
+			line = l
+		}
+	}
+	return line
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestValidateManifestImages(t *testing.T) {
+	t.Parallel()
+	files := []string{
+		"deploy/app.yaml",
+		"deploy/kustomization.yaml",
+		"docker-compose.yml",
+		"chart/Chart.yaml",
+		"chart/values.yaml",
+		"chart/templates/deployment.yaml",
+	}
+	chartDirs := map[string]bool{"chart": true}
+
+	var r checker.PinningDependenciesData
+	for _, f := range files {
+		content, err := os.ReadFile(filepath.Join("testdata/manifest-images", f))
+		if err != nil {
+			t.Fatalf("cannot read file: %v", err)
+		}
+		if _, err := validateManifestImages(f, content, &r, chartDirs); err != nil {
+			t.Fatalf("validateManifestImages: %v", err)
+		}
+	}
+
+	want := []ciDependency{
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "deploy/app.yaml",
+			Snippet: "ghcr.io/example/migrate@sha256:9e3b6a5f2a4c1d8e7f6b5a4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e",
+			Line:    10,
+			Pinned:  true,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "deploy/app.yaml",
+			Snippet: "ghcr.io/example/app:1.4.2",
+			Line:    13,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "deploy/app.yaml",
+			Snippet: "busybox",
+			Line:    26,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "deploy/kustomization.yaml",
+			Snippet: "ghcr.io/example/app:1.5.0",
+			Line:    6,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "deploy/kustomization.yaml",
+			Snippet: "registry.example.com/busybox@sha256:3c5c7ff2f0b0e4f0a3b5f4c4c9b4c9dd4b1f7e7ea5bcbe0e8d6c1b8f6f1a2b3c",
+			Line:    8,
+			Pinned:  true,
+		},
+		// Renaming an image keeps the tag of the manifests.
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "docker-compose.yml",
+			Snippet: "postgres:16",
+			Line:    5,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "docker-compose.yml",
+			Snippet: "redis@sha256:3c5c7ff2f0b0e4f0a3b5f4c4c9b4c9dd4b1f7e7ea5bcbe0e8d6c1b8f6f1a2b3c",
+			Line:    7,
+			Pinned:  true,
+		},
+		// An empty tag is the appVersion of the chart.
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "chart/values.yaml",
+			Snippet: "ghcr.io/example/app",
+			Line:    2,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "chart/values.yaml",
+			Snippet: "prom/statsd-exporter:v0.26.0@sha256:9e3b6a5f2a4c1d8e7f6b5a4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e",
+			Line:    8,
+			Pinned:  true,
+		},
+		{
+			Type:    checker.DependencyUseTypeManifestContainerImage,
+			Path:    "chart/values.yaml",
+			Snippet: "envoyproxy/envoy:v1.29.1",
+			Line:    13,
+		},
+		// Chart templates aren't valid manifests until rendered.
+	}
+	if diff := cmp.Diff(want, ciDependencies(&r)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if len(r.ProcessingErrors) != 0 {
+		t.Errorf("unexpected processing errors: %v", r.ProcessingErrors)
+	}
+}
@@ -1838,6 +1838,31 @@ func TestGitHubWorkInsecureDownloadsLineNumber(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "windows shells",
+			filename: "./testdata/.github/workflows/github-workflow-download-windows.yaml",
+			expected: []struct {
+				snippet   string
+				startLine uint
+				endLine   uint
+			}{
+				{
+					snippet:   "iwr https://example.com/install.ps1 -UseBasicParsing | iex",
+					startLine: 24,
+					endLine:   24,
+				},
+				{
+					snippet:   "Start-Process .\\setup.exe -Wait",
+					startLine: 29,
+					endLine:   29,
+				},
+				{
+					snippet:   "agent.exe /quiet",
+					startLine: 34,
+					endLine:   34,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -0,0 +1,418 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	intoto "github.com/in-toto/attestation/go/v1"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/sigverify"
+)
+
+const (
+	// maxProvenanceSize is the largest provenance asset which is read.
+	maxProvenanceSize = 16 << 20
+	// maxProvenanceAttestations is the number of attestations of a provenance asset
+	// which are checked.
+	maxProvenanceAttestations = 10
+	// maxProvenanceSubjects is the number of subjects of a provenance whose digests
+	// are checked against the release assets.
+	maxProvenanceSubjects = 3
+
+	// statementTypeV01 is the type of in-toto statements before v1.
+	statementTypeV01 = "https://in-toto.io/Statement/v0.1"
+	// slsaProvenancePrefix prefixes the predicate types of all SLSA provenance versions.
+	slsaProvenancePrefix = "https://slsa.dev/provenance/"
+)
+
+var errNoSLSAProvenance = errors.New("no SLSA provenance in the attestations")
+
+// provenanceSuffixes are the suffixes of in-toto attestation assets, e.g. the
+// multiple.intoto.jsonl of slsa-github-generator.
+var provenanceSuffixes = []string{".intoto.jsonl", ".intoto.json"}
+
+// hardenedBuilders prefix the IDs of builders meeting SLSA build level 3, which
+// sign provenance with their own identity, isolated from the calling workflow.
+var hardenedBuilders = []string{
+	"https://github.com/slsa-framework/slsa-github-generator/.github/workflows/",
+}
+
+// hostedBuildIssuers are the OIDC issuers of hosted build platforms.
+var hostedBuildIssuers = []string{
+	"https://token.actions.githubusercontent.com",
+	"https://gitlab.com",
+}
+
+// attestationJSON is either a Sigstore bundle or a bare DSSE envelope.
+type attestationJSON struct {
+	DSSEEnvelope *struct {
+		Payload []byte `json:"payload"`
+	} `json:"dsseEnvelope"`
+	Payload []byte `json:"payload"`
+}
+
+// provenanceDigestSetJSON maps digest algorithms to hex digests.
+type provenanceDigestSetJSON map[string]string
+
+type provenanceStatementJSON struct {
+	Type          string `json:"_type"`
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Digest provenanceDigestSetJSON `json:"digest"`
+		Name   string                  `json:"name"`
+	} `json:"subject"`
+	Predicate struct {
+		// v0.1 and v0.2
+		Builder    provenanceBuilderJSON `json:"builder"`
+		Invocation struct {
+			ConfigSource provenanceMaterialJSON `json:"configSource"`
+		} `json:"invocation"`
+		Materials []provenanceMaterialJSON `json:"materials"`
+		// v1
+		BuildDefinition struct {
+			ExternalParameters struct {
+				// Workflow is set by the GitHub Actions build types.
+				Workflow struct {
+					Ref        string `json:"ref"`
+					Repository string `json:"repository"`
+				} `json:"workflow"`
+			} `json:"externalParameters"`
+			ResolvedDependencies []provenanceMaterialJSON `json:"resolvedDependencies"`
+		} `json:"buildDefinition"`
+		RunDetails struct {
+			Builder provenanceBuilderJSON `json:"builder"`
+		} `json:"runDetails"`
+	} `json:"predicate"`
+}
+
+type provenanceBuilderJSON struct {
+	ID string `json:"id"`
+}
+
+type provenanceMaterialJSON struct {
+	Digest provenanceDigestSetJSON `json:"digest"`
+	URI    string                  `json:"uri"`
+}
+
+// isProvenanceAsset returns whether a release asset is an in-toto attestation.
+func isProvenanceAsset(name string) bool {
+	return slices.ContainsFunc(provenanceSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(name, suffix)
+	})
+}
+
+// verifyReleaseProvenance verifies the provenance attached to the latest releases. For
+// each release, the first verified SLSA provenance is kept, or else the last checked.
+func verifyReleaseProvenance(c *checker.CheckRequest, releases []clients.Release) ([]checker.ReleaseProvenance, error) {
+	var (
+		provenance []checker.ReleaseProvenance
+		v          *releaseVerifier
+		repo       string
+	)
+	for i := range releases {
+		if i >= releaseLookBack {
+			break
+		}
+		release := &releases[i]
+		var prov *checker.ReleaseProvenance
+		attempts := 0
+		for j := range release.Assets {
+			asset := &release.Assets[j]
+			if !isProvenanceAsset(asset.Name) || asset.DownloadURL == "" {
+				continue
+			}
+			if attempts >= maxSignatureAttempts {
+				break
+			}
+			attempts++
+			// Provenance is signed keyless, so no keys are read from the repository.
+			if v == nil {
+				root, err := sigverify.PublicGoodTrustedRoot()
+				if err != nil {
+					return nil, fmt.Errorf("sigverify.PublicGoodTrustedRoot: %w", err)
+				}
+				v = &releaseVerifier{c: c, root: root}
+				repo = forgeRepoPath(c)
+			}
+			if attempts == 1 {
+				v.digests = map[string]sigverify.Digest{}
+			}
+			prov = v.verifyProvenance(release, asset, repo)
+			if prov.Signer != nil {
+				break
+			}
+		}
+		if prov != nil {
+			provenance = append(provenance, *prov)
+		}
+	}
+	return provenance, nil
+}
+
+// verifyProvenance verifies the attestations of a provenance asset, until one of them
+// is a verified SLSA provenance.
+func (v *releaseVerifier) verifyProvenance(release *clients.Release, asset *clients.ReleaseAsset,
+	repo string,
+) *checker.ReleaseProvenance {
+	prov := &checker.ReleaseProvenance{
+		Release: release.TagName,
+		File: checker.File{
+			Path: asset.URL,
+			Type: finding.FileTypeURL,
+		},
+	}
+	content, err := v.read(asset, maxProvenanceSize)
+	if err != nil {
+		prov.Error = err.Error()
+		return prov
+	}
+
+	var (
+		statement *provenanceStatementJSON
+		verified  *sigverify.Attestation
+		verr      error
+	)
+	for i, line := range provenanceAttestations(content) {
+		if i >= maxProvenanceAttestations {
+			break
+		}
+		att, payload, err := verifyAttestation(v.root, line)
+		// Unverified statements are still reported, as SLSA build level 1.
+		s, perr := parseProvenanceStatement(payload)
+		if perr != nil {
+			continue
+		}
+		// Logged attestations are preferred, as only their signing time is verified.
+		if err == nil && (verified == nil || att.Logged) {
+			statement, verified = s, att
+			if att.Logged {
+				break
+			}
+		}
+		if err != nil && statement == nil {
+			statement, verr = s, err
+		}
+	}
+	if statement == nil {
+		prov.Error = errNoSLSAProvenance.Error()
+		return prov
+	}
+
+	prov.PredicateType = statement.PredicateType
+	prov.BuilderID = statement.builderID()
+	prov.Source = statement.source()
+	matchProvenanceSource(&prov.Source, release, repo)
+	prov.Subjects = v.provenanceSubjects(release, statement)
+	if verified != nil {
+		prov.Signer = &checker.ReleaseSigner{
+			Identity: verified.Signer.Identity,
+			Issuer:   verified.Signer.Issuer,
+		}
+		prov.Logged = verified.Logged
+	} else {
+		prov.Error = verr.Error()
+	}
+	prov.BuildLevel = provenanceBuildLevel(prov)
+	return prov
+}
+
+// provenanceAttestations splits a provenance asset into its attestations, either a JSON
+// document or JSON lines.
+func provenanceAttestations(content []byte) [][]byte {
+	content = bytes.TrimSpace(content)
+	if json.Valid(content) {
+		return [][]byte{content}
+	}
+	var attestations [][]byte
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			attestations = append(attestations, line)
+		}
+	}
+	return attestations
+}
+
+// verifyAttestation verifies a Sigstore bundle or a DSSE envelope, and returns its
+// payload even if it isn't verified.
+func verifyAttestation(root *sigverify.TrustedRoot, content []byte) (*sigverify.Attestation, []byte, error) {
+	var a attestationJSON
+	if err := json.Unmarshal(content, &a); err != nil {
+		return nil, nil, fmt.Errorf("parsing attestation: %w", err)
+	}
+	if a.DSSEEnvelope != nil {
+		att, err := sigverify.VerifyAttestationBundle(root, content)
+		if err != nil {
+			return nil, a.DSSEEnvelope.Payload, fmt.Errorf("sigstore: %w", err)
+		}
+		return att, att.Payload, nil
+	}
+	att, err := sigverify.VerifyAttestationEnvelope(root, content)
+	if err != nil {
+		return nil, a.Payload, fmt.Errorf("DSSE: %w", err)
+	}
+	return att, att.Payload, nil
+}
+
+// parseProvenanceStatement parses an in-toto statement, failing if its predicate isn't
+// SLSA provenance.
+func parseProvenanceStatement(payload []byte) (*provenanceStatementJSON, error) {
+	var s provenanceStatementJSON
+	if err := json.Unmarshal(payload, &s); err != nil {
+		return nil, fmt.Errorf("parsing in-toto statement: %w", err)
+	}
+	if (s.Type != intoto.StatementTypeUri && s.Type != statementTypeV01) ||
+		!strings.HasPrefix(s.PredicateType, slsaProvenancePrefix) {
+		return nil, errNoSLSAProvenance
+	}
+	return &s, nil
+}
+
+func (s *provenanceStatementJSON) builderID() string {
+	if id := s.Predicate.RunDetails.Builder.ID; id != "" {
+		return id
+	}
+	return s.Predicate.Builder.ID
+}
+
+// source returns the source repository, ref and commit of a provenance, from the
+// workflow of the GitHub Actions build types, or else from the first material.
+func (s *provenanceStatementJSON) source() checker.ProvenanceSource {
+	var materials []provenanceMaterialJSON
+	if p := &s.Predicate; strings.HasSuffix(s.PredicateType, "/v1") {
+		materials = p.BuildDefinition.ResolvedDependencies
+		if w := p.BuildDefinition.ExternalParameters.Workflow; w.Repository != "" {
+			source := checker.ProvenanceSource{Repository: w.Repository, Ref: w.Ref}
+			if len(materials) > 0 {
+				source.Commit = materials[0].Digest.commit()
+			}
+			return source
+		}
+	} else {
+		materials = p.Materials
+		if p.Invocation.ConfigSource.URI != "" {
+			materials = append([]provenanceMaterialJSON{p.Invocation.ConfigSource}, materials...)
+		}
+	}
+	if len(materials) == 0 {
+		return checker.ProvenanceSource{}
+	}
+	repository, ref := splitSourceURI(materials[0].URI)
+	return checker.ProvenanceSource{
+		Repository: repository,
+		Ref:        ref,
+		Commit:     materials[0].Digest.commit(),
+	}
+}
+
+func (d provenanceDigestSetJSON) commit() string {
+	if c := d["gitCommit"]; c != "" {
+		return c
+	}
+	return d["sha1"]
+}
+
+// splitSourceURI splits a source URI such as git+https://github.com/owner/repo@refs/tags/v1.0.0
+// into the repository and the ref.
+func splitSourceURI(uri string) (string, string) {
+	i := strings.LastIndex(uri, "@")
+	if i < 0 || i < strings.Index(uri, "://") {
+		return uri, ""
+	}
+	return uri[:i], uri[i+1:]
+}
+
+// matchProvenanceSource checks the source of a provenance against the repository and
+// the release. A release may be built from its tag, or from its target branch or commit.
+func matchProvenanceSource(s *checker.ProvenanceSource, release *clients.Release, repo string) {
+	if s.Repository != "" && repo != "" {
+		matches := identifierRepoPath(s.Repository) == repo
+		s.MatchesRepo = &matches
+	}
+	if s.Ref == "" && s.Commit == "" {
+		return
+	}
+	target := release.TargetCommitish
+	matches := s.Ref == "refs/tags/"+release.TagName ||
+		(target != "" && (s.Ref == "refs/heads/"+target || strings.EqualFold(s.Commit, target)))
+	s.MatchesRelease = &matches
+}
+
+// provenanceSubjects checks the digests of the subjects of a provenance which are
+// release assets.
+func (v *releaseVerifier) provenanceSubjects(release *clients.Release,
+	s *provenanceStatementJSON,
+) []checker.ProvenanceSubject {
+	subjects := make([]checker.ProvenanceSubject, 0, len(s.Subject))
+	checked := 0
+	for _, subject := range s.Subject {
+		ps := checker.ProvenanceSubject{Name: subject.Name}
+		asset := findReleaseAsset(release, path.Base(subject.Name))
+		if asset != nil && asset.DownloadURL != "" && checked < maxProvenanceSubjects {
+			checked++
+			digest, err := v.digest(asset)
+			if err != nil {
+				v.c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("digest of %s: %v", asset.Name, err)})
+			} else if matches, ok := subject.Digest.matches(digest); ok {
+				ps.Matches = &matches
+			}
+		}
+		subjects = append(subjects, ps)
+	}
+	return subjects
+}
+
+// matches returns whether all the SHA-2 digests of a subject are those of an artifact,
+// and false for ok if it has none.
+func (d provenanceDigestSetJSON) matches(artifact sigverify.Digest) (matches, ok bool) {
+	for alg, digest := range map[string][]byte{"sha256": artifact.SHA256, "sha512": artifact.SHA512} {
+		want, found := d[alg]
+		if !found {
+			continue
+		}
+		ok = true
+		if !strings.EqualFold(want, hex.EncodeToString(digest)) {
+			return false, true
+		}
+	}
+	return ok, ok
+}
+
+// provenanceBuildLevel returns the SLSA build level a provenance evidences. Hosted build
+// platforms sign provenance with the OIDC identity of the build, and hardened builders
+// with their own.
+func provenanceBuildLevel(p *checker.ReleaseProvenance) int {
+	// Without a transparency log entry, the certificate may have expired before the
+	// provenance was signed, so nothing shows it was signed by the hosted build.
+	if p.Signer == nil || !p.Logged || !slices.Contains(hostedBuildIssuers, p.Signer.Issuer) {
+		return 1
+	}
+	for _, builder := range hardenedBuilders {
+		if strings.HasPrefix(p.BuilderID, builder) && p.Signer.Identity == p.BuilderID {
+			return 3
+		}
+	}
+	return 2
+}
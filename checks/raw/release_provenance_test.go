@@ -0,0 +1,212 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestVerifyReleaseProvenance(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("testdata", "release-provenance")
+	asset := func(name string) clients.ReleaseAsset {
+		return clients.ReleaseAsset{
+			Name:        name,
+			URL:         "https://example.com/releases/" + name,
+			DownloadURL: "https://example.com/download/" + name,
+		}
+	}
+	releases := []clients.Release{
+		{
+			TagName:         "v2.0.0",
+			TargetCommitish: "main",
+			Assets:          []clients.ReleaseAsset{asset("sigstore-2.0.0.intoto.jsonl")},
+		},
+		{
+			TagName: "v1.0.0",
+			Assets: []clients.ReleaseAsset{
+				asset("tool.tar.gz"),
+				asset("tool.zip"),
+				asset("tool.intoto.jsonl"),
+			},
+		},
+		{TagName: "v0.9.0", Assets: []clients.ReleaseAsset{asset("tool.tar.gz")}},
+		{
+			TagName: "v0.8.0",
+			Assets: []clients.ReleaseAsset{
+				{Name: "tool.intoto.jsonl", URL: "https://example.com/releases/tool.intoto.jsonl"},
+			},
+		},
+	}
+
+	matches, mismatches := true, false
+	want := []checker.ReleaseProvenance{
+		{
+			Signer: &checker.ReleaseSigner{
+				Identity: "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/main",
+				Issuer:   "https://token.actions.githubusercontent.com",
+			},
+			Release:       "v2.0.0",
+			PredicateType: "https://slsa.dev/provenance/v1",
+			BuilderID:     "https://github.com/actions/runner/github-hosted",
+			Source: checker.ProvenanceSource{
+				MatchesRepo:    &matches,
+				MatchesRelease: &matches,
+				Repository:     "https://github.com/sigstore/sigstore-js",
+				Ref:            "refs/heads/main",
+				Commit:         "f0b49a04e5a62250e0f60fb128004a73110fe311",
+			},
+			Subjects: []checker.ProvenanceSubject{{Name: "pkg:npm/sigstore@2.0.0"}},
+			File: checker.File{
+				Path: "https://example.com/releases/sigstore-2.0.0.intoto.jsonl",
+				Type: finding.FileTypeURL,
+			},
+			BuildLevel: 2,
+			Logged:     true,
+		},
+		{
+			Release:       "v1.0.0",
+			PredicateType: "https://slsa.dev/provenance/v0.2",
+			BuilderID:     "https://github.com/owner/tool/.github/workflows/release.yml@refs/tags/v1.0.0",
+			Error:         "DSSE: unsupported: DSSE envelope signed with a public key",
+			Source: checker.ProvenanceSource{
+				MatchesRepo:    &mismatches,
+				MatchesRelease: &matches,
+				Repository:     "git+https://github.com/owner/tool",
+				Ref:            "refs/tags/v1.0.0",
+				Commit:         "4d2a2c8ec0e5f7bca1b6d5a4f5b7c1a8e2d9f0a1",
+			},
+			Subjects: []checker.ProvenanceSubject{
+				{Name: "tool.tar.gz", Matches: &matches},
+				{Name: "dist/tool.zip", Matches: &mismatches},
+				{Name: "tool.deb"},
+			},
+			File: checker.File{
+				Path: "https://example.com/releases/tool.intoto.jsonl",
+				Type: finding.FileTypeURL,
+			},
+			BuildLevel: 1,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().GetReleaseAssetReader(gomock.Any()).DoAndReturn(
+		func(a *clients.ReleaseAsset) (io.ReadCloser, error) {
+			return os.Open(filepath.Join(dir, path.Base(a.DownloadURL)))
+		}).AnyTimes()
+	mockRepo := mockrepo.NewMockRepo(ctrl)
+	mockRepo.EXPECT().URI().Return("github.com/sigstore/sigstore-js").AnyTimes()
+	req := checker.CheckRequest{
+		Repo:       mockRepo,
+		RepoClient: mockRepoClient,
+		Ctx:        context.Background(),
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	got, err := verifyReleaseProvenance(&req, releases)
+	if err != nil {
+		t.Fatalf("verifyReleaseProvenance: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestProvenanceBuildLevel(t *testing.T) {
+	t.Parallel()
+	const generator = "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/" +
+		"generator_generic_slsa3.yml@refs/tags/v2.0.0"
+	tests := []struct {
+		signer    *checker.ReleaseSigner
+		name      string
+		builderID string
+		want      int
+		logged    bool
+	}{
+		{
+			name:      "not verified",
+			builderID: generator,
+			want:      1,
+		},
+		{
+			name:      "signed by a person",
+			signer:    &checker.ReleaseSigner{Identity: "dev@example.com", Issuer: "https://accounts.google.com"},
+			builderID: "https://github.com/actions/runner/github-hosted",
+			want:      1,
+			logged:    true,
+		},
+		{
+			name: "signed by a GitHub Actions workflow",
+			signer: &checker.ReleaseSigner{
+				Identity: "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:   "https://token.actions.githubusercontent.com",
+			},
+			builderID: "https://github.com/actions/runner/github-hosted",
+			want:      2,
+			logged:    true,
+		},
+		{
+			name: "hardened builder ID claimed by another workflow",
+			signer: &checker.ReleaseSigner{
+				Identity: "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:   "https://token.actions.githubusercontent.com",
+			},
+			builderID: generator,
+			want:      2,
+			logged:    true,
+		},
+		{
+			name:      "signed by slsa-github-generator",
+			signer:    &checker.ReleaseSigner{Identity: generator, Issuer: "https://token.actions.githubusercontent.com"},
+			builderID: generator,
+			want:      3,
+			logged:    true,
+		},
+		{
+			name:      "not in the transparency log",
+			signer:    &checker.ReleaseSigner{Identity: generator, Issuer: "https://token.actions.githubusercontent.com"},
+			builderID: generator,
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := provenanceBuildLevel(&checker.ReleaseProvenance{
+				Signer:    tt.signer,
+				BuilderID: tt.builderID,
+				Logged:    tt.logged,
+			})
+			if got != tt.want {
+				t.Errorf("provenanceBuildLevel() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/sigverify"
+)
+
+const (
+	// maxSignatureSize is the largest signature asset which is read.
+	maxSignatureSize = 1 << 20
+	// maxReleaseArtifactSize is the largest release artifact which is downloaded to
+	// verify its signature. Artifacts are hashed as they're read, not buffered, but each
+	// run checks up to 3 signatures of 5 releases.
+	maxReleaseArtifactSize = 32 << 20
+	// maxSignatureAttempts is the number of signatures of a release which are
+	// checked before giving up on it.
+	maxSignatureAttempts = 3
+)
+
+var (
+	errReleaseAssetTooLarge = errors.New("release asset too large")
+	errNoSignedArtifact     = errors.New("signed artifact not found in the release")
+)
+
+var (
+	// Release signing keys are published at the root of the repository, or in
+	// the .github or keys directories.
+	reOpenPGPKeyFile = regexp.MustCompile(
+		`^(\.github/|keys/)?(KEYS|KEYS\.txt|KEYS\.asc|[^/]+\.(gpg|pgp)|[^/]*(public|signing|release)[-_.]?key[^/]*\.asc)$`)
+	reCosignKeyFile   = regexp.MustCompile(`^(\.github/|keys/)?([^/]+[-_.])?cosign\.pub$`)
+	reMinisignKeyFile = regexp.MustCompile(`^(\.github/|keys/)?([^/]+[-_.])?minisign\.pub$`)
+)
+
+// releaseSignatureSuffixes maps the suffixes of signature assets to their kind. The kind
+// of .sig and .sign signatures, cosign or OpenPGP, is told from their content.
+var releaseSignatureSuffixes = []struct {
+	suffix string
+	kind   checker.SignatureKind
+}{
+	{".sigstore.json", checker.SignatureKindSigstore},
+	{".sigstore", checker.SignatureKindSigstore},
+	{".bundle", checker.SignatureKindCosign},
+	{".minisig", checker.SignatureKindMinisign},
+	{".asc", checker.SignatureKindOpenPGP},
+	{".sig", ""},
+	{".sign", ""},
+}
+
+// releaseSignatureKind returns the kind of a signature asset and the name of the
+// artifact it signs.
+func releaseSignatureKind(name string) (checker.SignatureKind, string, bool) {
+	for _, s := range releaseSignatureSuffixes {
+		if artifact, ok := strings.CutSuffix(name, s.suffix); ok && artifact != "" {
+			return s.kind, artifact, true
+		}
+	}
+	return "", "", false
+}
+
+// releaseVerifier verifies the signatures of release artifacts, with the keys
+// published in the repository.
+type releaseVerifier struct {
+	c        *checker.CheckRequest
+	root     *sigverify.TrustedRoot
+	openpgp  *sigverify.OpenPGPKeys
+	cosign   []crypto.PublicKey
+	minisign []sigverify.MinisignKey
+	// digests caches the digests of the artifacts of the release being checked.
+	digests map[string]sigverify.Digest
+}
+
+func newReleaseVerifier(c *checker.CheckRequest) (*releaseVerifier, error) {
+	root, err := sigverify.PublicGoodTrustedRoot()
+	if err != nil {
+		return nil, fmt.Errorf("sigverify.PublicGoodTrustedRoot: %w", err)
+	}
+	v := &releaseVerifier{c: c, root: root, openpgp: &sigverify.OpenPGPKeys{}}
+	files, err := c.RepoClient.ListFiles(func(f string) (bool, error) {
+		return reOpenPGPKeyFile.MatchString(f) || reCosignKeyFile.MatchString(f) || reMinisignKeyFile.MatchString(f), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	for _, f := range files {
+		content, err := readRepoFile(c.RepoClient, f)
+		if err != nil {
+			return nil, err
+		}
+		// Files which don't hold keys of the expected format are skipped.
+		switch {
+		case reCosignKeyFile.MatchString(f):
+			keys, err := sigverify.ParsePublicKeys(content)
+			if err != nil {
+				c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("parsing %s: %v", f, err)})
+			}
+			v.cosign = append(v.cosign, keys...)
+		case reMinisignKeyFile.MatchString(f):
+			keys, err := sigverify.ParseMinisignKeys(content)
+			if err != nil {
+				c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("parsing %s: %v", f, err)})
+			}
+			v.minisign = append(v.minisign, keys...)
+		default:
+			keys, err := sigverify.ParseOpenPGPKeys(content)
+			if err != nil {
+				c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("parsing %s: %v", f, err)})
+				continue
+			}
+			v.openpgp.Append(keys)
+		}
+	}
+	return v, nil
+}
+
+// verifyReleaseSignatures verifies the signatures of the latest releases, until one
+// signature of each release is verified.
+func verifyReleaseSignatures(c *checker.CheckRequest, releases []clients.Release) ([]checker.ReleaseSignature, error) {
+	var (
+		signatures []checker.ReleaseSignature
+		v          *releaseVerifier
+		repo       string
+	)
+	for i := range releases {
+		if i >= releaseLookBack {
+			break
+		}
+		release := &releases[i]
+		attempts := 0
+		for j := range release.Assets {
+			asset := &release.Assets[j]
+			kind, artifact, ok := releaseSignatureKind(asset.Name)
+			// Signatures which can't be downloaded are left to the name-based checks.
+			if !ok || asset.DownloadURL == "" {
+				continue
+			}
+			if attempts >= maxSignatureAttempts {
+				break
+			}
+			attempts++
+			if v == nil {
+				var err error
+				if v, err = newReleaseVerifier(c); err != nil {
+					return nil, err
+				}
+				repo = forgeRepoPath(c)
+			}
+			if attempts == 1 {
+				v.digests = map[string]sigverify.Digest{}
+			}
+			sig := checker.ReleaseSignature{
+				Release:  release.TagName,
+				Artifact: artifact,
+				Kind:     kind,
+				File: checker.File{
+					Path: asset.URL,
+					Type: finding.FileTypeURL,
+				},
+			}
+			signer, err := v.verify(release, asset, &sig)
+			if err != nil {
+				sig.Error = err.Error()
+			} else {
+				sig.Signer = &checker.ReleaseSigner{
+					Identity:    signer.Identity,
+					Issuer:      signer.Issuer,
+					KeyID:       signer.KeyID,
+					MatchesRepo: signerMatchesRepo(signer, repo),
+				}
+			}
+			signatures = append(signatures, sig)
+			// Signatures by other identities don't count, so the next ones are checked.
+			if sig.Signer != nil && (sig.Signer.MatchesRepo == nil || *sig.Signer.MatchesRepo) {
+				break
+			}
+		}
+	}
+	return signatures, nil
+}
+
+// signerMatchesRepo returns whether the identity of a keyless signature is the repository
+// or one of its workflows. Key-based signatures are trusted through the keys published in
+// the repository, so nil is returned for them. Anyone can sign keyless, so keyless
+// signatures don't match if the repository is unknown.
+func signerMatchesRepo(signer *sigverify.Signer, repo string) *bool {
+	if signer.Issuer == "" {
+		return nil
+	}
+	var matches bool
+	if repo == "" {
+		return &matches
+	}
+	// Reusable workflows may be called from any repository, so the repository which ran
+	// the workflow is preferred to the workflow of the identity.
+	if signer.SourceRepository != "" {
+		matches = identifierRepoPath(signer.SourceRepository) == repo
+	} else {
+		matches = workflowIdentityRepoPath(signer.Identity) == repo
+	}
+	return &matches
+}
+
+// workflowIdentityRepoPath returns the repository of a CI workflow identity, e.g.
+// https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0 or
+// https://gitlab.com/owner/repo//.gitlab-ci.yml@refs/heads/main, or "" for other
+// identities, e.g. emails.
+func workflowIdentityRepoPath(identity string) string {
+	u, ok := strings.CutPrefix(identity, "https://")
+	if !ok {
+		return ""
+	}
+	u, _, _ = strings.Cut(u, "@")
+	for _, sep := range []string{"/.github/workflows/", "//"} {
+		if repo, _, ok := strings.Cut(u, sep); ok {
+			return strings.ToLower(repo)
+		}
+	}
+	return ""
+}
+
+// verify verifies a signature asset, setting the kind of sig once its content is known.
+func (v *releaseVerifier) verify(release *clients.Release, asset *clients.ReleaseAsset,
+	sig *checker.ReleaseSignature,
+) (*sigverify.Signer, error) {
+	content, err := v.read(asset, maxSignatureSize)
+	if err != nil {
+		return nil, err
+	}
+	// Binary OpenPGP signatures may end with whitespace bytes, so only the kind is
+	// told from the trimmed content.
+	trimmed := bytes.TrimSpace(content)
+	isJSON := json.Valid(trimmed)
+	switch {
+	case sig.Kind == checker.SignatureKindCosign && isJSON && bytes.Contains(trimmed, []byte(`"mediaType"`)):
+		// cosign writes Sigstore bundles with --new-bundle-format.
+		sig.Kind = checker.SignatureKindSigstore
+	case sig.Kind == "" && isBase64(trimmed):
+		sig.Kind = checker.SignatureKindCosign
+	case sig.Kind == "":
+		sig.Kind = checker.SignatureKindOpenPGP
+	}
+
+	artifact := findReleaseAsset(release, sig.Artifact)
+	if artifact == nil || artifact.DownloadURL == "" {
+		return nil, errNoSignedArtifact
+	}
+	if sig.Kind == checker.SignatureKindOpenPGP {
+		reader, err := v.c.RepoClient.GetReleaseAssetReader(artifact)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetReleaseAssetReader: %w", err)
+		}
+		defer reader.Close()
+		signer, err := sigverify.VerifyOpenPGP(content, v.openpgp, newSizeLimitedReader(reader, maxReleaseArtifactSize))
+		if err != nil {
+			return nil, fmt.Errorf("OpenPGP: %w", err)
+		}
+		return signer, nil
+	}
+
+	digest, err := v.digest(artifact)
+	if err != nil {
+		return nil, err
+	}
+	var signer *sigverify.Signer
+	switch sig.Kind {
+	case checker.SignatureKindSigstore:
+		signer, err = sigverify.VerifyBundle(v.root, content, digest)
+	case checker.SignatureKindMinisign:
+		signer, err = sigverify.VerifyMinisign(content, v.minisign, digest)
+	default:
+		cosign := &sigverify.CosignSignature{Signature: content}
+		if isJSON {
+			cosign = &sigverify.CosignSignature{Bundle: content}
+		} else if cert := v.cosignCertificate(release, asset, sig.Artifact); cert != nil {
+			cosign.Certificate = cert
+		}
+		signer, err = sigverify.VerifyCosign(v.root, cosign, v.cosign, digest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", sig.Kind, err)
+	}
+	return signer, nil
+}
+
+// cosignCertificate returns the certificate of a keyless cosign signature, published as
+// <artifact>.pem, <artifact>.crt or <signature>.pem.
+func (v *releaseVerifier) cosignCertificate(release *clients.Release, sig *clients.ReleaseAsset,
+	artifact string,
+) []byte {
+	for _, name := range []string{artifact + ".pem", artifact + ".crt", sig.Name + ".pem"} {
+		asset := findReleaseAsset(release, name)
+		if asset == nil || asset.DownloadURL == "" {
+			continue
+		}
+		content, err := v.read(asset, maxSignatureSize)
+		if err == nil {
+			return content
+		}
+	}
+	return nil
+}
+
+func (v *releaseVerifier) read(asset *clients.ReleaseAsset, limit int64) ([]byte, error) {
+	reader, err := v.c.RepoClient.GetReleaseAssetReader(asset)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.GetReleaseAssetReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(newSizeLimitedReader(reader, limit))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", asset.Name, err)
+	}
+	return content, nil
+}
+
+func (v *releaseVerifier) digest(asset *clients.ReleaseAsset) (sigverify.Digest, error) {
+	if d, ok := v.digests[asset.Name]; ok {
+		return d, nil
+	}
+	reader, err := v.c.RepoClient.GetReleaseAssetReader(asset)
+	if err != nil {
+		return sigverify.Digest{}, fmt.Errorf("RepoClient.GetReleaseAssetReader: %w", err)
+	}
+	defer reader.Close()
+	d, err := sigverify.DigestOf(newSizeLimitedReader(reader, maxReleaseArtifactSize))
+	if err != nil {
+		return sigverify.Digest{}, fmt.Errorf("reading %s: %w", asset.Name, err)
+	}
+	v.digests[asset.Name] = d
+	return d, nil
+}
+
+func findReleaseAsset(release *clients.Release, name string) *clients.ReleaseAsset {
+	for i := range release.Assets {
+		if release.Assets[i].Name == name {
+			return &release.Assets[i]
+		}
+	}
+	return nil
+}
+
+func isBase64(content []byte) bool {
+	_, err := base64.StdEncoding.DecodeString(string(content))
+	return len(content) > 0 && err == nil
+}
+
+// sizeLimitedReader fails once more than a limit is read, rather than truncating.
+type sizeLimitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func newSizeLimitedReader(r io.Reader, limit int64) *sizeLimitedReader {
+	return &sizeLimitedReader{r: r, remaining: limit}
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errReleaseAssetTooLarge
+	}
+	return n, err //nolint:wrapcheck // io.EOF must be returned as is.
+}
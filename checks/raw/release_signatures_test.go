@@ -0,0 +1,280 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/sigverify"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestVerifyReleaseSignatures(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("testdata", "release-signatures")
+	asset := func(name string) clients.ReleaseAsset {
+		return clients.ReleaseAsset{
+			Name:        name,
+			URL:         "https://example.com/releases/" + name,
+			DownloadURL: "https://example.com/download/" + name,
+		}
+	}
+	releases := []clients.Release{
+		{
+			TagName: "v1.0.0",
+			Assets: []clients.ReleaseAsset{
+				{Name: "checksums.txt.sig", URL: "https://example.com/releases/checksums.txt.sig"},
+				asset("tool-linux-amd64"),
+				asset("tool-linux-amd64.asc"),
+			},
+		},
+		{TagName: "v0.9.0", Assets: []clients.ReleaseAsset{asset("tool.tar.gz"), asset("tool.tar.gz.minisig")}},
+		{TagName: "v0.8.0", Assets: []clients.ReleaseAsset{asset("tool.zip"), asset("tool.zip.sig")}},
+		{
+			TagName: "v0.7.0",
+			Assets: []clients.ReleaseAsset{
+				asset("tool-0.7.0.zip"),
+				asset("tool-0.7.0.zip.sig"),
+				asset("tool-0.7.0.zip.minisig"),
+			},
+		},
+		{TagName: "v0.6.0", Assets: []clients.ReleaseAsset{asset("tool.deb.asc")}},
+		{TagName: "v0.5.0", Assets: []clients.ReleaseAsset{asset("tool.zip"), asset("tool.zip.sig")}},
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "repo", "cosign.pub"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(content)
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cosignKeyID := sha256.Sum256(der)
+
+	signature := func(release, name, artifact string, kind checker.SignatureKind) checker.ReleaseSignature {
+		return checker.ReleaseSignature{
+			Release:  release,
+			Artifact: artifact,
+			Kind:     kind,
+			File:     checker.File{Path: "https://example.com/releases/" + name, Type: finding.FileTypeURL},
+		}
+	}
+	want := []checker.ReleaseSignature{
+		signature("v1.0.0", "tool-linux-amd64.asc", "tool-linux-amd64", checker.SignatureKindOpenPGP),
+		signature("v0.9.0", "tool.tar.gz.minisig", "tool.tar.gz", checker.SignatureKindMinisign),
+		signature("v0.8.0", "tool.zip.sig", "tool.zip", checker.SignatureKindCosign),
+		signature("v0.7.0", "tool-0.7.0.zip.sig", "tool-0.7.0.zip", checker.SignatureKindOpenPGP),
+		signature("v0.7.0", "tool-0.7.0.zip.minisig", "tool-0.7.0.zip", checker.SignatureKindMinisign),
+		signature("v0.6.0", "tool.deb.asc", "tool.deb", checker.SignatureKindOpenPGP),
+	}
+	want[0].Signer = &checker.ReleaseSigner{
+		Identity: "Release Manager <release@example.com>",
+		KeyID:    "E831D5D5099E04D1CCDBA280A97B5263A605809D",
+	}
+	want[1].Signer = &checker.ReleaseSigner{KeyID: "E85D337B0C912A4E"}
+	want[2].Signer = &checker.ReleaseSigner{KeyID: hex.EncodeToString(cosignKeyID[:])}
+	want[3].Error = "OpenPGP: no matching key"
+	want[4].Signer = &checker.ReleaseSigner{KeyID: "E85D337B0C912A4E"}
+	want[5].Error = errNoSignedArtifact.Error()
+
+	mockRepoClient, ok := mockRepoFiles(t, filepath.Join(dir, "repo")).(*mockrepo.MockRepoClient)
+	if !ok {
+		t.Fatal("unexpected repo client type")
+	}
+	mockRepoClient.EXPECT().GetReleaseAssetReader(gomock.Any()).DoAndReturn(
+		func(a *clients.ReleaseAsset) (io.ReadCloser, error) {
+			name := path.Base(a.DownloadURL)
+			if name == "tool.deb.asc" {
+				name = "tool-linux-amd64.asc"
+			}
+			return os.Open(filepath.Join(dir, "assets", name))
+		}).AnyTimes()
+	req := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Ctx:        context.Background(),
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	got, err := verifyReleaseSignatures(&req, releases)
+	if err != nil {
+		t.Fatalf("verifyReleaseSignatures: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestReleaseVerifierDigest_tooLarge(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().GetReleaseAssetReader(gomock.Any()).Return(
+		io.NopCloser(io.LimitReader(zeroReader{}, maxReleaseArtifactSize+1)), nil)
+	v := &releaseVerifier{
+		c:       &checker.CheckRequest{RepoClient: mockRepoClient},
+		digests: map[string]sigverify.Digest{},
+	}
+	_, err := v.digest(&clients.ReleaseAsset{Name: "tool.tar.gz", DownloadURL: "https://example.com/tool.tar.gz"})
+	if !errors.Is(err, errReleaseAssetTooLarge) {
+		t.Errorf("digest() error = %v, want %v", err, errReleaseAssetTooLarge)
+	}
+}
+
+func TestSignerMatchesRepo(t *testing.T) {
+	t.Parallel()
+	const (
+		repo   = "github.com/owner/repo"
+		github = "https://token.actions.githubusercontent.com"
+	)
+	yes, no := true, false
+	tests := []struct {
+		want   *bool
+		signer sigverify.Signer
+		name   string
+		repo   string
+	}{
+		{
+			name: "workflow of the repository",
+			signer: sigverify.Signer{
+				Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:           github,
+				SourceRepository: "https://github.com/Owner/Repo",
+			},
+			repo: repo,
+			want: &yes,
+		},
+		{
+			name: "reusable workflow called from the repository",
+			signer: sigverify.Signer{
+				Identity:         "https://github.com/org/workflows/.github/workflows/sign.yml@refs/heads/main",
+				Issuer:           github,
+				SourceRepository: "https://github.com/owner/repo",
+			},
+			repo: repo,
+			want: &yes,
+		},
+		{
+			name: "workflow of the repository called from another repository",
+			signer: sigverify.Signer{
+				Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:           github,
+				SourceRepository: "https://github.com/attacker/fork",
+			},
+			repo: repo,
+			want: &no,
+		},
+		{
+			name: "GitLab pipeline without source repository",
+			signer: sigverify.Signer{
+				Identity: "https://gitlab.com/owner/repo//.gitlab-ci.yml@refs/heads/main",
+				Issuer:   "https://gitlab.com",
+			},
+			repo: "gitlab.com/owner/repo",
+			want: &yes,
+		},
+		{
+			name:   "email",
+			signer: sigverify.Signer{Identity: "dev@example.com", Issuer: "https://accounts.google.com"},
+			repo:   repo,
+			want:   &no,
+		},
+		{
+			name:   "key-based signature",
+			signer: sigverify.Signer{KeyID: "E85D337B0C912A4E"},
+			repo:   repo,
+		},
+		{
+			name: "unknown repository",
+			signer: sigverify.Signer{
+				Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:           github,
+				SourceRepository: "https://github.com/owner/repo",
+			},
+			want: &no,
+		},
+		{
+			name:   "key-based signature of an unknown repository",
+			signer: sigverify.Signer{KeyID: "E85D337B0C912A4E"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, signerMatchesRepo(&tt.signer, tt.repo)); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReleaseSignatureKind(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		kind     checker.SignatureKind
+		artifact string
+		ok       bool
+	}{
+		{name: "tool.tar.gz.sigstore.json", kind: checker.SignatureKindSigstore, artifact: "tool.tar.gz", ok: true},
+		{name: "tool.tar.gz.sigstore", kind: checker.SignatureKindSigstore, artifact: "tool.tar.gz", ok: true},
+		{name: "tool.bundle", kind: checker.SignatureKindCosign, artifact: "tool", ok: true},
+		{name: "tool.minisig", kind: checker.SignatureKindMinisign, artifact: "tool", ok: true},
+		{name: "tool.asc", kind: checker.SignatureKindOpenPGP, artifact: "tool", ok: true},
+		{name: "tool.sig", artifact: "tool", ok: true},
+		{name: ".sig"},
+		{name: "tool.tar.gz"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			kind, artifact, ok := releaseSignatureKind(tt.name)
+			if kind != tt.kind || artifact != tt.artifact || ok != tt.ok {
+				t.Errorf("releaseSignatureKind(%q) = %q, %q, %v, want %q, %q, %v",
+					tt.name, kind, artifact, ok, tt.kind, tt.artifact, tt.ok)
+			}
+		})
+	}
+}
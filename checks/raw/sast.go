@@ -87,6 +87,23 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	}
 	data.Workflows = append(data.Workflows, qodanaWorkflows...)
 
+	toolWorkflows, err := getSASTToolWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, toolWorkflows...)
+
+	data.ConfigFiles, err = getSASTConfigFiles(c)
+	if err != nil {
+		return data, err
+	}
+
+	defaultSetup, err := getCodeQLDefaultSetup(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, defaultSetup...)
+
 	return data, nil
 }
 
@@ -159,7 +176,7 @@ func getSastUsesWorkflows(
 	var workflowPaths []string
 	var sastWorkflows []checker.SASTWorkflow
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
+		Pattern:       fileparser.WorkflowFilesPattern,
 		CaseSensitive: false,
 	}, searchGitHubActionWorkflowUseRegex, &workflowPaths, usesRegex)
 	if err != nil {
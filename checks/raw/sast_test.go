@@ -210,6 +210,8 @@ func TestSAST(t *testing.T) {
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("./testdata/" + file)
 			}).AnyTimes()
+			mockRepoClient.EXPECT().GetCodeScanningDefaultSetup().
+				Return(clients.CodeScanningDefaultSetup{}, clients.ErrUnsupportedFeature).AnyTimes()
 			req := checker.CheckRequest{
 				RepoClient: mockRepoClient,
 			}
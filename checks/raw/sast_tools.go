@@ -0,0 +1,491 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// sastCITool describes how to detect a SAST tool run by a GitHub workflow or a GitLab CI job.
+type sastCITool struct {
+	// uses matches the actions running the tool, without their version.
+	uses *regexp.Regexp
+	// command matches the commands running the tool.
+	command *regexp.Regexp
+	// image matches the container images of the tool.
+	image *regexp.Regexp
+	tool  checker.SASTWorkflowType
+}
+
+// toolCommand matches the commands running the tool name, directly or through a package manager.
+func toolCommand(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)(^\s*|[;&|(]\s*|(\bexec|\brun|\s-m)\s+|/bin/)` + name + `(\s|$)`)
+}
+
+var sastCITools = []sastCITool{
+	{
+		tool:    checker.SemgrepWorkflow,
+		uses:    regexp.MustCompile(`^(returntocorp|semgrep)/semgrep-action$`),
+		command: regexp.MustCompile(`\bsemgrep\s+(ci|scan|--config|-c)\b`),
+		image:   regexp.MustCompile(`^(docker\.io/)?(returntocorp|semgrep)/semgrep([:@]|$)`),
+	},
+	{
+		tool:    checker.GosecWorkflow,
+		uses:    regexp.MustCompile(`^securego/gosec$`),
+		command: toolCommand("gosec"),
+		image:   regexp.MustCompile(`^(docker\.io/)?securego/gosec([:@]|$)`),
+	},
+	{
+		tool:    checker.BanditWorkflow,
+		uses:    regexp.MustCompile(`^PyCQA/bandit-action$`),
+		command: toolCommand("bandit"),
+	},
+	{
+		tool:    checker.BrakemanWorkflow,
+		uses:    regexp.MustCompile(`^(devmasx/brakeman-linter-action|artplan1/brakeman-action|reviewdog/action-brakeman)$`),
+		command: toolCommand("brakeman"),
+		image:   regexp.MustCompile(`^(docker\.io/)?presidentbeef/brakeman([:@]|$)`),
+	},
+	{
+		tool:    checker.SpotBugsWorkflow,
+		command: regexp.MustCompile(`\bspotbugs(-maven-plugin:check|:check|Main|Test)\b`),
+	},
+	{
+		tool:    checker.CheckmarxWorkflow,
+		uses:    regexp.MustCompile(`(?i)^checkmarx(-ts)?/(ast-github-action|checkmarx-ast-github-action|checkmarx-cxflow-github-action)$`),
+		command: regexp.MustCompile(`\bcx\s+scan\s+create\b`),
+		image:   regexp.MustCompile(`^(docker\.io/)?checkmarx/(ast-cli|cx-flow)([:@]|$)`),
+	},
+}
+
+// gitlabSASTTemplateRegex matches the GitLab templates running the GitLab SAST analyzers.
+var gitlabSASTTemplateRegex = regexp.MustCompile(`(^|/)SAST(\.latest)?\.gitlab-ci\.yml$`)
+
+// eslintSecurityRegex matches the ESLint plugins finding security issues, and their configurations.
+var eslintSecurityRegex = regexp.MustCompile(
+	`eslint-plugin-security|eslint-plugin-no-unsanitized|@microsoft/eslint-plugin-sdl|` +
+		`plugin:security/|plugin:no-unsanitized/|plugin:@microsoft/sdl/`)
+
+// sastToolsFound records the SAST tools found in a file, to report each tool once per file.
+type sastToolsFound struct {
+	found     map[checker.SASTWorkflowType]bool
+	workflows *[]checker.SASTWorkflow
+	path      string
+}
+
+func newSASTToolsFound(path string, workflows *[]checker.SASTWorkflow) *sastToolsFound {
+	return &sastToolsFound{
+		found:     make(map[checker.SASTWorkflowType]bool),
+		workflows: workflows,
+		path:      path,
+	}
+}
+
+func (s *sastToolsFound) add(tool checker.SASTWorkflowType, line uint, snippet string) {
+	if s.found[tool] {
+		return
+	}
+	s.found[tool] = true
+	*s.workflows = append(*s.workflows, checker.SASTWorkflow{
+		Type: tool,
+		File: checker.File{
+			Path:    s.path,
+			Type:    finding.FileTypeSource,
+			Offset:  line,
+			Snippet: snippet,
+		},
+	})
+}
+
+// matchCommand adds the tools run by command.
+func (s *sastToolsFound) matchCommand(command string, line uint) {
+	for i := range sastCITools {
+		t := &sastCITools[i]
+		if t.command == nil {
+			continue
+		}
+		if loc := t.command.FindStringIndex(command); loc != nil {
+			s.add(t.tool, line, commandLine(command, loc[0]))
+		}
+	}
+}
+
+// commandLine returns the line of script at offset, to use as a snippet.
+func commandLine(script string, offset int) string {
+	start := strings.LastIndexByte(script[:offset], '\n') + 1
+	end := strings.IndexByte(script[offset:], '\n')
+	if end < 0 {
+		return strings.TrimSpace(script[start:])
+	}
+	return strings.TrimSpace(script[start : offset+end])
+}
+
+// matchImage adds the tools of a container image.
+func (s *sastToolsFound) matchImage(image string, line uint) {
+	for i := range sastCITools {
+		t := &sastCITools[i]
+		if t.image != nil && t.image.MatchString(image) {
+			s.add(t.tool, line, image)
+		}
+	}
+}
+
+func getSASTToolWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	var sastWorkflows []checker.SASTWorkflow
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
+		CaseSensitive: false,
+	}, searchGitHubWorkflowSASTTools, &sastWorkflows)
+	if err != nil {
+		return nil, err
+	}
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       fileparser.GitLabCIFile,
+		CaseSensitive: true,
+	}, searchGitLabCISASTTools, &sastWorkflows, c.RepoClient)
+	if err != nil {
+		return nil, err
+	}
+	return sastWorkflows, nil
+}
+
+// searchGitHubWorkflowSASTTools finds the SAST tools GitHub workflows run, with actions,
+// commands or container images.
+var searchGitHubWorkflowSASTTools fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowSASTTools requires exactly 1 argument: %w", errInvalid)
+	}
+	workflows, ok := args[0].(*[]checker.SASTWorkflow)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowSASTTools expects arg[0] of type *[]checker.SASTWorkflow: %w", errInvalid)
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+
+	found := newSASTToolsFound(path, workflows)
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		if job.Container != nil && job.Container.Image != nil {
+			found.matchImage(job.Container.Image.Value, fileparser.GetLineNumber(job.Container.Image.Pos))
+		}
+		for _, step := range job.Steps {
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				if e.Uses == nil {
+					continue
+				}
+				line := fileparser.GetLineNumber(e.Uses.Pos)
+				if image, ok := strings.CutPrefix(e.Uses.Value, "docker://"); ok {
+					found.matchImage(image, line)
+					continue
+				}
+				action, _, _ := strings.Cut(e.Uses.Value, "@")
+				for i := range sastCITools {
+					t := &sastCITools[i]
+					if t.uses != nil && t.uses.MatchString(action) {
+						found.add(t.tool, line, e.Uses.Value)
+					}
+				}
+			case *actionlint.ExecRun:
+				if e.Run != nil {
+					found.matchCommand(e.Run.Value, fileparser.GetLineNumber(e.Run.Pos))
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// searchGitLabCISASTTools finds the SAST tools the GitLab CI configuration runs, with the
+// GitLab SAST template, commands or container images.
+var searchGitLabCISASTTools fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, _ := fileparser.IsGitLabCIFile(path); !ok {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"searchGitLabCISASTTools requires exactly 2 arguments: %w", errInvalid)
+	}
+	workflows, ok := args[0].(*[]checker.SASTWorkflow)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCISASTTools expects arg[0] of type *[]checker.SASTWorkflow: %w", errInvalid)
+	}
+	client, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCISASTTools expects arg[1] of type clients.RepoClient: %w", errInvalid)
+	}
+
+	pipeline, err := fileparser.ParseGitLabCI(client, path, content)
+	if err != nil {
+		// Invalid configurations are reported by Pinned-Dependencies, and don't run any tool.
+		return true, nil
+	}
+
+	// Tools are reported in the file running them, which may be an included file.
+	found := make(map[string]*sastToolsFound)
+	inFile := func(p string) *sastToolsFound {
+		if found[p] == nil {
+			found[p] = newSASTToolsFound(p, workflows)
+		}
+		return found[p]
+	}
+	for _, template := range pipeline.Templates {
+		if gitlabSASTTemplateRegex.MatchString(template.Value) {
+			inFile(template.Path).add(checker.GitLabSASTWorkflow, template.Line, template.Value)
+		}
+	}
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for _, image := range job.Images {
+			inFile(image.Path).matchImage(image.Value, image.Line)
+		}
+		for _, command := range job.Commands {
+			inFile(command.Path).matchCommand(command.Value, command.Line)
+		}
+	}
+	return true, nil
+}
+
+// isSASTConfigFile returns whether a file may configure a SAST tool. Those of
+// dependencies and test fixtures aren't the project's.
+func isSASTConfigFile(pathfn string) bool {
+	if fileparser.IsTestdataFile(pathfn) || fileIsInVendorDir(pathfn) ||
+		slices.Contains(strings.Split(path.Dir(pathfn), "/"), "node_modules") {
+		return false
+	}
+	base := strings.ToLower(path.Base(pathfn))
+	switch base {
+	case ".semgrep.yml", ".semgrep.yaml", ".bandit", "bandit.yml", "bandit.yaml", "pyproject.toml",
+		".golangci.yml", ".golangci.yaml", "pom.xml", "build.gradle", "build.gradle.kts", "package.json",
+		".pre-commit-config.yaml", ".pre-commit-config.yml":
+		return true
+	case "brakeman.yml", "brakeman.ignore":
+		return path.Base(path.Dir(pathfn)) == "config"
+	}
+	if strings.HasPrefix(base, ".eslintrc") || strings.HasPrefix(base, "eslint.config.") {
+		return true
+	}
+	ext := path.Ext(base)
+	return (strings.HasPrefix(pathfn, ".semgrep/") || strings.Contains(pathfn, "/.semgrep/")) &&
+		(ext == ".yml" || ext == ".yaml")
+}
+
+// getSASTConfigFiles finds the configuration files of SAST tools, including pre-commit hooks.
+func getSASTConfigFiles(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	files, err := c.RepoClient.ListFiles(func(f string) (bool, error) {
+		return isSASTConfigFile(f), nil
+	})
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("RepoClient.ListFiles: %v", err))
+	}
+	var sastWorkflows []checker.SASTWorkflow
+	for _, f := range files {
+		if !isSASTConfigFile(f) {
+			continue
+		}
+		content, err := readSASTConfigFile(c.RepoClient, f)
+		if err != nil {
+			return nil, err
+		}
+		if err := searchSASTConfig(f, content, newSASTToolsFound(f, &sastWorkflows)); err != nil {
+			return nil, err
+		}
+	}
+	return sastWorkflows, nil
+}
+
+func readSASTConfigFile(client clients.RepoClient, f string) ([]byte, error) {
+	reader, err := client.GetFileReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading from file: %w", err)
+	}
+	return content, nil
+}
+
+func searchSASTConfig(pathfn string, content []byte, found *sastToolsFound) error {
+	// contains adds tool if content contains data, at the line of data.
+	contains := func(tool checker.SASTWorkflowType, data string) error {
+		if !strings.Contains(string(content), data) {
+			return nil
+		}
+		line, err := findLine(content, []byte(data))
+		if err != nil {
+			return err
+		}
+		found.add(tool, line, data)
+		return nil
+	}
+
+	base := strings.ToLower(path.Base(pathfn))
+	switch {
+	case base == "pyproject.toml":
+		return contains(checker.BanditWorkflow, "[tool.bandit]")
+	case base == ".bandit" || base == "bandit.yml" || base == "bandit.yaml":
+		found.add(checker.BanditWorkflow, checker.OffsetDefault, "")
+	case base == "brakeman.yml" || base == "brakeman.ignore":
+		found.add(checker.BrakemanWorkflow, checker.OffsetDefault, "")
+	case base == "pom.xml":
+		if err := contains(checker.SpotBugsWorkflow, "spotbugs-maven-plugin"); err != nil {
+			return err
+		}
+		return contains(checker.SpotBugsWorkflow, "findsecbugs-plugin")
+	case base == "build.gradle" || base == "build.gradle.kts":
+		return contains(checker.SpotBugsWorkflow, "com.github.spotbugs")
+	case base == ".golangci.yml" || base == ".golangci.yaml":
+		if line, ok := golangciEnablesGosec(content); ok {
+			found.add(checker.GosecWorkflow, line, "gosec")
+		}
+	case base == ".pre-commit-config.yaml" || base == ".pre-commit-config.yml":
+		searchPreCommitHooks(content, found)
+	case base == "package.json" || strings.HasPrefix(base, ".eslintrc") || strings.HasPrefix(base, "eslint.config."):
+		if m := eslintSecurityRegex.Find(content); m != nil {
+			return contains(checker.ESLintSecurityWorkflow, string(m))
+		}
+	default:
+		// Semgrep rules.
+		found.add(checker.SemgrepWorkflow, checker.OffsetDefault, "")
+	}
+	return nil
+}
+
+// golangciEnablesGosec returns whether a golangci-lint configuration enables gosec, and the
+// line enabling it.
+func golangciEnablesGosec(content []byte) (uint, bool) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return 0, false
+	}
+	linters := yamlLookup(doc.Content[0], "linters")
+	for _, n := range yamlItems(yamlLookup(linters, "disable")) {
+		if yamlScalar(n) == "gosec" {
+			return 0, false
+		}
+	}
+	for _, n := range yamlItems(yamlLookup(linters, "enable")) {
+		if yamlScalar(n) == "gosec" {
+			return uint(n.Line), true
+		}
+	}
+	// golangci-lint v1 uses enable-all, and v2 default: all.
+	if n := yamlLookup(linters, "enable-all"); yamlScalar(n) == "true" {
+		return uint(n.Line), true
+	}
+	if n := yamlLookup(linters, "default"); yamlScalar(n) == "all" {
+		return uint(n.Line), true
+	}
+	return 0, false
+}
+
+// searchPreCommitHooks adds the SAST tools run by pre-commit hooks.
+func searchPreCommitHooks(content []byte, found *sastToolsFound) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return
+	}
+	for _, repo := range yamlItems(yamlLookup(doc.Content[0], "repos")) {
+		for _, hook := range yamlItems(yamlLookup(repo, "hooks")) {
+			idNode := yamlLookup(hook, "id")
+			id := yamlScalar(idNode)
+			if id == "" {
+				continue
+			}
+			line := uint(idNode.Line)
+			switch {
+			case strings.HasPrefix(id, "semgrep"):
+				found.add(checker.SemgrepWorkflow, line, id)
+			case id == "bandit":
+				found.add(checker.BanditWorkflow, line, id)
+			case id == "gosec" || strings.HasPrefix(id, "go-sec"):
+				found.add(checker.GosecWorkflow, line, id)
+			case id == "brakeman":
+				found.add(checker.BrakemanWorkflow, line, id)
+			case strings.Contains(id, "eslint"):
+				for _, dep := range yamlItems(yamlLookup(hook, "additional_dependencies")) {
+					if eslintSecurityRegex.MatchString(yamlScalar(dep)) {
+						found.add(checker.ESLintSecurityWorkflow, line, id)
+					}
+				}
+			}
+		}
+	}
+}
+
+// getCodeQLDefaultSetup returns CodeQL if code scanning runs it with the default setup,
+// which doesn't need a workflow file.
+func getCodeQLDefaultSetup(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	setup, err := c.RepoClient.GetCodeScanningDefaultSetup()
+	if err != nil {
+		// ignoring check for local dir, and for tokens without access to code scanning
+		if errors.Is(err, clients.ErrUnsupportedFeature) {
+			return nil, nil
+		}
+		return nil, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("RepoClient.GetCodeScanningDefaultSetup: %v", err))
+	}
+	if !setup.Enabled {
+		return nil, nil
+	}
+	return []checker.SASTWorkflow{
+		{
+			Type: checker.CodeQLWorkflow,
+			File: checker.File{
+				Path:    fmt.Sprintf("https://%s/security/code-scanning", c.RepoClient.URI()),
+				Type:    finding.FileTypeURL,
+				Offset:  checker.OffsetDefault,
+				Snippet: "code scanning default setup",
+			},
+		},
+	}, nil
+}
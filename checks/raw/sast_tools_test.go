@@ -0,0 +1,157 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func sastSource(tool checker.SASTWorkflowType, path string, line uint, snippet string) checker.SASTWorkflow {
+	return checker.SASTWorkflow{
+		Type: tool,
+		File: checker.File{
+			Path:    path,
+			Type:    finding.FileTypeSource,
+			Offset:  line,
+			Snippet: snippet,
+		},
+	}
+}
+
+func TestGetSASTToolWorkflows(t *testing.T) {
+	t.Parallel()
+	req := &checker.CheckRequest{
+		RepoClient: mockRepoFiles(t, "testdata/sast-tools"),
+	}
+	got, err := getSASTToolWorkflows(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const workflow = ".github/workflows/sast.yml"
+	expected := []checker.SASTWorkflow{
+		sastSource(checker.GosecWorkflow, workflow, 18, "securego/gosec@v2.21.4"),
+		sastSource(checker.SpotBugsWorkflow, workflow, 39, "mvn -B verify spotbugs:check"),
+		sastSource(checker.CheckmarxWorkflow, workflow, 40, "checkmarx/ast-github-action@2.0.36"),
+		// pip install bandit doesn't run it.
+		sastSource(checker.BanditWorkflow, workflow, 26, "python -m bandit -r src"),
+		sastSource(checker.BrakemanWorkflow, workflow, 34, "bundle exec brakeman -q -w2"),
+		sastSource(checker.SemgrepWorkflow, workflow, 10, "semgrep/semgrep:1.90.0"),
+		// SAST-IaC scans infrastructure as code, not the source code.
+		sastSource(checker.GitLabSASTWorkflow, ".gitlab-ci.yml", 2, "Jobs/SAST.gitlab-ci.yml"),
+		sastSource(checker.GosecWorkflow, ".gitlab-ci.yml", 6, "securego/gosec:2.21.4"),
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSASTConfigFiles(t *testing.T) {
+	t.Parallel()
+	req := &checker.CheckRequest{
+		RepoClient: mockRepoFiles(t, "testdata/sast-tools"),
+	}
+	got, err := getSASTConfigFiles(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// build.gradle and web/package.json don't configure any SAST tool.
+	expected := []checker.SASTWorkflow{
+		sastSource(checker.GosecWorkflow, ".golangci.yml", 5, "gosec"),
+		sastSource(checker.BanditWorkflow, ".pre-commit-config.yaml", 9, "bandit"),
+		sastSource(checker.ESLintSecurityWorkflow, ".pre-commit-config.yaml", 14, "eslint"),
+		sastSource(checker.SemgrepWorkflow, ".semgrep/rules.yml", checker.OffsetDefault, ""),
+		sastSource(checker.BrakemanWorkflow, "config/brakeman.yml", checker.OffsetDefault, ""),
+		sastSource(checker.SpotBugsWorkflow, "pom.xml", 10, "spotbugs-maven-plugin"),
+		sastSource(checker.BanditWorkflow, "pyproject.toml", 5, "[tool.bandit]"),
+		sastSource(checker.ESLintSecurityWorkflow, "web/eslint.config.mjs", 2, "eslint-plugin-security"),
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetCodeQLDefaultSetup(t *testing.T) {
+	t.Parallel()
+	errAPI := errors.New("API error")
+	tests := []struct {
+		err      error
+		wantErr  error
+		name     string
+		expected []checker.SASTWorkflow
+		setup    clients.CodeScanningDefaultSetup
+	}{
+		{
+			name: "default setup enabled",
+			setup: clients.CodeScanningDefaultSetup{
+				Enabled:   true,
+				Languages: []string{"go", "python"},
+			},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.CodeQLWorkflow,
+					File: checker.File{
+						Path:    "https://github.com/ossf/scorecard/security/code-scanning",
+						Type:    finding.FileTypeURL,
+						Offset:  checker.OffsetDefault,
+						Snippet: "code scanning default setup",
+					},
+				},
+			},
+		},
+		{
+			name:  "default setup not configured",
+			setup: clients.CodeScanningDefaultSetup{},
+		},
+		{
+			name: "unsupported",
+			err:  clients.ErrUnsupportedFeature,
+		},
+		{
+			name:    "API error",
+			err:     errAPI,
+			wantErr: sce.ErrScorecardInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().GetCodeScanningDefaultSetup().Return(tt.setup, tt.err)
+			mockRepoClient.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+			req := &checker.CheckRequest{
+				RepoClient: mockRepoClient,
+			}
+			got, err := getCodeQLDefaultSetup(req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("unexpected error: %v", cmp.Diff(tt.wantErr, err, cmpopts.EquateErrors()))
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
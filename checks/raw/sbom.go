@@ -15,8 +15,12 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
@@ -30,7 +34,14 @@ var (
 	)
 )
 
-const releaseLookBack = 5
+const (
+	releaseLookBack = 5
+	// maxSBOMSize is the largest SBOM which is read.
+	maxSBOMSize = 50 << 20
+	// sbomStaleAge is how long before the latest release an SBOM can be
+	// generated without being considered stale.
+	sbomStaleAge = 7 * 24 * time.Hour
+)
 
 // SBOM retrieves the raw data for the SBOM check.
 func SBOM(c *checker.CheckRequest) (checker.SBOMData, error) {
@@ -41,7 +52,10 @@ func SBOM(c *checker.CheckRequest) (checker.SBOMData, error) {
 		return results, fmt.Errorf("RepoClient.ListReleases: %w", lerr)
 	}
 
-	results.SBOMFiles = append(results.SBOMFiles, checkSBOMReleases(releases)...)
+	repo := forgeRepoPath(c)
+	latest := latestRelease(releases)
+
+	results.SBOMFiles = append(results.SBOMFiles, checkSBOMReleases(c.RepoClient, releases, latest, repo)...)
 
 	// Look for SBOMs in source
 	repoFiles, err := c.RepoClient.ListFiles(func(file string) (bool, error) {
@@ -51,12 +65,43 @@ func SBOM(c *checker.CheckRequest) (checker.SBOMData, error) {
 		return results, fmt.Errorf("error during ListFiles: %w", err)
 	}
 
-	results.SBOMFiles = append(results.SBOMFiles, checkSBOMSource(repoFiles)...)
+	sources, err := checkSBOMSource(c.RepoClient, repoFiles, latest, repo)
+	if err != nil {
+		return results, err
+	}
+	results.SBOMFiles = append(results.SBOMFiles, sources...)
 
 	return results, nil
 }
 
-func checkSBOMReleases(releases []clients.Release) []checker.SBOM {
+// forgeRepoPath returns the host and path of the repository in lower case, empty for
+// repositories without a forge URL, e.g. local directories, which can't be matched
+// against the subject of an SBOM or the source of a provenance.
+func forgeRepoPath(c *checker.CheckRequest) string {
+	if c.Repo == nil || strings.Contains(c.Repo.URI(), "://") {
+		return ""
+	}
+	return strings.ToLower(c.Repo.URI())
+}
+
+// latestRelease returns the most recently published release, nil if none has a
+// publication date.
+func latestRelease(releases []clients.Release) *clients.Release {
+	var latest *clients.Release
+	for i := range releases {
+		if releases[i].PublishedAt.IsZero() {
+			continue
+		}
+		if latest == nil || releases[i].PublishedAt.After(latest.PublishedAt) {
+			latest = &releases[i]
+		}
+	}
+	return latest
+}
+
+func checkSBOMReleases(c clients.RepoClient, releases []clients.Release,
+	latest *clients.Release, repo string,
+) []checker.SBOM {
 	var foundSBOMs []checker.SBOM
 
 	for i := range releases {
@@ -71,14 +116,21 @@ func checkSBOMReleases(releases []clients.Release) []checker.SBOM {
 				continue
 			}
 
-			foundSBOMs = append(foundSBOMs,
-				checker.SBOM{
-					File: checker.File{
-						Path: link.URL,
-						Type: finding.FileTypeURL,
-					},
-					Name: link.Name,
-				})
+			sbom := checker.SBOM{
+				File: checker.File{
+					Path: link.URL,
+					Type: finding.FileTypeURL,
+				},
+				Name: link.Name,
+			}
+			// Assets which can't be downloaded are still reported, without their content.
+			if content, err := readReleaseSBOM(c, &link); err == nil {
+				sbom.Document = parseSBOM(link.Name, content, repo)
+				if latest != nil && v.TagName == latest.TagName {
+					setSBOMStale(sbom.Document, latest)
+				}
+			}
+			foundSBOMs = append(foundSBOMs, sbom)
 
 			// Only want one sbom from each release
 			break
@@ -87,20 +139,67 @@ func checkSBOMReleases(releases []clients.Release) []checker.SBOM {
 	return foundSBOMs
 }
 
-func checkSBOMSource(fileList []string) []checker.SBOM {
+func readReleaseSBOM(c clients.RepoClient, asset *clients.ReleaseAsset) ([]byte, error) {
+	if asset.DownloadURL == "" {
+		return nil, clients.ErrUnsupportedFeature
+	}
+	reader, err := c.GetReleaseAssetReader(asset)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.GetReleaseAssetReader: %w", err)
+	}
+	defer reader.Close()
+	return readSBOM(reader)
+}
+
+func readSBOM(reader io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(reader, maxSBOMSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+	if len(content) > maxSBOMSize {
+		return nil, errSBOMTooLarge
+	}
+	return content, nil
+}
+
+// setSBOMStale records whether an SBOM was generated well before the latest release.
+func setSBOMStale(doc *checker.SBOMDocument, latest *clients.Release) {
+	if doc == nil || doc.Created.IsZero() || latest == nil {
+		return
+	}
+	stale := doc.Created.Before(latest.PublishedAt.Add(-sbomStaleAge))
+	doc.Stale = &stale
+}
+
+func checkSBOMSource(c clients.RepoClient, fileList []string,
+	latest *clients.Release, repo string,
+) ([]checker.SBOM, error) {
 	var foundSBOMs []checker.SBOM
 
 	for _, file := range fileList {
-		// TODO: parse matching file contents to determine schema & version
-		foundSBOMs = append(foundSBOMs,
-			checker.SBOM{
-				File: checker.File{
-					Path: file,
-					Type: finding.FileTypeSource,
-				},
-				Name: file,
-			})
+		sbom := checker.SBOM{
+			File: checker.File{
+				Path: file,
+				Type: finding.FileTypeSource,
+			},
+			Name: file,
+		}
+		reader, err := c.GetFileReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		content, err := readSBOM(reader)
+		reader.Close()
+		switch {
+		case errors.Is(err, errSBOMTooLarge):
+		case err != nil:
+			return nil, err
+		default:
+			sbom.Document = parseSBOM(file, content, repo)
+			setSBOMStale(sbom.Document, latest)
+		}
+		foundSBOMs = append(foundSBOMs, sbom)
 	}
 
-	return foundSBOMs
+	return foundSBOMs, nil
 }
@@ -0,0 +1,617 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+// maxSBOMErrors is the number of validation errors reported for a document.
+const maxSBOMErrors = 10
+
+var (
+	spdxVersionRegex  = regexp.MustCompile(`^SPDX-(2\.[0-3])$`)
+	spdxIDRegex       = regexp.MustCompile(`^SPDXRef-[A-Za-z0-9.\-]+$`)
+	cdxNamespaceRegex = regexp.MustCompile(`^http://cyclonedx\.org/schema/bom/(1\.[0-6])$`)
+	cdxSerialRegex    = regexp.MustCompile(
+		`^urn:uuid:[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[1-5][0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`)
+	cdxSpecVersions   = []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"}
+	cdxComponentTypes = []string{
+		"application", "framework", "library", "container", "platform", "operating-system", "device",
+		"device-driver", "firmware", "file", "machine-learning-model", "data", "cryptographic-asset",
+	}
+)
+
+// sbomValidator collects the validation errors of a document.
+type sbomValidator struct {
+	doc *checker.SBOMDocument
+	// dropped is the number of errors beyond maxSBOMErrors.
+	dropped int
+}
+
+func (v *sbomValidator) errorf(format string, args ...any) {
+	if len(v.doc.Errors) >= maxSBOMErrors {
+		v.dropped++
+		return
+	}
+	v.doc.Errors = append(v.doc.Errors, fmt.Sprintf(format, args...))
+}
+
+func (v *sbomValidator) done() {
+	if v.dropped > 0 {
+		v.doc.Errors = append(v.doc.Errors, fmt.Sprintf("%d more errors", v.dropped))
+	}
+}
+
+// parseSBOM parses and validates an SBOM named name. repo is the repository
+// the SBOM should describe, e.g. "github.com/owner/repo". It returns nil for
+// the encodings which aren't supported, e.g. SPDX YAML or RDF.
+func parseSBOM(name string, content []byte, repo string) *checker.SBOMDocument {
+	lower := strings.ToLower(name)
+	trimmed := bytes.TrimSpace(content)
+	switch {
+	case strings.HasSuffix(lower, ".json"):
+		return parseJSONSBOM(lower, trimmed, repo)
+	case strings.HasSuffix(lower, ".cdx.xml"):
+		return parseCycloneDXXML(trimmed, repo)
+	case strings.HasSuffix(lower, ".spdx"):
+		return parseSPDXTagValue(trimmed, repo)
+	}
+	return nil
+}
+
+func parseJSONSBOM(name string, content []byte, repo string) *checker.SBOMDocument {
+	var probe struct {
+		SPDXVersion *string `json:"spdxVersion"`
+		BOMFormat   *string `json:"bomFormat"`
+	}
+	err := json.Unmarshal(content, &probe)
+	cyclonedx := probe.BOMFormat != nil || (probe.SPDXVersion == nil && strings.HasSuffix(name, ".cdx.json"))
+	if err != nil {
+		doc := &checker.SBOMDocument{Format: checker.SBOMFormatSPDX, Encoding: "json"}
+		if cyclonedx {
+			doc.Format = checker.SBOMFormatCycloneDX
+		}
+		doc.Errors = []string{fmt.Sprintf("invalid JSON: %v", err)}
+		return doc
+	}
+	if cyclonedx {
+		var bom cdxBOM
+		if err := json.Unmarshal(content, &bom); err != nil {
+			return &checker.SBOMDocument{
+				Format:   checker.SBOMFormatCycloneDX,
+				Encoding: "json",
+				Errors:   []string{fmt.Sprintf("invalid CycloneDX document: %v", err)},
+			}
+		}
+		return bom.document("json", repo)
+	}
+	var doc spdxJSONDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return &checker.SBOMDocument{
+			Format:   checker.SBOMFormatSPDX,
+			Encoding: "json",
+			Errors:   []string{fmt.Sprintf("invalid SPDX document: %v", err)},
+		}
+	}
+	return doc.spdx().document("json", repo)
+}
+
+// spdxDocument holds the fields of an SPDX 2 document, whatever its encoding.
+type spdxDocument struct {
+	version     string
+	dataLicense string
+	id          string
+	name        string
+	namespace   string
+	created     string
+	creators    []string
+	// describes holds the ids of the elements the document describes.
+	describes []string
+	packages  []spdxPackage
+	// hasCreationInfo is whether the creation information section is present.
+	hasCreationInfo bool
+}
+
+type spdxPackage struct {
+	id               string
+	name             string
+	downloadLocation string
+	purls            []string
+}
+
+func (d *spdxDocument) document(encoding, repo string) *checker.SBOMDocument {
+	doc := &checker.SBOMDocument{
+		Format:     checker.SBOMFormatSPDX,
+		Encoding:   encoding,
+		Components: len(d.packages),
+	}
+	v := sbomValidator{doc: doc}
+	if m := spdxVersionRegex.FindStringSubmatch(d.version); m != nil {
+		doc.SpecVersion = m[1]
+	} else {
+		v.errorf("unsupported spdxVersion %q", d.version)
+	}
+	if d.dataLicense != "CC0-1.0" {
+		v.errorf("dataLicense is %q, not CC0-1.0", d.dataLicense)
+	}
+	if d.id != "SPDXRef-DOCUMENT" {
+		v.errorf("SPDXID is %q, not SPDXRef-DOCUMENT", d.id)
+	}
+	if d.name == "" {
+		v.errorf("missing document name")
+	}
+	if u, err := url.Parse(d.namespace); d.namespace == "" || err != nil || !u.IsAbs() || u.Fragment != "" {
+		v.errorf("documentNamespace %q isn't an absolute URI without fragment", d.namespace)
+	}
+	if !d.hasCreationInfo {
+		v.errorf("missing creationInfo")
+	} else {
+		if created, err := time.Parse(time.RFC3339, d.created); err == nil {
+			doc.Created = created
+		} else {
+			v.errorf("creationInfo.created %q isn't a date and time", d.created)
+		}
+		if len(d.creators) == 0 {
+			v.errorf("missing creationInfo.creators")
+		}
+	}
+	for _, creator := range d.creators {
+		kind, value, _ := strings.Cut(creator, ":")
+		value = strings.TrimSpace(value)
+		switch kind {
+		case "Tool":
+			doc.Tools = append(doc.Tools, value)
+		case "Person", "Organization":
+		default:
+			v.errorf("creator %q isn't a Person, Organization or Tool", creator)
+		}
+	}
+	for i := range d.packages {
+		p := &d.packages[i]
+		if p.name == "" {
+			v.errorf("package %d has no name", i)
+		}
+		if !spdxIDRegex.MatchString(p.id) {
+			v.errorf("package %q has an invalid SPDXID %q", p.name, p.id)
+		}
+		if p.downloadLocation == "" {
+			v.errorf("package %q has no downloadLocation", p.name)
+		}
+	}
+	v.done()
+
+	var identifiers []string
+	for i := range d.packages {
+		p := &d.packages[i]
+		if !slices.Contains(d.describes, p.id) {
+			continue
+		}
+		identifiers = append(identifiers, p.purls...)
+		if p.downloadLocation != "NOASSERTION" && p.downloadLocation != "NONE" {
+			identifiers = append(identifiers, p.downloadLocation)
+		}
+	}
+	doc.DescribesRepo = describesRepo(identifiers, repo)
+	return doc
+}
+
+type spdxJSONDocument struct {
+	CreationInfo *struct {
+		Created  string   `json:"created"`
+		Creators []string `json:"creators"`
+	} `json:"creationInfo"`
+	SPDXVersion       string   `json:"spdxVersion"`
+	DataLicense       string   `json:"dataLicense"`
+	SPDXID            string   `json:"SPDXID"`
+	Name              string   `json:"name"`
+	DocumentNamespace string   `json:"documentNamespace"`
+	DocumentDescribes []string `json:"documentDescribes"`
+	Packages          []struct {
+		SPDXID           string `json:"SPDXID"`
+		Name             string `json:"name"`
+		DownloadLocation string `json:"downloadLocation"`
+		ExternalRefs     []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+	Relationships []struct {
+		SPDXElementID      string `json:"spdxElementId"`
+		RelationshipType   string `json:"relationshipType"`
+		RelatedSPDXElement string `json:"relatedSpdxElement"`
+	} `json:"relationships"`
+}
+
+func (j *spdxJSONDocument) spdx() *spdxDocument {
+	d := &spdxDocument{
+		version:     j.SPDXVersion,
+		dataLicense: j.DataLicense,
+		id:          j.SPDXID,
+		name:        j.Name,
+		namespace:   j.DocumentNamespace,
+		describes:   j.DocumentDescribes,
+	}
+	if j.CreationInfo != nil {
+		d.hasCreationInfo = true
+		d.created = j.CreationInfo.Created
+		d.creators = j.CreationInfo.Creators
+	}
+	for _, p := range j.Packages {
+		pkg := spdxPackage{id: p.SPDXID, name: p.Name, downloadLocation: p.DownloadLocation}
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				pkg.purls = append(pkg.purls, ref.ReferenceLocator)
+			}
+		}
+		d.packages = append(d.packages, pkg)
+	}
+	for _, r := range j.Relationships {
+		d.addRelationship(r.SPDXElementID, r.RelationshipType, r.RelatedSPDXElement)
+	}
+	return d
+}
+
+// addRelationship records the elements the document describes.
+func (d *spdxDocument) addRelationship(element, relationship, related string) {
+	switch {
+	case relationship == "DESCRIBES" && element == d.id:
+		d.describes = append(d.describes, related)
+	case relationship == "DESCRIBED_BY" && related == d.id:
+		d.describes = append(d.describes, element)
+	}
+}
+
+// parseSPDXTagValue parses an SPDX document in the tag-value format.
+// https://spdx.github.io/spdx-spec/v2.3/conformance/
+func parseSPDXTagValue(content []byte, repo string) *checker.SBOMDocument {
+	d := &spdxDocument{}
+	type relationship struct{ element, relationship, related string }
+	var relationships []relationship
+	var pkg *spdxPackage
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+	inText := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		// Multi-line values are enclosed in <text> tags.
+		if inText {
+			inText = !strings.Contains(line, "</text>")
+			continue
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tag, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, "<text>") && !strings.Contains(value, "</text>") {
+			inText = true
+		}
+		switch tag {
+		case "SPDXVersion":
+			d.version = value
+		case "DataLicense":
+			d.dataLicense = value
+		case "DocumentName":
+			d.name = value
+		case "DocumentNamespace":
+			d.namespace = value
+		case "Creator":
+			d.hasCreationInfo = true
+			d.creators = append(d.creators, value)
+		case "Created":
+			d.hasCreationInfo = true
+			d.created = value
+		case "SPDXID":
+			if pkg != nil {
+				pkg.id = value
+			} else if d.id == "" {
+				d.id = value
+			}
+		case "PackageName":
+			d.packages = append(d.packages, spdxPackage{name: value})
+			pkg = &d.packages[len(d.packages)-1]
+		case "PackageDownloadLocation":
+			if pkg != nil {
+				pkg.downloadLocation = value
+			}
+		case "ExternalRef":
+			// ExternalRef: PACKAGE-MANAGER purl pkg:golang/...
+			fields := strings.Fields(value)
+			if pkg != nil && len(fields) == 3 && fields[1] == "purl" {
+				pkg.purls = append(pkg.purls, fields[2])
+			}
+		case "Relationship":
+			if fields := strings.Fields(value); len(fields) == 3 {
+				relationships = append(relationships, relationship{fields[0], fields[1], fields[2]})
+			}
+		case "FileName", "SnippetSPDXID", "LicenseID":
+			// Files, snippets and licenses follow the packages they belong to.
+			pkg = nil
+		}
+	}
+	// The document SPDXID comes first, so relationships are resolved once it's known.
+	for _, r := range relationships {
+		d.addRelationship(r.element, r.relationship, r.related)
+	}
+	if err := scanner.Err(); err != nil {
+		return &checker.SBOMDocument{
+			Format:   checker.SBOMFormatSPDX,
+			Encoding: "tag-value",
+			Errors:   []string{fmt.Sprintf("reading SPDX document: %v", err)},
+		}
+	}
+	return d.document("tag-value", repo)
+}
+
+// cdxBOM is a CycloneDX document, with the fields of the JSON and XML encodings.
+type cdxBOM struct {
+	XMLName  xml.Name `json:"-"`
+	Metadata *struct {
+		// Tools is an array of tools before CycloneDX 1.5, and an object with
+		// components and services since.
+		Tools     json.RawMessage `json:"tools" xml:"-"`
+		XMLTools  *cdxXMLTools    `json:"-" xml:"tools"`
+		Component *cdxComponent   `json:"component" xml:"component"`
+		Timestamp string          `json:"timestamp" xml:"timestamp"`
+	} `json:"metadata" xml:"metadata"`
+	Version      *json.Number   `json:"version" xml:"version,attr"`
+	BOMFormat    string         `json:"bomFormat" xml:"-"`
+	SpecVersion  string         `json:"specVersion" xml:"-"`
+	SerialNumber string         `json:"serialNumber" xml:"serialNumber,attr"`
+	Components   []cdxComponent `json:"components" xml:"components>component"`
+}
+
+type cdxXMLTools struct {
+	Tools      []cdxTool      `xml:"tool"`
+	Components []cdxComponent `xml:"components>component"`
+	Services   []cdxTool      `xml:"services>service"`
+}
+
+type cdxTool struct {
+	Name    string `json:"name" xml:"name"`
+	Version string `json:"version" xml:"version"`
+}
+
+type cdxComponent struct {
+	Type               string `json:"type" xml:"type,attr"`
+	Name               string `json:"name" xml:"name"`
+	Version            string `json:"version" xml:"version"`
+	Purl               string `json:"purl" xml:"purl"`
+	ExternalReferences []struct {
+		Type string `json:"type" xml:"type,attr"`
+		URL  string `json:"url" xml:"url"`
+	} `json:"externalReferences" xml:"externalReferences>reference"`
+	Components []cdxComponent `json:"components" xml:"components>component"`
+}
+
+// parseCycloneDXXML parses a CycloneDX document in the XML format.
+func parseCycloneDXXML(content []byte, repo string) *checker.SBOMDocument {
+	var bom cdxBOM
+	if err := xml.Unmarshal(content, &bom); err != nil {
+		return &checker.SBOMDocument{
+			Format:   checker.SBOMFormatCycloneDX,
+			Encoding: "xml",
+			Errors:   []string{fmt.Sprintf("invalid XML: %v", err)},
+		}
+	}
+	// XML documents name their format and version with the namespace of the bom element.
+	if bom.XMLName.Local == "bom" {
+		bom.BOMFormat = "CycloneDX"
+	}
+	if m := cdxNamespaceRegex.FindStringSubmatch(bom.XMLName.Space); m != nil {
+		bom.SpecVersion = m[1]
+	}
+	return bom.document("xml", repo)
+}
+
+func (b *cdxBOM) document(encoding, repo string) *checker.SBOMDocument {
+	doc := &checker.SBOMDocument{
+		Format:   checker.SBOMFormatCycloneDX,
+		Encoding: encoding,
+	}
+	v := sbomValidator{doc: doc}
+	if encoding == "xml" && b.XMLName.Local != "bom" {
+		v.errorf("root element is %q, not bom", b.XMLName.Local)
+	} else if b.BOMFormat != "CycloneDX" {
+		v.errorf("bomFormat is %q, not CycloneDX", b.BOMFormat)
+	}
+	if slices.Contains(cdxSpecVersions, b.SpecVersion) {
+		doc.SpecVersion = b.SpecVersion
+	} else if encoding == "xml" {
+		v.errorf("unsupported CycloneDX namespace %q", b.XMLName.Space)
+	} else {
+		v.errorf("unsupported specVersion %q", b.SpecVersion)
+	}
+	if b.SerialNumber != "" && !cdxSerialRegex.MatchString(b.SerialNumber) {
+		v.errorf("serialNumber %q isn't a UUID URN", b.SerialNumber)
+	}
+	if b.Version != nil {
+		if n, err := b.Version.Int64(); err != nil || n < 1 {
+			v.errorf("version %q isn't a positive integer", b.Version.String())
+		}
+	}
+
+	var subject *cdxComponent
+	if m := b.Metadata; m != nil {
+		if m.Timestamp != "" {
+			if created, err := time.Parse(time.RFC3339, m.Timestamp); err == nil {
+				doc.Created = created
+			} else {
+				v.errorf("metadata.timestamp %q isn't a date and time", m.Timestamp)
+			}
+		}
+		doc.Tools = b.tools(&v)
+		subject = m.Component
+		if subject != nil {
+			validateCycloneDXComponent(&v, subject)
+		}
+	}
+	var walk func(components []cdxComponent)
+	walk = func(components []cdxComponent) {
+		for i := range components {
+			doc.Components++
+			validateCycloneDXComponent(&v, &components[i])
+			walk(components[i].Components)
+		}
+	}
+	walk(b.Components)
+	v.done()
+
+	if subject != nil {
+		var identifiers []string
+		if subject.Purl != "" {
+			identifiers = append(identifiers, subject.Purl)
+		}
+		for _, ref := range subject.ExternalReferences {
+			if ref.Type == "vcs" {
+				identifiers = append(identifiers, ref.URL)
+			}
+		}
+		doc.DescribesRepo = describesRepo(identifiers, repo)
+	}
+	return doc
+}
+
+// tools returns the names and versions of the tools which generated the document.
+func (b *cdxBOM) tools(v *sbomValidator) []string {
+	var tools []cdxTool
+	switch {
+	case b.Metadata.XMLTools != nil:
+		tools = append(tools, b.Metadata.XMLTools.Tools...)
+		tools = append(tools, b.Metadata.XMLTools.Services...)
+		for _, c := range b.Metadata.XMLTools.Components {
+			tools = append(tools, cdxTool{Name: c.Name, Version: c.Version})
+		}
+	case bytes.HasPrefix(bytes.TrimSpace(b.Metadata.Tools), []byte("[")):
+		if err := json.Unmarshal(b.Metadata.Tools, &tools); err != nil {
+			v.errorf("invalid metadata.tools: %v", err)
+		}
+	case len(b.Metadata.Tools) > 0:
+		var object struct {
+			Components []cdxTool `json:"components"`
+			Services   []cdxTool `json:"services"`
+		}
+		if err := json.Unmarshal(b.Metadata.Tools, &object); err != nil {
+			v.errorf("invalid metadata.tools: %v", err)
+		}
+		tools = append(object.Components, object.Services...)
+	}
+	var names []string
+	for _, t := range tools {
+		if t.Name == "" {
+			continue
+		}
+		name := t.Name
+		if t.Version != "" {
+			name += " " + t.Version
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func validateCycloneDXComponent(v *sbomValidator, c *cdxComponent) {
+	if c.Name == "" {
+		v.errorf("component of type %q has no name", c.Type)
+	}
+	if !slices.Contains(cdxComponentTypes, c.Type) {
+		v.errorf("component %q has an invalid type %q", c.Name, c.Type)
+	}
+}
+
+// describesRepo returns whether one of the purls or VCS URLs identifying the subject of
+// an SBOM is the repository, nil if there are none or the repository has no such URL.
+func describesRepo(identifiers []string, repo string) *bool {
+	if len(identifiers) == 0 || repo == "" {
+		return nil
+	}
+	described := slices.ContainsFunc(identifiers, func(id string) bool {
+		path := identifierRepoPath(id)
+		return path == repo || strings.HasPrefix(path, repo+"/")
+	})
+	return &described
+}
+
+// purlHosts maps the purl types of forges to their hosts.
+var purlHosts = map[string]string{
+	"github":    "github.com",
+	"gitlab":    "gitlab.com",
+	"bitbucket": "bitbucket.org",
+}
+
+// identifierRepoPath returns the host and path, in lower case, of the repository
+// a purl or a VCS URL points to.
+func identifierRepoPath(id string) string {
+	id = strings.TrimSpace(id)
+	if rest, ok := strings.CutPrefix(id, "pkg:"); ok {
+		rest, qualifiers, _ := strings.Cut(rest, "?")
+		q, err := url.ParseQuery(strings.SplitN(qualifiers, "#", 2)[0])
+		if err == nil {
+			for _, key := range []string{"vcs_url", "repository_url"} {
+				if u := q.Get(key); u != "" {
+					return identifierRepoPath(u)
+				}
+			}
+		}
+		rest, _, _ = strings.Cut(rest, "#")
+		rest, _, _ = strings.Cut(rest, "@")
+		typ, path, _ := strings.Cut(rest, "/")
+		path, err = url.PathUnescape(path)
+		if err != nil {
+			return ""
+		}
+		switch typ = strings.ToLower(typ); {
+		case purlHosts[typ] != "":
+			return strings.ToLower(purlHosts[typ] + "/" + path)
+		case typ == "golang":
+			// Go module paths start with the repository, e.g. github.com/owner/repo/v2.
+			return strings.ToLower(path)
+		}
+		return ""
+	}
+
+	u := strings.TrimPrefix(id, "git+")
+	if _, rest, ok := strings.Cut(u, "://"); ok {
+		u = rest
+	} else if user, rest, ok := strings.Cut(u, "@"); ok && !strings.Contains(user, "/") {
+		// scp-like syntax, e.g. git@github.com:owner/repo.git.
+		u = strings.Replace(rest, ":", "/", 1)
+	}
+	host, path, _ := strings.Cut(u, "/")
+	if _, h, ok := strings.Cut(host, "@"); ok {
+		host = h
+	}
+	// Refs and subdirectories follow the path, e.g. repo.git@v1.0#subdir.
+	path, _, _ = strings.Cut(path, "#")
+	path, _, _ = strings.Cut(path, "?")
+	path, _, _ = strings.Cut(path, "@")
+	path = strings.TrimSuffix(strings.TrimSuffix(path, "/"), ".git")
+	return strings.ToLower(host + "/" + path)
+}
@@ -16,10 +16,16 @@ package raw
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
@@ -107,6 +113,9 @@ func TestSbom(t *testing.T) {
 			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
 				return tt.files, nil
 			}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("{}")), nil
+			}).AnyTimes()
 
 			dl := scut.TestDetailLogger{}
 			req := checker.CheckRequest{
@@ -121,9 +130,150 @@ func TestSbom(t *testing.T) {
 				}
 			}
 
-			if !cmp.Equal(res, tt.expected) {
+			if !cmp.Equal(res, tt.expected, cmpopts.IgnoreFields(checker.SBOM{}, "Document")) {
 				t.Errorf("Expected %v, got %v for %v", tt.expected, res, tt.name)
 			}
 		})
 	}
 }
+
+func TestSBOMDocuments(t *testing.T) {
+	t.Parallel()
+	asBool := func(b bool) *bool { return &b }
+	created := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	releases := []clients.Release{
+		{
+			TagName:     "v1.2.0",
+			PublishedAt: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+			Assets: []clients.ReleaseAsset{
+				{Name: "project.cdx.json", URL: "https://release/project.cdx.json", DownloadURL: "https://dl/project.cdx.json"},
+			},
+		},
+		{
+			TagName:     "v1.1.0",
+			PublishedAt: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			Assets: []clients.ReleaseAsset{
+				{Name: "invalid.cdx.json", URL: "https://release/invalid.cdx.json", DownloadURL: "https://dl/invalid.cdx.json"},
+			},
+		},
+	}
+	cdxJSON := func(stale *bool) *checker.SBOMDocument {
+		return &checker.SBOMDocument{
+			Created:       created,
+			DescribesRepo: asBool(true),
+			Stale:         stale,
+			Format:        checker.SBOMFormatCycloneDX,
+			SpecVersion:   "1.5",
+			Encoding:      "json",
+			Tools:         []string{"cyclonedx-gomod v1.6.0"},
+			Components:    2,
+		}
+	}
+	invalid := &checker.SBOMDocument{
+		Format:   checker.SBOMFormatCycloneDX,
+		Encoding: "json",
+		Errors: []string{
+			`unsupported specVersion "1.7"`,
+			`serialNumber "3e671687" isn't a UUID URN`,
+			`version "0" isn't a positive integer`,
+			`metadata.timestamp "yesterday" isn't a date and time`,
+			`component "left-pad" has an invalid type "package"`,
+			`component of type "library" has no name`,
+		},
+		Components: 2,
+	}
+	expected := map[string]*checker.SBOMDocument{
+		"https://release/project.cdx.json": cdxJSON(asBool(false)),
+		"https://release/invalid.cdx.json": invalid,
+		"invalid.cdx.json":                 invalid,
+		"project.cdx.json":                 cdxJSON(asBool(false)),
+		"project.cdx.xml": {
+			Created:       created,
+			DescribesRepo: asBool(true),
+			Stale:         asBool(false),
+			Format:        checker.SBOMFormatCycloneDX,
+			SpecVersion:   "1.4",
+			Encoding:      "xml",
+			Tools:         []string{"cyclonedx-maven-plugin 2.7.9"},
+			Components:    1,
+		},
+		"project.spdx": {
+			Created:       time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+			DescribesRepo: asBool(false),
+			Stale:         asBool(true),
+			Format:        checker.SBOMFormatSPDX,
+			SpecVersion:   "2.2",
+			Encoding:      "tag-value",
+			Tools:         []string{"spdx-sbom-generator-0.0.15"},
+			Components:    1,
+		},
+		"project.spdx.json": {
+			Created:       created,
+			DescribesRepo: asBool(true),
+			Stale:         asBool(false),
+			Format:        checker.SBOMFormatSPDX,
+			SpecVersion:   "2.3",
+			Encoding:      "json",
+			Tools:         []string{"syft-1.4.1"},
+			Components:    2,
+		},
+		"project.spdx.yaml": nil,
+	}
+
+	dir := filepath.Join("testdata", "sbom")
+	ctrl := gomock.NewController(t)
+	mockRepoClient, ok := mockRepoFiles(t, dir).(*mockrepo.MockRepoClient)
+	if !ok {
+		t.Fatal("unexpected repo client type")
+	}
+	mockRepoClient.EXPECT().ListReleases().Return(releases, nil)
+	mockRepoClient.EXPECT().GetReleaseAssetReader(gomock.Any()).DoAndReturn(
+		func(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+			return os.Open(filepath.Join(dir, asset.Name))
+		}).AnyTimes()
+	mockRepo := mockrepo.NewMockRepo(ctrl)
+	mockRepo.EXPECT().URI().Return("github.com/owner/project").AnyTimes()
+
+	req := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Repo:       mockRepo,
+		Ctx:        context.Background(),
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	res, err := SBOM(&req)
+	if err != nil {
+		t.Fatalf("SBOM: %v", err)
+	}
+	got := map[string]*checker.SBOMDocument{}
+	for _, sbom := range res.SBOMFiles {
+		got[sbom.File.Path] = sbom.Document
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIdentifierRepoPath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "pkg:github/Owner/Repo@v1.0.0", want: "github.com/owner/repo"},
+		{id: "pkg:golang/github.com/owner/repo/v2@v2.1.0#cmd", want: "github.com/owner/repo/v2"},
+		{id: "pkg:npm/%40scope/name@1.0.0?vcs_url=git%2Bhttps://github.com/owner/repo.git", want: "github.com/owner/repo"},
+		{id: "pkg:npm/left-pad@1.3.0", want: ""},
+		{id: "git+https://github.com/owner/repo.git@v1.0#subdir", want: "github.com/owner/repo"},
+		{id: "https://user@gitlab.com/group/sub/repo/", want: "gitlab.com/group/sub/repo"},
+		{id: "git@github.com:owner/repo.git", want: "github.com/owner/repo"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+			if got := identifierRepoPath(tt.id); got != tt.want {
+				t.Errorf("identifierRepoPath(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	// files larger than this, e.g. minified bundles, aren't scanned for secrets.
+	maxSecretsFileSize = 1 << 20
+	// lines longer than this are truncated in snippets.
+	maxSecretSnippetLen = 120
+	// high-entropy tokens have at least this many characters,
+	minTokenLen = 20
+	// and at least this many bits of entropy per character.
+	minTokenEntropy = 3.5
+)
+
+// secretRule detects a secret. The secret is the first subgroup of regex if any, or the
+// whole match.
+type secretRule struct {
+	regex *regexp.Regexp
+	rule  string
+	typ   checker.SecretType
+}
+
+var secretRules = []secretRule{
+	{
+		// Only a secret if key material follows, see hasKeyMaterial.
+		rule:  "private key",
+		typ:   checker.SecretTypePrivateKey,
+		regex: regexp.MustCompile(`-----BEGIN[ A-Z0-9]*PRIVATE KEY(?: BLOCK)?-----`),
+	},
+	{
+		rule:  "AWS access key",
+		typ:   checker.SecretTypeCloudAccessKey,
+		regex: regexp.MustCompile(`\b((?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16})\b`),
+	},
+	{
+		rule:  "Google Cloud API key",
+		typ:   checker.SecretTypeCloudAccessKey,
+		regex: regexp.MustCompile(`(?:^|[^0-9A-Za-z_-])(AIza[0-9A-Za-z_-]{35})(?:[^0-9A-Za-z_-]|$)`),
+	},
+	{
+		rule:  "Azure storage account key",
+		typ:   checker.SecretTypeCloudAccessKey,
+		regex: regexp.MustCompile(`AccountKey=([A-Za-z0-9+/]{86}==)`),
+	},
+	{
+		rule: "high-entropy token",
+		typ:  checker.SecretTypeHighEntropyToken,
+		regex: regexp.MustCompile(`(?i)[\w.-]*(?:secret|token|passw(?:or)?d|api[_-]?key|access[_-]?key|auth[_-]?key)` +
+			`[\w.-]*["']?\s*(?::|=|:=|=>)\s*["']?([A-Za-z0-9+/_.~=-]{` + fmt.Sprint(minTokenLen) + `,})`),
+	},
+}
+
+var (
+	// keyMaterial matches base64 key material, after the escaped newlines of a key in
+	// a string, e.g. in the JSON key of a Google Cloud service account.
+	keyMaterial = regexp.MustCompile(`^(?:\\[nr]|\s)*[A-Za-z0-9+/]{40,}`)
+	// pemHeader matches the headers before the key material of encrypted PEM keys and
+	// PGP keys, e.g. "Proc-Type: 4,ENCRYPTED".
+	pemHeader = regexp.MustCompile(`^[A-Za-z-]+:`)
+)
+
+// Secrets retrieves the raw data for the Secrets check.
+func Secrets(c *checker.CheckRequest) (checker.SecretsData, error) {
+	var data checker.SecretsData
+	err := fileparser.OnAllFilesDo(c.RepoClient, scanFileForSecrets, c.RepoClient, &data)
+	if err != nil {
+		return data, err
+	}
+
+	settings, err := c.RepoClient.GetSecretScanningSettings()
+	switch {
+	case err == nil:
+		data.SecretScanning = &settings
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	default:
+		return data, fmt.Errorf("RepoClient.GetSecretScanningSettings: %w", err)
+	}
+	return data, nil
+}
+
+var scanFileForSecrets fileparser.DoWhileTrueOnFilename = func(path string, args ...interface{}) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf("scanFileForSecrets requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	client, ok := args[0].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf("scanFileForSecrets requires arg[0] of type clients.RepoClient: %w", errInvalidArgType)
+	}
+	data, ok := args[1].(*checker.SecretsData)
+	if !ok {
+		return false, fmt.Errorf("scanFileForSecrets requires arg[1] of type *checker.SecretsData: %w", errInvalidArgType)
+	}
+	if fileparser.IsTestdataFile(path) || isSecretsExampleFile(path) {
+		return true, nil
+	}
+
+	reader, err := client.GetFileReader(path)
+	if err != nil {
+		return false, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(io.LimitReader(reader, maxSecretsFileSize+1))
+	if err != nil {
+		return false, fmt.Errorf("reading from file: %w", err)
+	}
+	if len(content) > maxSecretsFileSize || !isText(content[:min(len(content), binaryTestLen)]) {
+		return true, nil
+	}
+
+	data.Secrets = append(data.Secrets, findSecrets(path, content)...)
+	return true, nil
+}
+
+// isSecretsExampleFile returns whether a file is a test source or documentation,
+// whose secrets are examples.
+func isSecretsExampleFile(pathfn string) bool {
+	base := strings.ToLower(path.Base(pathfn))
+	switch path.Ext(base) {
+	case ".md", ".markdown", ".rst", ".adoc":
+		return true
+	}
+	for _, suffix := range []string{
+		"_test.go", "_test.py", "_test.rb", "_spec.rb", "test.java", "test.kt",
+		".test.js", ".test.ts", ".spec.js", ".spec.ts",
+	} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	if strings.HasPrefix(base, "test_") && path.Ext(base) == ".py" {
+		return true
+	}
+	for _, dir := range strings.Split(path.Dir(pathfn), "/") {
+		switch dir {
+		case "doc", "docs", "test", "tests", "__tests__", "spec":
+			return true
+		}
+	}
+	return false
+}
+
+// secretMatch is a secret found on a line.
+type secretMatch struct {
+	rule       *secretRule
+	start, end int
+}
+
+// findSecrets returns the secrets in content, at most one per rule and line.
+func findSecrets(path string, content []byte) []checker.Secret {
+	var secrets []checker.Secret
+	lines := bytes.Split(content, []byte("\n"))
+	for i, line := range lines {
+		matches := slices.DeleteFunc(findLineSecrets(line), func(m secretMatch) bool {
+			return m.rule.typ == checker.SecretTypePrivateKey && !hasKeyMaterial(line[m.end:], lines[i+1:])
+		})
+		if len(matches) == 0 {
+			continue
+		}
+		snippet := truncateSnippet(strings.TrimSpace(redactLine(line, matches)))
+		reported := make(map[*secretRule]bool)
+		for _, m := range matches {
+			if reported[m.rule] {
+				continue
+			}
+			reported[m.rule] = true
+			secrets = append(secrets, checker.Secret{
+				Rule: m.rule.rule,
+				Type: m.rule.typ,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  uint(i + 1),
+					Snippet: snippet,
+				},
+			})
+		}
+	}
+	return secrets
+}
+
+// findLineSecrets returns the secrets on a line. Rules are ordered from the most specific,
+// and a secret matched by a rule isn't matched again by the following ones.
+func findLineSecrets(line []byte) []secretMatch {
+	var matches []secretMatch
+	for i := range secretRules {
+		r := &secretRules[i]
+		for _, m := range r.regex.FindAllSubmatchIndex(line, -1) {
+			start, end := m[0], m[1]
+			if len(m) > 2 && m[2] >= 0 {
+				start, end = m[2], m[3]
+			}
+			if r.typ == checker.SecretTypeHighEntropyToken && !isHighEntropyToken(string(line[start:end])) {
+				continue
+			}
+			if !overlapsSecret(matches, start, end) {
+				matches = append(matches, secretMatch{rule: r, start: start, end: end})
+			}
+		}
+	}
+	return matches
+}
+
+func overlapsSecret(matches []secretMatch, start, end int) bool {
+	for _, m := range matches {
+		if start < m.end && m.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// hasKeyMaterial returns whether the key material of a private key follows its header,
+// on the rest of its line, or on the next lines, after optional headers.
+func hasKeyMaterial(rest []byte, next [][]byte) bool {
+	if keyMaterial.Match(rest) {
+		return true
+	}
+	const maxHeaderLines = 4
+	for _, line := range next[:min(len(next), maxHeaderLines)] {
+		// Keys may be indented, e.g. in YAML, or quoted, e.g. in source code.
+		line = bytes.TrimLeft(line, " \t\"'")
+		if keyMaterial.Match(line) {
+			return true
+		}
+		if len(bytes.TrimSpace(line)) > 0 && !pemHeader.Match(line) {
+			return false
+		}
+	}
+	return false
+}
+
+// redactLine returns line with its secrets redacted. The header of a private key isn't
+// secret, and is kept, but everything after it is redacted.
+func redactLine(line []byte, matches []secretMatch) string {
+	slices.SortFunc(matches, func(a, b secretMatch) int { return a.start - b.start })
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m.rule.typ == checker.SecretTypePrivateKey {
+			b.Write(line[last:m.end])
+			if m.end < len(line) {
+				b.WriteString("****")
+			}
+			return b.String()
+		}
+		b.Write(line[last:m.start])
+		b.WriteString(redactSecret(string(line[m.start:m.end])))
+		last = m.end
+	}
+	b.Write(line[last:])
+	return b.String()
+}
+
+// isHighEntropyToken returns whether a value looks like a random token rather than
+// a placeholder, an identifier or a reference to a variable.
+func isHighEntropyToken(value string) bool {
+	if !strings.ContainsAny(value, "0123456789") ||
+		strings.IndexFunc(value, func(r rune) bool { return r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' }) < 0 {
+		return false
+	}
+	return shannonEntropy(value) >= minTokenEntropy
+}
+
+// shannonEntropy returns the Shannon entropy of s, in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// redactSecret keeps the first characters of a secret, which identify its kind,
+// e.g. AKIA for AWS access keys, and hides the rest.
+func redactSecret(secret string) string {
+	const shown = 4
+	if len(secret) <= shown {
+		return "****"
+	}
+	return secret[:shown] + "****"
+}
+
+func truncateSnippet(snippet string) string {
+	if len(snippet) <= maxSecretSnippetLen {
+		return snippet
+	}
+	return snippet[:maxSecretSnippetLen] + "..."
+}
@@ -27,13 +27,24 @@ func SignedReleases(c *checker.CheckRequest) (checker.SignedReleasesData, error)
 		return checker.SignedReleasesData{}, fmt.Errorf("%w", err)
 	}
 
+	signatures, err := verifyReleaseSignatures(c, releases)
+	if err != nil {
+		return checker.SignedReleasesData{}, err
+	}
+	provenance, err := verifyReleaseProvenance(c, releases)
+	if err != nil {
+		return checker.SignedReleasesData{}, err
+	}
+
 	pkgs := []checker.ProjectPackage{}
 	versions, err := c.ProjectClient.GetProjectPackageVersions(c.Ctx, c.Repo.Host(), c.Repo.Path())
 	if err != nil {
 		c.Dlogger.Debug(&checker.LogMessage{Text: fmt.Sprintf("GetProjectPackageVersions: %v", err)})
 		return checker.SignedReleasesData{
-			Releases: releases,
-			Packages: pkgs,
+			Releases:   releases,
+			Packages:   pkgs,
+			Signatures: signatures,
+			Provenance: provenance,
 		}, nil
 	}
 
@@ -56,7 +67,9 @@ func SignedReleases(c *checker.CheckRequest) (checker.SignedReleasesData, error)
 	}
 
 	return checker.SignedReleasesData{
-		Releases: releases,
-		Packages: pkgs,
+		Releases:   releases,
+		Packages:   pkgs,
+		Signatures: signatures,
+		Provenance: provenance,
 	}, nil
 }
@@ -0,0 +1,3 @@
+module example.com/lockfiles/api
+
+go 1.22
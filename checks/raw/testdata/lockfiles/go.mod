@@ -0,0 +1,5 @@
+module example.com/lockfiles
+
+go 1.22
+
+require golang.org/x/mod v0.17.0
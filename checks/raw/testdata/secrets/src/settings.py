@@ -0,0 +1,7 @@
+import os
+
+# Secrets are read from the environment.
+API_TOKEN = os.environ["API_TOKEN"]
+PASSWORD_MIN_LENGTH = 12
+session_token_header = "X-Session-Token-Authorization"
+stripe_api_key = "sk_test_4eC39HqLyjWDarjtT1zdp7dc"
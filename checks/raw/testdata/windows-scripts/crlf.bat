@@ -0,0 +1,2 @@
+curl -o tool.exe https://example.com/tool.exe
+tool.exe --version
@@ -0,0 +1,11 @@
+@echo off
+rem curl -o x.exe https://example.com/x.exe && x.exe
+:: Download and run the installer.
+curl -L -o %TEMP%\agent.exe https://example.com/agent.exe && %TEMP%\agent.exe /quiet
+bitsadmin /transfer job /download /priority normal ^
+  https://example.com/tool.msi %TEMP%\tool.msi
+start "" /wait msiexec /i %TEMP%\tool.msi
+powershell -NoProfile -Command "iwr https://example.com/bootstrap.ps1 | iex"
+powershell -NoProfile -Command "Invoke-WebRequest https://example.com/x.zip -OutFile x.zip"
+curl -s https://example.com/script.cmd | cmd
+echo done & exit /b 0
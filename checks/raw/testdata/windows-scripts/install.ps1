@@ -0,0 +1,21 @@
+# Installs the build dependencies.
+<#
+  iwr https://example.com/commented.ps1 | iex
+#>
+$ErrorActionPreference = "Stop"
+
+iwr https://example.com/install.ps1 -UseBasicParsing | iex
+Invoke-Expression ((New-Object System.Net.WebClient).DownloadString('https://chocolatey.org/install.ps1'))
+irm https://raw.githubusercontent.com/owner/repo/2b7d2bb17d0b2c4e1e6b8d6f7a3a3c0ef7a41b1e/install.ps1 | iex
+
+$installer = "$env:TEMP\tool-setup.exe"
+Invoke-WebRequest -Uri https://example.com/tool-setup.exe `
+  -OutFile $installer
+Start-Process $installer -ArgumentList '/S' -Wait
+
+(New-Object Net.WebClient).DownloadFile('https://example.com/helper.msi', "$env:TEMP\helper.msi")
+msiexec /i "$env:TEMP\helper.msi" /qn
+
+Invoke-WebRequest https://example.com/readme.txt -OutFile readme.txt; Get-Content readme.txt
+curl.exe -sSLo setup.ps1 https://example.com/setup.ps1; & .\setup.ps1
+Write-Host "iex is not run here"
@@ -37,8 +37,13 @@ func Vulnerabilities(c *checker.CheckRequest) (checker.VulnerabilitiesData, erro
 	if err != nil {
 		return checker.VulnerabilitiesData{}, fmt.Errorf("vulnerabilitiesClient.ListUnfixedVulnerabilities: %w", err)
 	}
+	gates, err := getVulnerabilityGates(c.RepoClient, c.Dlogger)
+	if err != nil {
+		return checker.VulnerabilitiesData{}, err
+	}
 	return checker.VulnerabilitiesData{
 		Vulnerabilities: resp.Vulnerabilities,
+		Gates:           gates,
 	}, nil
 }
 
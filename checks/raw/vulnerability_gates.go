@@ -0,0 +1,403 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// vulnerabilityGateTool describes how to detect a CI job checking dependencies for vulnerabilities.
+type vulnerabilityGateTool struct {
+	// uses matches the actions or reusable workflows running the tool, without their version.
+	uses *regexp.Regexp
+	// command matches the commands running the tool.
+	command *regexp.Regexp
+	gate    checker.VulnerabilityGateType
+}
+
+var vulnerabilityGateTools = []vulnerabilityGateTool{
+	{
+		gate: checker.VulnerabilityGateDependencyReview,
+		uses: regexp.MustCompile(`^actions/dependency-review-action$`),
+	},
+	{
+		gate:    checker.VulnerabilityGateOSVScanner,
+		uses:    regexp.MustCompile(`^google/osv-scanner-action/`),
+		command: toolCommand("osv-scanner"),
+	},
+	{
+		gate: checker.VulnerabilityGateGovulncheck,
+		uses: regexp.MustCompile(`^golang/govulncheck-action$`),
+		// govulncheck is often run with go run golang.org/x/vuln/cmd/govulncheck@latest.
+		command: regexp.MustCompile(`(?m)(^\s*|[;&|(]\s*|(\bexec|\brun)\s+|/bin/|/cmd/)govulncheck(@\S*)?(\s|$)`),
+	},
+	{
+		gate:    checker.VulnerabilityGateNpmAudit,
+		command: regexp.MustCompile(`\b(npm|pnpm|yarn( npm)?) audit\b`),
+	},
+	{
+		gate:    checker.VulnerabilityGateCargoAudit,
+		uses:    regexp.MustCompile(`^(rustsec|actions-rs)/audit-check$`),
+		command: regexp.MustCompile(`\bcargo(-audit)? audit\b`),
+	},
+}
+
+var (
+	// gitlabDependencyScanningRegex matches the GitLab templates running the GitLab Dependency Scanning analyzers.
+	gitlabDependencyScanningRegex = regexp.MustCompile(`(^|/)Dependency-Scanning(\.latest)?\.gitlab-ci\.yml$`)
+	// https://docs.renovatebot.com/configuration-options/#osvvulnerabilityalerts
+	renovateOSVAlertsRegex = regexp.MustCompile(`["']?osvVulnerabilityAlerts["']?\s*:\s*true`)
+	// https://docs.renovatebot.com/configuration-options/#vulnerabilityalerts
+	renovateAlertsRegex         = regexp.MustCompile(`["']?vulnerabilityAlerts["']?\s*:\s*\{`)
+	renovateAlertsDisabledRegex = regexp.MustCompile(`["']?vulnerabilityAlerts["']?\s*:\s*\{[^}]*["']?enabled["']?\s*:\s*false`)
+)
+
+// getVulnerabilityGates returns the CI jobs and bots checking changes for vulnerable
+// dependencies, and whether the jobs are required status checks.
+func getVulnerabilityGates(c clients.RepoClient, dl checker.DetailLogger) ([]checker.VulnerabilityGate, error) {
+	var gates []checker.VulnerabilityGate
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       fileparser.WorkflowFilesPattern,
+		CaseSensitive: false,
+	}, searchGitHubWorkflowVulnerabilityGates, &gates, dl)
+	if err != nil {
+		return nil, err
+	}
+	err = fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       fileparser.GitLabCIFile,
+		CaseSensitive: true,
+	}, searchGitLabCIVulnerabilityGates, &gates, c)
+	if err != nil {
+		return nil, err
+	}
+	renovate, err := getRenovateVulnerabilityAlerts(c)
+	if err != nil {
+		return nil, err
+	}
+	gates = append(gates, renovate...)
+
+	if err := setRequiredVulnerabilityGates(c, gates); err != nil {
+		return nil, err
+	}
+	return gates, nil
+}
+
+// searchGitHubWorkflowVulnerabilityGates finds the jobs of GitHub workflows checking the
+// dependencies of pull requests for vulnerabilities.
+var searchGitHubWorkflowVulnerabilityGates fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowVulnerabilityGates requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	gates, ok := args[0].(*[]checker.VulnerabilityGate)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowVulnerabilityGates expects arg[0] of type *[]checker.VulnerabilityGate: %w",
+			errInvalidArgType)
+	}
+	dl, ok := args[1].(checker.DetailLogger)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubWorkflowVulnerabilityGates expects arg[1] of type checker.DetailLogger: %w", errInvalidArgType)
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		// A workflow GitHub can't run doesn't gate changes, and shouldn't fail the check.
+		dl.Debug(&checker.LogMessage{
+			Path: path,
+			Type: finding.FileTypeSource,
+			Text: fmt.Sprintf("skipping workflow: %v", fileparser.FormatActionlintError(errs)),
+		})
+		return true, nil
+	}
+	// Only workflows running before changes are merged gate them.
+	if !usesEventTrigger(workflow, triggerPullRequest) &&
+		!usesEventTrigger(workflow, triggerPullRequestTarget) &&
+		!usesEventTrigger(workflow, "merge_group") {
+		return true, nil
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		found := make(map[checker.VulnerabilityGateType]bool)
+		add := func(gate checker.VulnerabilityGateType, pos *actionlint.Pos, snippet string) {
+			if found[gate] {
+				return
+			}
+			found[gate] = true
+			*gates = append(*gates, checker.VulnerabilityGate{
+				Type: gate,
+				Job:  jobStatusCheckName(id, job),
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(pos),
+					Snippet: snippet,
+				},
+			})
+		}
+		matchUses := func(uses *actionlint.String) {
+			if uses == nil {
+				return
+			}
+			action, _, _ := strings.Cut(uses.Value, "@")
+			for i := range vulnerabilityGateTools {
+				t := &vulnerabilityGateTools[i]
+				if t.uses != nil && t.uses.MatchString(action) {
+					add(t.gate, uses.Pos, uses.Value)
+				}
+			}
+		}
+
+		if job.WorkflowCall != nil {
+			matchUses(job.WorkflowCall.Uses)
+		}
+		for _, step := range job.Steps {
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				matchUses(e.Uses)
+			case *actionlint.ExecRun:
+				if e.Run == nil {
+					continue
+				}
+				for i := range vulnerabilityGateTools {
+					t := &vulnerabilityGateTools[i]
+					if t.command == nil {
+						continue
+					}
+					if loc := t.command.FindStringIndex(e.Run.Value); loc != nil {
+						add(t.gate, e.Run.Pos, commandLine(e.Run.Value, loc[0]))
+					}
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// jobStatusCheckName returns the name of the status check of a job, which is its name
+// if it has one, or its id. Names with expressions are cut before them.
+func jobStatusCheckName(id string, job *actionlint.Job) string {
+	if job.Name == nil {
+		return id
+	}
+	name, _, _ := strings.Cut(job.Name.Value, "${{")
+	if name = strings.TrimSpace(name); name != "" {
+		return name
+	}
+	return id
+}
+
+// searchGitLabCIVulnerabilityGates finds the jobs of the GitLab CI configuration checking
+// dependencies for vulnerabilities.
+var searchGitLabCIVulnerabilityGates fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, _ := fileparser.IsGitLabCIFile(path); !ok {
+		return true, nil
+	}
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"searchGitLabCIVulnerabilityGates requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	gates, ok := args[0].(*[]checker.VulnerabilityGate)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCIVulnerabilityGates expects arg[0] of type *[]checker.VulnerabilityGate: %w",
+			errInvalidArgType)
+	}
+	client, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitLabCIVulnerabilityGates expects arg[1] of type clients.RepoClient: %w", errInvalidArgType)
+	}
+
+	pipeline, err := fileparser.ParseGitLabCI(client, path, content)
+	if err != nil {
+		// Invalid configurations are reported by Pinned-Dependencies, and don't run any job.
+		return true, nil
+	}
+
+	found := make(map[checker.VulnerabilityGateType]bool)
+	add := func(gate checker.VulnerabilityGateType, v *fileparser.GitLabCIValue) {
+		if found[gate] {
+			return
+		}
+		found[gate] = true
+		*gates = append(*gates, checker.VulnerabilityGate{
+			Type: gate,
+			File: checker.File{
+				Path:    v.Path,
+				Type:    finding.FileTypeSource,
+				Offset:  v.Line,
+				Snippet: v.Value,
+			},
+		})
+	}
+	for i := range pipeline.Templates {
+		if gitlabDependencyScanningRegex.MatchString(pipeline.Templates[i].Value) {
+			add(checker.VulnerabilityGateGitLabDependencyScanning, &pipeline.Templates[i])
+		}
+	}
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for j := range job.Commands {
+			for k := range vulnerabilityGateTools {
+				t := &vulnerabilityGateTools[k]
+				if t.command != nil && t.command.MatchString(job.Commands[j].Value) {
+					add(t.gate, &job.Commands[j])
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// isRenovateConfig returns whether a file is a Renovate configuration file.
+// https://docs.renovatebot.com/configuration-options/
+func isRenovateConfig(pathfn string) bool {
+	switch pathfn {
+	case "renovate.json",
+		"renovate.json5",
+		".github/renovate.json",
+		".github/renovate.json5",
+		".gitlab/renovate.json",
+		".gitlab/renovate.json5",
+		".renovaterc",
+		".renovaterc.json",
+		".renovaterc.json5":
+		return true
+	}
+	return false
+}
+
+// getRenovateVulnerabilityAlerts returns Renovate if its configuration opens pull
+// requests for vulnerability alerts.
+func getRenovateVulnerabilityAlerts(c clients.RepoClient) ([]checker.VulnerabilityGate, error) {
+	files, err := c.ListFiles(func(f string) (bool, error) {
+		return isRenovateConfig(f), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error during ListFiles: %w", err)
+	}
+	var gates []checker.VulnerabilityGate
+	for _, f := range files {
+		if !isRenovateConfig(f) {
+			continue
+		}
+		reader, err := c.GetFileReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("error during GetFileReader: %w", err)
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading from file: %w", err)
+		}
+
+		m := renovateOSVAlertsRegex.Find(content)
+		if m == nil && !renovateAlertsDisabledRegex.Match(content) {
+			m = renovateAlertsRegex.Find(content)
+		}
+		if m == nil {
+			continue
+		}
+		line, err := findLine(content, m)
+		if err != nil {
+			return nil, err
+		}
+		gates = append(gates, checker.VulnerabilityGate{
+			Type: checker.VulnerabilityGateRenovate,
+			File: checker.File{
+				Path:    f,
+				Type:    finding.FileTypeSource,
+				Offset:  line,
+				Snippet: string(m),
+			},
+		})
+	}
+	return gates, nil
+}
+
+// setRequiredVulnerabilityGates sets whether the jobs of gates are required status checks
+// of the default branch.
+func setRequiredVulnerabilityGates(c clients.RepoClient, gates []checker.VulnerabilityGate) error {
+	if !slices.ContainsFunc(gates, func(g checker.VulnerabilityGate) bool { return g.Job != "" }) {
+		return nil
+	}
+	branch, err := c.GetDefaultBranch()
+	if err != nil {
+		if errors.Is(err, clients.ErrUnsupportedFeature) {
+			return nil
+		}
+		return fmt.Errorf("RepoClient.GetDefaultBranch: %w", err)
+	}
+	contexts, ok := requiredStatusChecks(branch)
+	if !ok {
+		return nil
+	}
+	for i := range gates {
+		job := gates[i].Job
+		if job == "" {
+			continue
+		}
+		// Status checks of matrix jobs and reusable workflows are named "job (...)" and "job / ...".
+		required := slices.ContainsFunc(contexts, func(context string) bool {
+			return context == job || strings.HasPrefix(context, job+" ")
+		})
+		gates[i].Required = &required
+	}
+	return nil
+}
+
+// requiredStatusChecks returns the status checks required to merge into a branch, and
+// whether they are known.
+func requiredStatusChecks(branch *clients.BranchRef) ([]string, bool) {
+	if branch == nil || branch.Protected == nil {
+		return nil, false
+	}
+	if !*branch.Protected {
+		return nil, true
+	}
+	rule := &branch.BranchProtectionRule.CheckRules
+	if len(rule.Contexts) > 0 || rule.RequiresStatusChecks != nil {
+		return rule.Contexts, true
+	}
+	return nil, false
+}
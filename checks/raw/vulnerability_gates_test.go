@@ -0,0 +1,151 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestGetVulnerabilityGates(t *testing.T) {
+	t.Parallel()
+	protected := true
+	yes, no := true, false
+	tests := []struct {
+		branch    *clients.BranchRef
+		branchErr error
+		name      string
+		want      []checker.VulnerabilityGate
+	}{
+		{
+			name: "required status checks",
+			branch: &clients.BranchRef{
+				Protected: &protected,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					CheckRules: clients.StatusChecksRule{
+						Contexts: []string{"Dependency review", "Go (1.22)", "osv / scan-pr"},
+					},
+				},
+			},
+			want: []checker.VulnerabilityGate{
+				{
+					Type:     checker.VulnerabilityGateDependencyReview,
+					Job:      "Dependency review",
+					Required: &yes,
+					File:     checker.File{Path: ".github/workflows/pr.yml", Offset: 12},
+				},
+				{
+					Type:     checker.VulnerabilityGateGovulncheck,
+					Job:      "Go",
+					Required: &yes,
+					File:     checker.File{Path: ".github/workflows/pr.yml", Offset: 23},
+				},
+				{
+					Type:     checker.VulnerabilityGateNpmAudit,
+					Job:      "node",
+					Required: &no,
+					File:     checker.File{Path: ".github/workflows/pr.yml", Offset: 28},
+				},
+				{
+					Type:     checker.VulnerabilityGateOSVScanner,
+					Job:      "osv",
+					Required: &yes,
+					File:     checker.File{Path: ".github/workflows/pr.yml", Offset: 32},
+				},
+				{
+					Type: checker.VulnerabilityGateGitLabDependencyScanning,
+					File: checker.File{Path: ".gitlab-ci.yml", Offset: 2},
+				},
+				{
+					Type: checker.VulnerabilityGateCargoAudit,
+					File: checker.File{Path: ".gitlab-ci.yml", Offset: 8},
+				},
+				{
+					Type: checker.VulnerabilityGateRenovate,
+					File: checker.File{Path: "renovate.json", Offset: 4},
+				},
+			},
+		},
+		{
+			name:      "branch protection unsupported",
+			branchErr: clients.ErrUnsupportedFeature,
+			want: []checker.VulnerabilityGate{
+				{
+					Type: checker.VulnerabilityGateDependencyReview,
+					Job:  "Dependency review",
+					File: checker.File{Path: ".github/workflows/pr.yml", Offset: 12},
+				},
+				{
+					Type: checker.VulnerabilityGateGovulncheck,
+					Job:  "Go",
+					File: checker.File{Path: ".github/workflows/pr.yml", Offset: 23},
+				},
+				{
+					Type: checker.VulnerabilityGateNpmAudit,
+					Job:  "node",
+					File: checker.File{Path: ".github/workflows/pr.yml", Offset: 28},
+				},
+				{
+					Type: checker.VulnerabilityGateOSVScanner,
+					Job:  "osv",
+					File: checker.File{Path: ".github/workflows/pr.yml", Offset: 32},
+				},
+				{
+					Type: checker.VulnerabilityGateGitLabDependencyScanning,
+					File: checker.File{Path: ".gitlab-ci.yml", Offset: 2},
+				},
+				{
+					Type: checker.VulnerabilityGateCargoAudit,
+					File: checker.File{Path: ".gitlab-ci.yml", Offset: 8},
+				},
+				{
+					Type: checker.VulnerabilityGateRenovate,
+					File: checker.File{Path: "renovate.json", Offset: 4},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := mockRepoFiles(t, "testdata/vulnerability-gates")
+			client.(*mockrepo.MockRepoClient).EXPECT().GetDefaultBranch().Return(tt.branch, tt.branchErr)
+
+			dl := scut.TestDetailLogger{}
+			got, err := getVulnerabilityGates(client, &dl)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// The invalid workflow is skipped.
+			if details := dl.Flush(); len(details) != 1 || details[0].Msg.Path != ".github/workflows/invalid.yml" {
+				t.Errorf("unexpected details: %v", details)
+			}
+			for i := range tt.want {
+				tt.want[i].File.Type = finding.FileTypeSource
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(checker.File{}, "Snippet")); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// windowsScript is a scripting language of Windows, which mvdan.cc/sh/v3/syntax can't parse.
+// Scripts of those are analyzed statement by statement instead.
+type windowsScript int
+
+const (
+	powerShellScript windowsScript = iota
+	batchScript
+)
+
+var (
+	// psDownloadRegex matches the cmdlets, aliases and .NET methods downloading content in PowerShell.
+	psDownloadRegex = regexp.MustCompile(
+		`(?i)(\.Download(String|Data)(Async)?\(|\b(Invoke-WebRequest|iwr|Invoke-RestMethod|irm)\b|(^|[\s(|;])(curl|wget)(\.exe)?\s)`)
+	// psExecuteRegex matches the ways of running a string as PowerShell code.
+	psExecuteRegex = regexp.MustCompile(`(?i)(\b(Invoke-Expression|iex)\b|\[ScriptBlock\]::Create\()`)
+	// psDownloadFileRegex matches the .NET method downloading a file, capturing its destination.
+	psDownloadFileRegex = regexp.MustCompile(`(?i)\.DownloadFile(Async)?\(\s*[^,]+,\s*([^)]+)\)`)
+	// windowsURLRegex matches the URLs of a statement, up to the quote or parenthesis ending them.
+	windowsURLRegex = regexp.MustCompile("https?://[^\\s'\"()`]+")
+
+	// windowsExecuteCommands run the file they're given, e.g. `Start-Process installer.exe`.
+	windowsExecuteCommands = []string{
+		"&", ".", "start-process", "saps", "start", "invoke-item", "ii", "call",
+		"msiexec", "powershell", "pwsh", "cmd", "rundll32",
+	}
+	// windowsInterpreters run the script piped to them, e.g. `curl -s url | powershell -`.
+	windowsInterpreters = append([]string{"powershell", "pwsh", "cmd"}, interpreters...)
+)
+
+// windowsScriptForFile returns the Windows scripting language of a file, from its extension.
+func windowsScriptForFile(pathfn string) (windowsScript, bool) {
+	switch strings.ToLower(path.Ext(pathfn)) {
+	case ".ps1", ".psm1":
+		return powerShellScript, true
+	case ".bat", ".cmd":
+		return batchScript, true
+	default:
+		return 0, false
+	}
+}
+
+// windowsScriptForShell returns the Windows scripting language of a workflow step shell,
+// e.g. `pwsh`, `powershell -NoProfile {0}` or `cmd`.
+func windowsScriptForShell(shell string) (windowsScript, bool) {
+	fields := strings.Fields(shell)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	switch name := strings.TrimSuffix(strings.ToLower(fields[0]), ".exe"); name {
+	case "pwsh", "powershell":
+		return powerShellScript, true
+	case "cmd":
+		return batchScript, true
+	default:
+		return 0, false
+	}
+}
+
+// scriptStatement is a statement of a script, with the lines it spans.
+type scriptStatement struct {
+	text               string
+	startLine, endLine uint
+}
+
+// splitWindowsScript splits a script into statements. Lines ending with the continuation
+// character are joined, comments are dropped, and each line is split on the separators
+// of the language, outside of quotes.
+func splitWindowsScript(kind windowsScript, content []byte) []scriptStatement {
+	continuation, separators := "`", []string{";", "&&", "||"}
+	if kind == batchScript {
+		continuation, separators = "^", []string{"&&", "||", "&"}
+	}
+
+	var statements []scriptStatement
+	var text strings.Builder
+	var start uint
+	inBlockComment := false
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		n := uint(i + 1)
+		trimmed := strings.TrimSpace(line)
+		if kind == powerShellScript {
+			if strings.HasPrefix(trimmed, "<#") {
+				inBlockComment = true
+			}
+			if inBlockComment {
+				inBlockComment = !strings.Contains(trimmed, "#>")
+				continue
+			}
+		}
+		if text.Len() == 0 {
+			if isWindowsScriptComment(kind, trimmed) {
+				continue
+			}
+			start = n
+		}
+		if strings.HasSuffix(trimmed, continuation) && i < len(lines)-1 {
+			text.WriteString(strings.TrimSuffix(trimmed, continuation))
+			text.WriteString(" ")
+			continue
+		}
+		text.WriteString(trimmed)
+		for _, s := range splitOutsideQuotes(text.String(), separators) {
+			if s = strings.TrimSpace(s); s != "" {
+				statements = append(statements, scriptStatement{text: s, startLine: start, endLine: n})
+			}
+		}
+		text.Reset()
+	}
+	return statements
+}
+
+func isWindowsScriptComment(kind windowsScript, line string) bool {
+	if kind == powerShellScript {
+		return strings.HasPrefix(line, "#")
+	}
+	lower := strings.ToLower(strings.TrimPrefix(line, "@"))
+	return strings.HasPrefix(lower, "::") || lower == "rem" || strings.HasPrefix(lower, "rem ")
+}
+
+// splitOutsideQuotes splits s on any of the separators which aren't quoted.
+func splitOutsideQuotes(s string, separators []string) []string {
+	var parts []string
+	var quote byte
+	last := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '"' || c == '\'':
+			quote = c
+			continue
+		}
+		for _, sep := range separators {
+			if strings.HasPrefix(s[i:], sep) {
+				parts = append(parts, s[last:i])
+				i += len(sep) - 1
+				last = i + 1
+				break
+			}
+		}
+	}
+	return append(parts, s[last:])
+}
+
+// windowsScriptWords splits a command into its words, removing quotes.
+func windowsScriptWords(command string) []string {
+	var words []string
+	var word strings.Builder
+	var quote rune
+	inWord := false
+	for _, c := range command {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote, inWord = c, true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// normalizeWindowsPath returns a path in a form comparable across commands: Windows paths
+// aren't case sensitive, and may use either separator.
+func normalizeWindowsPath(p string) string {
+	p = strings.ToLower(strings.Trim(p, `"'() `))
+	p = strings.ReplaceAll(p, `\`, "/")
+	if p == "" {
+		return ""
+	}
+	return path.Clean(p)
+}
+
+// windowsCommandName returns the name of a command, without its directory and .exe extension.
+func windowsCommandName(word string) string {
+	word = strings.ToLower(strings.ReplaceAll(word, `\`, "/"))
+	return strings.TrimSuffix(path.Base(word), ".exe")
+}
+
+// validateWindowsScript records the insecure downloads of a PowerShell or batch script.
+// Lines are offset by startLine, like for validateShellFile.
+func validateWindowsScript(kind windowsScript, pathfn string, startLine uint, content []byte,
+	files map[string]bool, r *checker.PinningDependenciesData,
+) {
+	for _, s := range splitWindowsScript(kind, content) {
+		validateWindowsStatement(kind, pathfn, startLine+s.startLine, startLine+s.endLine, s.text, files, r)
+	}
+}
+
+func validateWindowsStatement(kind windowsScript, pathfn string, startLine, endLine uint, statement string,
+	files map[string]bool, r *checker.PinningDependenciesData,
+) {
+	words := windowsScriptWords(statement)
+	if len(words) == 0 {
+		return
+	}
+	if kind == batchScript && strings.HasPrefix(words[0], "@") {
+		words[0] = strings.TrimPrefix(words[0], "@")
+	}
+
+	// `powershell -Command "iwr https://example.com/install.ps1 | iex"` from either language.
+	if command, ok := powerShellCommand(words); ok {
+		validateWindowsStatement(powerShellScript, pathfn, startLine, endLine, command, files, r)
+		return
+	}
+
+	// Check if we're running a file we previously downloaded.
+	if isWindowsExecuteFile(words, files) {
+		recordDownloadThenRun(pathfn, startLine, endLine, statement, r)
+	}
+
+	// `iex (New-Object Net.WebClient).DownloadString(url)` and `curl -s url | cmd`.
+	if isWindowsFetchExecute(kind, statement) {
+		recordDownloadThenRun(pathfn, startLine, endLine, statement, r)
+	}
+
+	// Record the file that is downloaded, if any.
+	// \`curl -o - url\` writes to stdout.
+	if fn, ok := windowsDownloadedFile(kind, statement, words); ok && fn != "" && fn != "-" {
+		files[fn] = true
+	}
+}
+
+// powerShellCommand returns the code run by `powershell -Command CODE`.
+func powerShellCommand(words []string) (string, bool) {
+	if name := windowsCommandName(words[0]); name != "powershell" && name != "pwsh" {
+		return "", false
+	}
+	for i := 1; i < len(words)-1; i++ {
+		switch strings.ToLower(words[i]) {
+		case "-command", "-c", "/c":
+			return strings.Join(words[i+1:], " "), true
+		}
+	}
+	return "", false
+}
+
+func isWindowsExecuteFile(words []string, files map[string]bool) bool {
+	if len(files) == 0 {
+		return false
+	}
+	name := windowsCommandName(words[0])
+	isExecute := files[normalizeWindowsPath(words[0])]
+	for _, c := range windowsExecuteCommands {
+		if name == c {
+			isExecute = true
+		}
+	}
+	if !isExecute {
+		return false
+	}
+	for _, w := range words {
+		if files[normalizeWindowsPath(w)] {
+			return true
+		}
+	}
+	return false
+}
+
+func isWindowsFetchExecute(kind windowsScript, statement string) bool {
+	if kind == powerShellScript && psDownloadRegex.MatchString(statement) && psExecuteRegex.MatchString(statement) {
+		return hasUnpinnedURLs(windowsURLRegex.FindAllString(statement, -1))
+	}
+
+	commands := splitOutsideQuotes(statement, []string{"|"})
+	for i := 0; i+1 < len(commands); i++ {
+		left := windowsScriptWords(commands[i])
+		right := windowsScriptWords(commands[i+1])
+		if len(left) == 0 || len(right) == 0 {
+			continue
+		}
+		left[0] = windowsCommandName(left[0])
+		isDownload := isDownloadUtility(left) ||
+			(kind == powerShellScript && psDownloadRegex.MatchString(commands[i]))
+		if isDownload && containsFold(windowsInterpreters, windowsCommandName(right[0])) {
+			return hasUnpinnedURLs(windowsURLRegex.FindAllString(commands[i], -1))
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// windowsDownloadedFile returns the file a statement downloads, e.g.
+// `Invoke-WebRequest url -OutFile installer.exe` or `curl -o installer.exe url`.
+func windowsDownloadedFile(kind windowsScript, statement string, words []string) (string, bool) {
+	if kind == powerShellScript {
+		if m := psDownloadFileRegex.FindStringSubmatch(statement); m != nil {
+			return normalizeWindowsPath(m[2]), true
+		}
+	}
+
+	name := windowsCommandName(words[0])
+	switch {
+	case name == "curl" || name == "wget":
+		for i := 1; i < len(words)-1; i++ {
+			switch w := words[i]; {
+			case name == "curl" && (w == "--output" || isShortFlagEndingWith(w, 'o')),
+				name == "wget" && (w == "--output-document" || isShortFlagEndingWith(w, 'O')):
+				return normalizeWindowsPath(words[i+1]), true
+			case kind == powerShellScript && strings.EqualFold(w, "-OutFile"):
+				return normalizeWindowsPath(words[i+1]), true
+			}
+		}
+	case name == "invoke-webrequest" || name == "iwr" || name == "invoke-restmethod" || name == "irm":
+		for i := 1; i < len(words)-1; i++ {
+			if strings.EqualFold(words[i], "-OutFile") {
+				return normalizeWindowsPath(words[i+1]), true
+			}
+		}
+	case name == "start-bitstransfer":
+		for i := 1; i < len(words)-1; i++ {
+			if strings.EqualFold(words[i], "-Destination") {
+				return normalizeWindowsPath(words[i+1]), true
+			}
+		}
+		if positional := windowsPositionalArgs(words[1:]); len(positional) >= 2 {
+			return normalizeWindowsPath(positional[1]), true
+		}
+	case name == "bitsadmin" && containsFold(words, "/transfer"),
+		name == "certutil" && containsFold(words, "-urlcache"):
+		// The destination is the last argument: `bitsadmin /transfer job url file`.
+		if last := words[len(words)-1]; !strings.HasPrefix(last, "-") && !strings.HasPrefix(last, "/") {
+			return normalizeWindowsPath(last), true
+		}
+	}
+	return "", false
+}
+
+// isShortFlagEndingWith reports whether a word is a group of short flags ending with the given one,
+// e.g. the \`o\` of \`curl -sSLo file url\`.
+func isShortFlagEndingWith(word string, flag byte) bool {
+	return len(word) >= 2 && word[0] == '-' && word[1] != '-' && word[len(word)-1] == flag
+}
+
+// windowsPositionalArgs returns the arguments of a cmdlet not bound to a named parameter.
+func windowsPositionalArgs(args []string) []string {
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		if strings.HasPrefix(args[i], "-") {
+			i++
+			continue
+		}
+		positional = append(positional, args[i])
+	}
+	return positional
+}
+
+func recordDownloadThenRun(pathfn string, startLine, endLine uint, snippet string,
+	r *checker.PinningDependenciesData,
+) {
+	r.Dependencies = append(r.Dependencies,
+		checker.Dependency{
+			Location: &checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    startLine,
+				EndOffset: endLine,
+				Snippet:   snippet,
+			},
+			Pinned: asBoolPointer(false),
+			Type:   checker.DependencyUseTypeDownloadThenRun,
+		},
+	)
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestValidateWindowsScript(t *testing.T) {
+	t.Parallel()
+	type download struct {
+		snippet            string
+		startLine, endLine uint
+	}
+	tests := []struct {
+		name     string
+		filename string
+		expected []download
+	}{
+		{
+			name:     "powershell",
+			filename: "install.ps1",
+			expected: []download{
+				{"iwr https://example.com/install.ps1 -UseBasicParsing | iex", 7, 7},
+				{"Invoke-Expression ((New-Object System.Net.WebClient).DownloadString('https://chocolatey.org/install.ps1'))", 8, 8},
+				{"Start-Process $installer -ArgumentList '/S' -Wait", 14, 14},
+				{`msiexec /i "$env:TEMP\helper.msi" /qn`, 17, 17},
+				{`& .\setup.ps1`, 20, 20},
+			},
+		},
+		{
+			name:     "batch",
+			filename: "install.cmd",
+			expected: []download{
+				{`%TEMP%\agent.exe /quiet`, 4, 4},
+				{`start "" /wait msiexec /i %TEMP%\tool.msi`, 7, 7},
+				{"iwr https://example.com/bootstrap.ps1 | iex", 8, 8},
+				{"curl -s https://example.com/script.cmd | cmd", 10, 10},
+			},
+		},
+		{
+			name:     "batch with CRLF line endings",
+			filename: "crlf.bat",
+			expected: []download{
+				{"tool.exe --version", 2, 2},
+			},
+		},
+		{
+			name:     "module without downloads run",
+			filename: "safe.psm1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile("testdata/windows-scripts/" + tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+
+			var r checker.PinningDependenciesData
+			if _, err := validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []download
+			for _, dep := range r.Dependencies {
+				if dep.Type != checker.DependencyUseTypeDownloadThenRun || dep.Location.Path != tt.filename {
+					t.Errorf("unexpected dependency: %+v", dep)
+				}
+				got = append(got, download{dep.Location.Snippet, dep.Location.Offset, dep.Location.EndOffset})
+			}
+			if diff := cmp.Diff(tt.expected, got, cmp.AllowUnexported(download{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsScriptForShell(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		shell string
+		kind  windowsScript
+		ok    bool
+	}{
+		{shell: "pwsh", kind: powerShellScript, ok: true},
+		{shell: "powershell -NoProfile {0}", kind: powerShellScript, ok: true},
+		{shell: "cmd", kind: batchScript, ok: true},
+		{shell: "bash"},
+		{shell: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			t.Parallel()
+			kind, ok := windowsScriptForShell(tt.shell)
+			if kind != tt.kind || ok != tt.ok {
+				t.Errorf("windowsScriptForShell(%q) = %v, %v, want %v, %v", tt.shell, kind, ok, tt.kind, tt.ok)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ const CheckSAST = "SAST"
 func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.FileBased,
+		checker.GitHistoryBased,
 	}
 	if err := registerCheck(CheckSAST, SAST, supportedRequestTypes); err != nil {
 		// This should never happen.
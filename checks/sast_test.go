@@ -55,6 +55,17 @@ func Test_SAST(t *testing.T) {
 				NumberOfWarn: 1,
 			},
 		},
+		{
+			name:         "SAST configuration file without a workflow running the tool",
+			commits:      []clients.Commit{},
+			searchresult: clients.SearchResponse{},
+			checkRuns:    []clients.CheckRun{},
+			path:         ".semgrep.yml",
+			expected: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 1,
+			},
+		},
 		{
 			name:         "SAST checker should return failed status when an error occurs",
 			err:          errors.New("error"),
@@ -313,6 +324,8 @@ func Test_SAST(t *testing.T) {
 			})
 			mockRepoClient.EXPECT().ListCheckRunsForRef("").Return(tt.checkRuns, nil).AnyTimes()
 			mockRepoClient.EXPECT().Search(searchRequest).Return(tt.searchresult, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetCodeScanningDefaultSetup().
+				Return(clients.CodeScanningDefaultSetup{}, clients.ErrUnsupportedFeature).AnyTimes()
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
 				func(predicate func(string) (bool, error)) ([]string, error) {
 					if strings.Contains(tt.path, "pom") {
@@ -16,6 +16,8 @@ package checks
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -96,6 +98,9 @@ func TestSbom(t *testing.T) {
 			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
 				return tt.files, nil
 			}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("{}")), nil
+			}).AnyTimes()
 
 			dl := scut.TestDetailLogger{}
 			req := checker.CheckRequest{
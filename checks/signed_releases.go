@@ -28,7 +28,10 @@ const CheckSignedReleases = "Signed-Releases"
 
 //nolint:gochecknoinits
 func init() {
-	if err := registerCheck(CheckSignedReleases, SignedReleases, nil); err != nil {
+	supportedRequestTypes := []checker.RequestType{
+		checker.GitHistoryBased,
+	}
+	if err := registerCheck(CheckSignedReleases, SignedReleases, supportedRequestTypes); err != nil {
 		// this should never happen
 		panic(err)
 	}
@@ -190,6 +190,50 @@ func TestSignedRelease(t *testing.T) {
 				Score: 8,
 			},
 		},
+		{
+			name: "GitLab release with only source archives",
+			releases: []clients.Release{
+				{
+					TagName:         "v1.0.0",
+					TargetCommitish: "/owner/repo/-/commit/abc123",
+					Assets: []clients.ReleaseAsset{
+						{
+							Name: "tar.gz",
+							URL:  "https://gitlab.com/owner/repo/-/archive/v1.0.0/repo-v1.0.0.tar.gz",
+						},
+					},
+				},
+			},
+			expected: checker.CheckResult{
+				Score: 0,
+			},
+		},
+		{
+			name: "GitLab release with a signature in its links",
+			releases: []clients.Release{
+				{
+					TagName:         "v1.0.0",
+					TargetCommitish: "/owner/repo/-/commit/abc123",
+					Assets: []clients.ReleaseAsset{
+						{
+							Name: "tar.gz",
+							URL:  "https://gitlab.com/owner/repo/-/archive/v1.0.0/repo-v1.0.0.tar.gz",
+						},
+						{
+							Name: "repo-linux-amd64.tar.gz",
+							URL:  "https://gitlab.com/owner/repo/-/package_files/1/download",
+						},
+						{
+							Name: "repo-linux-amd64.tar.gz.sig",
+							URL:  "https://gitlab.com/owner/repo/-/package_files/2/download",
+						},
+					},
+				},
+			},
+			expected: checker.CheckResult{
+				Score: 8,
+			},
+		},
 		{
 			name: "Releases with assets with signed and unsigned artifacts",
 			releases: []clients.Release{
@@ -58,6 +58,7 @@ func TestVulnerabilities(t *testing.T) {
 			mockRepo.EXPECT().LocalPath().DoAndReturn(func() (string, error) {
 				return "test_path", nil
 			}).AnyTimes()
+			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
 
 			mockVulnClient := mockrepo.NewMockVulnerabilitiesClient(ctrl)
 			mockVulnClient.EXPECT().ListUnfixedVulnerabilities(context.TODO(), gomock.Any(), gomock.Any()).DoAndReturn(
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -217,6 +218,18 @@ func (c *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return c.servicehooks.listWebhooks()
 }
 
+func (c *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+func (c *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
+func (c *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("GetReleaseAssetReader: %w", clients.ErrUnsupportedFeature)
+}
+
 func (c *Client) ListProgrammingLanguages() ([]clients.Language, error) {
 	return c.languages.listProgrammingLanguages()
 }
@@ -242,45 +255,56 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 	// https://dev.azure.com/<org>
 	url := "https://" + repo.Host() + "/" + strings.Split(repo.Path(), "/")[0]
 	connection := azuredevops.NewPatConnection(url, token)
+	areas := &areaClients{connection: connection}
+	if clients.TransportWrapped() {
+		areas.httpClient = &http.Client{Transport: clients.WrapTransport(http.DefaultTransport)}
+	}
 
-	client := azuredevops.NewClient(connection, url)
+	client := areas.clientByURL(url)
 
-	auditClient, err := audit.NewClient(ctx, connection)
+	auditArea, err := areas.clientByArea(ctx, audit.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops audit client with error: %w", err)
 	}
+	auditClient := &audit.ClientImpl{Client: *auditArea}
 
-	buildClient, err := build.NewClient(ctx, connection)
+	buildArea, err := areas.clientByArea(ctx, build.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops build client with error: %w", err)
 	}
+	buildClient := &build.ClientImpl{Client: *buildArea}
 
-	gitClient, err := git.NewClient(ctx, connection)
+	gitArea, err := areas.clientByArea(ctx, git.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops git client with error: %w", err)
 	}
+	gitClient := &git.ClientImpl{Client: *gitArea}
 
-	policyClient, err := policy.NewClient(ctx, connection)
+	policyArea, err := areas.clientByArea(ctx, policy.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops policy client with error: %w", err)
 	}
+	policyClient := &policy.ClientImpl{Client: *policyArea}
 
-	projectAnalysisClient, err := projectanalysis.NewClient(ctx, connection)
+	projectAnalysisArea, err := areas.clientByArea(ctx, projectanalysis.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops project analysis client with error: %w", err)
 	}
+	projectAnalysisClient := &projectanalysis.ClientImpl{Client: *projectAnalysisArea}
 
-	searchClient, err := search.NewClient(ctx, connection)
+	searchArea, err := areas.clientByArea(ctx, search.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops search client with error: %w", err)
 	}
+	searchClient := &search.ClientImpl{Client: *searchArea}
 
-	servicehooksClient := servicehooks.NewClient(ctx, connection)
+	servicehooksClient := &servicehooks.ClientImpl{Client: *areas.clientByURL(connection.BaseUrl)}
 
-	workItemsClient, err := workitemtracking.NewClient(ctx, connection)
+	workItemsArea, err := areas.clientByArea(ctx, workitemtracking.ResourceAreaId)
 	if err != nil {
 		return nil, fmt.Errorf("could not create azure devops work item tracking client with error: %w", err)
 	}
+	workItemsClient := &workitemtracking.ClientImpl{Client: *workItemsArea}
 
 	return &Client{
 		ctx:        ctx,
@@ -0,0 +1,77 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+var errResourceAreaNotFound = errors.New("resource area not registered")
+
+// areaClients creates the SDK clients for each Azure DevOps service.
+// azuredevops.Connection hands out clients using a default *http.Client,
+// so when httpClient is set the resource area lookup is done here instead.
+type areaClients struct {
+	connection *azuredevops.Connection
+	httpClient *http.Client
+	locations  map[uuid.UUID]string
+}
+
+func (a *areaClients) clientByURL(url string) *azuredevops.Client {
+	if a.httpClient == nil {
+		return azuredevops.NewClient(a.connection, url)
+	}
+	return azuredevops.NewClientWithOptions(a.connection, url, azuredevops.WithHTTPClient(a.httpClient))
+}
+
+func (a *areaClients) clientByArea(ctx context.Context, id uuid.UUID) (*azuredevops.Client, error) {
+	if a.httpClient == nil {
+		client, err := a.connection.GetClientByResourceAreaId(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("GetClientByResourceAreaId: %w", err)
+		}
+		return client, nil
+	}
+
+	if a.locations == nil {
+		areas, err := a.clientByURL(a.connection.BaseUrl).GetResourceAreas(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("GetResourceAreas: %w", err)
+		}
+		a.locations = make(map[uuid.UUID]string)
+		if areas != nil {
+			for _, area := range *areas {
+				if area.Id != nil && area.LocationUrl != nil {
+					a.locations[*area.Id] = *area.LocationUrl
+				}
+			}
+		}
+	}
+	// On-premises servers don't list resource areas, every service is hosted at the base URL.
+	if len(a.locations) == 0 {
+		return a.clientByURL(a.connection.BaseUrl), nil
+	}
+	location, ok := a.locations[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errResourceAreaNotFound, id)
+	}
+	return a.clientByURL(location), nil
+}
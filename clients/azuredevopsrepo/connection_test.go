@@ -0,0 +1,108 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevopsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/search"
+)
+
+const resourceAreasLocations = `{"count":1,"value":[{
+	"id":"e81700f7-3be2-46de-8624-2eb35882fcaa",
+	"area":"Location",
+	"resourceName":"ResourceAreas",
+	"routeTemplate":"_apis/{resource}",
+	"resourceVersion":1,
+	"minVersion":"1.0",
+	"maxVersion":"7.1",
+	"releasedVersion":"0.0"
+}]}`
+
+type countingTransport struct {
+	requests atomic.Int32
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func Test_areaClients_clientByArea(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		wantErr error
+		areas   string
+		name    string
+		area    uuid.UUID
+	}{
+		{
+			name:  "registered area",
+			areas: fmt.Sprintf(`{"count":1,"value":[{"id":"%s","locationUrl":"https://example.com/git"}]}`, git.ResourceAreaId),
+			area:  git.ResourceAreaId,
+		},
+		{
+			name:    "unregistered area",
+			areas:   fmt.Sprintf(`{"count":1,"value":[{"id":"%s","locationUrl":"https://example.com/git"}]}`, git.ResourceAreaId),
+			area:    search.ResourceAreaId,
+			wantErr: errResourceAreaNotFound,
+		},
+		{
+			name:  "on-premises server",
+			areas: `{"count":0,"value":[]}`,
+			area:  search.ResourceAreaId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodOptions {
+					fmt.Fprint(w, resourceAreasLocations)
+					return
+				}
+				fmt.Fprint(w, tt.areas)
+			}))
+			defer srv.Close()
+
+			transport := &countingTransport{}
+			areas := &areaClients{
+				connection: azuredevops.NewAnonymousConnection(srv.URL),
+				httpClient: &http.Client{Transport: transport},
+			}
+			for range 2 {
+				_, err := areas.clientByArea(context.Background(), tt.area)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("clientByArea() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+			// One request for the resource locations and one for the areas, both
+			// made through the configured client and only once.
+			if got := transport.requests.Load(); got != 2 {
+				t.Errorf("requests through httpClient: got %d, want 2", got)
+			}
+		})
+	}
+}
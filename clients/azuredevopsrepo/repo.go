@@ -50,7 +50,7 @@ func (r *Repo) parse(input string) error {
 
 	const splitLen = 4
 	split := strings.SplitN(strings.Trim(u.Path, "/"), "/", splitLen)
-	if len(split) != splitLen {
+	if len(split) != splitLen || split[2] != "_git" {
 		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("Azure DevOps repo format is invalid: %s", input))
 	}
 
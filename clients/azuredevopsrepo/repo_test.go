@@ -58,6 +58,12 @@ func TestRepo_parse(t *testing.T) {
 			inputURL: "https://dev.azure.com/dnceng-public/public",
 			wantErr:  true,
 		},
+		{
+			name:     "invalid azuredevops project missing _git segment",
+			expected: Repo{},
+			inputURL: "https://codeberg.org/forgejo/forgejo/src/branch/forgejo",
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -158,6 +164,10 @@ func TestRepo_MakeAzureDevOpsRepo(t *testing.T) {
 			repouri:  "dev.azure.com/dnceng-public/public/_git/public",
 			expected: true,
 		},
+		{
+			repouri:  "codeberg.org/forgejo/forgejo/src/branch/forgejo",
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
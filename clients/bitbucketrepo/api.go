@@ -0,0 +1,222 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	cloudAPIURL = "https://api.bitbucket.org/2.0"
+	// Bitbucket Cloud caps pagelen at 100 for most endpoints,
+	// Bitbucket Data Center allows up to 1000 but defaults to 25.
+	maxPageSize = 100
+)
+
+var errAPIResponse = errors.New("unexpected Bitbucket API response")
+
+// apiError carries the HTTP status code of a failed Bitbucket API call,
+// so handlers can distinguish missing permissions from real failures.
+type apiError struct {
+	url        string
+	statusCode int
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%v: GET %s returned %d", errAPIResponse, e.url, e.statusCode)
+}
+
+func (e *apiError) Unwrap() error {
+	return errAPIResponse
+}
+
+func hasStatus(err error, codes ...int) bool {
+	var e *apiError
+	if !errors.As(err, &e) {
+		return false
+	}
+	for _, code := range codes {
+		if e.statusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// apiClient is a thin REST client shared by all handlers. The Bitbucket
+// Cloud and Data Center APIs differ in paths and payloads but share the same
+// authentication and a similar pagination scheme.
+type apiClient struct {
+	httpClient *http.Client
+	// baseURL is https://api.bitbucket.org/2.0 for Bitbucket Cloud
+	// and <scheme>://<host>/rest for Bitbucket Data Center.
+	baseURL  string
+	username string
+	token    string
+	cloud    bool
+}
+
+// page is the union of the Bitbucket Cloud and Data Center paginated envelopes.
+type page struct {
+	IsLastPage    *bool             `json:"isLastPage"`
+	Next          string            `json:"next"`
+	Values        []json.RawMessage `json:"values"`
+	NextPageStart int               `json:"nextPageStart"`
+}
+
+func (c *apiClient) do(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.username != "" && c.token != "":
+		req.SetBasicAuth(c.username, c.token)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http.Client.Do: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &apiError{url: rawURL, statusCode: resp.StatusCode}
+	}
+	return resp, nil
+}
+
+func (c *apiClient) url(path string, query url.Values) string {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// get decodes the JSON response of a single API call into v.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, v any) error {
+	return c.getURL(ctx, c.url(path, query), v)
+}
+
+func (c *apiClient) getURL(ctx context.Context, rawURL string, v any) error {
+	resp, err := c.do(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", rawURL, err)
+	}
+	return nil
+}
+
+// download returns the raw response body for rawURL. Callers must close it.
+func (c *apiClient) download(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// list walks a paginated endpoint and calls fn for every value until limit
+// values were seen, or all of them if limit <= 0.
+func (c *apiClient) list(ctx context.Context, path string, query url.Values, limit int,
+	fn func(json.RawMessage) error,
+) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	pageSize := maxPageSize
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	// Bitbucket Cloud uses "pagelen", Bitbucket Data Center uses "limit".
+	if c.cloud {
+		query.Set("pagelen", strconv.Itoa(pageSize))
+	} else {
+		query.Set("limit", strconv.Itoa(pageSize))
+	}
+
+	next := c.url(path, query)
+	seen := 0
+	for next != "" {
+		var p page
+		if err := c.getURL(ctx, next, &p); err != nil {
+			return err
+		}
+		for _, v := range p.Values {
+			if err := fn(v); err != nil {
+				return err
+			}
+			seen++
+			if limit > 0 && seen >= limit {
+				return nil
+			}
+		}
+
+		switch {
+		case p.Next != "":
+			// The credentials are sent along, so only pages of the API are followed.
+			if !c.sameOrigin(p.Next) {
+				return fmt.Errorf("%w: next page %s isn't on %s", errAPIResponse, p.Next, c.baseURL)
+			}
+			next = p.Next
+		case p.IsLastPage != nil && !*p.IsLastPage:
+			query.Set("start", strconv.Itoa(p.NextPageStart))
+			next = c.url(path, query)
+		default:
+			next = ""
+		}
+	}
+	return nil
+}
+
+// sameOrigin returns whether rawURL has the scheme and host of the API.
+func (c *apiClient) sameOrigin(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// listInto is a typed convenience wrapper around list.
+func listInto[T any](ctx context.Context, c *apiClient, path string, query url.Values, limit int) ([]T, error) {
+	var ret []T
+	err := c.list(ctx, path, query, limit, func(raw json.RawMessage) error {
+		var v T
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w", err)
+		}
+		ret = append(ret, v)
+		return nil
+	})
+	return ret, err
+}
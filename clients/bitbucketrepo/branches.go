@@ -0,0 +1,371 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// cloudRestriction is a Bitbucket Cloud branch restriction.
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-branch-restrictions/
+type cloudRestriction struct {
+	Value           *int   `json:"value"`
+	Kind            string `json:"kind"`
+	BranchMatchKind string `json:"branch_match_kind"`
+	BranchType      string `json:"branch_type"`
+	Pattern         string `json:"pattern"`
+}
+
+// cloudBranchingModel describes the branch types used by "branching_model" restrictions.
+type cloudBranchingModel struct {
+	Development *struct {
+		Name string `json:"name"`
+	} `json:"development"`
+	Production *struct {
+		Name string `json:"name"`
+	} `json:"production"`
+	Types []struct {
+		Kind   string `json:"kind"`
+		Prefix string `json:"prefix"`
+	} `json:"branch_types"`
+}
+
+// dcRestriction is a Bitbucket Data Center ref restriction.
+// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-repository/#api-branch-permissions-2-0-projects-projectkey-repos-repositoryslug-restrictions-get
+type dcRestriction struct {
+	Type    string `json:"type"`
+	Matcher struct {
+		ID        string `json:"id"`
+		DisplayID string `json:"displayId"`
+		Type      struct {
+			ID string `json:"id"`
+		} `json:"type"`
+	} `json:"matcher"`
+}
+
+type dcBranchModel struct {
+	Development *dcBranch `json:"development"`
+	Production  *dcBranch `json:"production"`
+	Types       []struct {
+		ID     string `json:"id"`
+		Prefix string `json:"prefix"`
+	} `json:"types"`
+}
+
+type dcPullRequestSettings struct {
+	RequiredApprovers        *int  `json:"requiredApprovers"`
+	RequiredSuccessfulBuilds *int  `json:"requiredSuccessfulBuilds"`
+	UnapproveOnUpdate        *bool `json:"unapproveOnUpdate"`
+}
+
+type branchesHandler struct {
+	api              *apiClient
+	ctx              context.Context
+	once             *sync.Once
+	errSetup         error
+	repourl          *Repo
+	defaultBranchRef *clients.BranchRef
+}
+
+func (handler *branchesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.defaultBranchRef = nil
+}
+
+func (handler *branchesHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: branches only supported for HEAD queries", clients.ErrUnsupportedFeature)
+			return
+		}
+		handler.defaultBranchRef, handler.errSetup = handler.getBranch(handler.repourl.defaultBranch)
+	})
+	return handler.errSetup
+}
+
+func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
+	}
+	return handler.defaultBranchRef, nil
+}
+
+func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, error) {
+	if handler.repourl.cloud {
+		return handler.getCloudBranch(branch)
+	}
+	return handler.getDCBranch(branch)
+}
+
+func (handler *branchesHandler) getCloudBranch(branch string) (*clients.BranchRef, error) {
+	var b struct {
+		Name string `json:"name"`
+	}
+	branchPath := fmt.Sprintf("%s/refs/branches/%s", handler.repourl.apiPath(), url.PathEscape(branch))
+	if err := handler.api.get(handler.ctx, branchPath, nil, &b); err != nil {
+		return nil, fmt.Errorf("request for branch %s failed with error %w", branch, err)
+	}
+
+	restrictions, err := listInto[cloudRestriction](handler.ctx, handler.api,
+		handler.repourl.apiPath()+"/branch-restrictions", nil, 0)
+	// Reading branch restrictions requires admin access to the repository.
+	if hasStatus(err, http.StatusForbidden, http.StatusUnauthorized) {
+		return &clients.BranchRef{Name: &b.Name}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for branch restrictions failed with error %w", err)
+	}
+
+	var model *cloudBranchingModel
+	var matching []cloudRestriction
+	for _, r := range restrictions {
+		if r.BranchMatchKind == "branching_model" && model == nil {
+			model = &cloudBranchingModel{}
+			if err := handler.api.get(handler.ctx, handler.repourl.apiPath()+"/branching-model", nil,
+				model); err != nil && !hasStatus(err, http.StatusNotFound) {
+				return nil, fmt.Errorf("request for branching model failed with error %w", err)
+			}
+		}
+		if cloudRestrictionMatches(&r, model, b.Name) {
+			matching = append(matching, r)
+		}
+	}
+	return makeCloudBranchRef(b.Name, matching), nil
+}
+
+func cloudRestrictionMatches(r *cloudRestriction, model *cloudBranchingModel, branch string) bool {
+	switch r.BranchMatchKind {
+	case "glob":
+		return globMatch(r.Pattern, branch)
+	case "branching_model":
+		if model == nil {
+			return false
+		}
+		switch r.BranchType {
+		case "development":
+			return model.Development != nil && model.Development.Name == branch
+		case "production":
+			return model.Production != nil && model.Production.Name == branch
+		}
+		for _, t := range model.Types {
+			if t.Kind == r.BranchType && t.Prefix != "" && strings.HasPrefix(branch, t.Prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// globMatch matches Bitbucket branch patterns, where "*" also matches "/".
+func globMatch(pattern, branch string) bool {
+	if pattern == "*" || pattern == branch {
+		return true
+	}
+	if ok, err := path.Match(pattern, branch); err == nil && ok {
+		return true
+	}
+	// Bitbucket matches "release/*" against "release/1.x/rc" too.
+	prefix, found := strings.CutSuffix(pattern, "*")
+	return found && !strings.ContainsAny(prefix, "*?[") && strings.HasPrefix(branch, prefix)
+}
+
+func makeCloudBranchRef(name string, restrictions []cloudRestriction) *clients.BranchRef {
+	protected := len(restrictions) > 0
+	ret := &clients.BranchRef{
+		Name:      &name,
+		Protected: &protected,
+	}
+	if !protected {
+		return ret
+	}
+
+	rule := &ret.BranchProtectionRule
+	rule.AllowForcePushes = asPtr(true)
+	rule.AllowDeletions = asPtr(true)
+	rule.EnforceAdmins = asPtr(false)
+	rule.PullRequestRule.Required = asPtr(false)
+	rule.PullRequestRule.DismissStaleReviews = asPtr(false)
+	rule.PullRequestRule.RequireCodeOwnerReviews = asPtr(false)
+	rule.RequireLastPushApproval = asPtr(false)
+	rule.CheckRules.RequiresStatusChecks = asPtr(false)
+	for _, r := range restrictions {
+		switch r.Kind {
+		case "force":
+			rule.AllowForcePushes = asPtr(false)
+		case "delete":
+			rule.AllowDeletions = asPtr(false)
+		case "push", "restrict_merges":
+			// Only the exempted users and groups may push directly,
+			// everyone else has to go through a pull request.
+			rule.PullRequestRule.Required = asPtr(true)
+		case "require_approvals_to_merge":
+			if r.Value != nil {
+				rule.PullRequestRule.RequiredApprovingReviewCount = asPtr(int32(*r.Value))
+			}
+		case "require_default_reviewer_approvals_to_merge":
+			// Default reviewers are the closest equivalent to code owners.
+			rule.PullRequestRule.RequireCodeOwnerReviews = asPtr(true)
+		case "reset_pullrequest_approvals_on_change":
+			rule.PullRequestRule.DismissStaleReviews = asPtr(true)
+			rule.RequireLastPushApproval = asPtr(true)
+		case "require_passing_builds_to_merge":
+			rule.CheckRules.RequiresStatusChecks = asPtr(true)
+		case "require_commits_behind":
+			rule.CheckRules.UpToDateBeforeMerge = asPtr(r.Value != nil && *r.Value == 0)
+		case "enforce_merge_checks":
+			// Without this (Premium) setting, admins can bypass merge checks.
+			rule.EnforceAdmins = asPtr(true)
+		}
+	}
+	return ret
+}
+
+func (handler *branchesHandler) getDCBranch(branch string) (*clients.BranchRef, error) {
+	query := url.Values{"filterText": {branch}}
+	branches, err := listInto[dcBranch](handler.ctx, handler.api, handler.repourl.apiPath()+"/branches", query, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for branch %s failed with error %w", branch, err)
+	}
+	found := false
+	for _, b := range branches {
+		if b.DisplayID == branch {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: branch %s not found", errAPIResponse, branch)
+	}
+
+	restrictionsPath := fmt.Sprintf("/branch-permissions/2.0/projects/%s/repos/%s/restrictions",
+		url.PathEscape(handler.repourl.owner), url.PathEscape(handler.repourl.slug))
+	restrictions, err := listInto[dcRestriction](handler.ctx, handler.api, restrictionsPath, nil, 0)
+	if hasStatus(err, http.StatusForbidden, http.StatusUnauthorized) {
+		return &clients.BranchRef{Name: &branch}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for branch restrictions failed with error %w", err)
+	}
+
+	var model *dcBranchModel
+	var matching []dcRestriction
+	for _, r := range restrictions {
+		if strings.HasPrefix(r.Matcher.Type.ID, "MODEL_") && model == nil {
+			model = &dcBranchModel{}
+			modelPath := fmt.Sprintf("/branch-utils/1.0/projects/%s/repos/%s/branchmodel",
+				url.PathEscape(handler.repourl.owner), url.PathEscape(handler.repourl.slug))
+			if err := handler.api.get(handler.ctx, modelPath, nil, model); err != nil &&
+				!hasStatus(err, http.StatusNotFound) {
+				return nil, fmt.Errorf("request for branch model failed with error %w", err)
+			}
+		}
+		if dcRestrictionMatches(&r, model, branch) {
+			matching = append(matching, r)
+		}
+	}
+
+	var settings dcPullRequestSettings
+	if err := handler.api.get(handler.ctx, handler.repourl.apiPath()+"/settings/pull-requests", nil,
+		&settings); err != nil && !hasStatus(err, http.StatusForbidden, http.StatusUnauthorized) {
+		return nil, fmt.Errorf("request for pull request settings failed with error %w", err)
+	}
+	return makeDCBranchRef(branch, matching, &settings), nil
+}
+
+func dcRestrictionMatches(r *dcRestriction, model *dcBranchModel, branch string) bool {
+	switch r.Matcher.Type.ID {
+	case "BRANCH":
+		return r.Matcher.DisplayID == branch || r.Matcher.ID == "refs/heads/"+branch
+	case "PATTERN":
+		return globMatch(r.Matcher.ID, branch) || globMatch(r.Matcher.ID, "refs/heads/"+branch)
+	case "MODEL_BRANCH":
+		if model == nil {
+			return false
+		}
+		switch r.Matcher.ID {
+		case "development":
+			return model.Development != nil && model.Development.DisplayID == branch
+		case "production":
+			return model.Production != nil && model.Production.DisplayID == branch
+		}
+	case "MODEL_CATEGORY":
+		if model == nil {
+			return false
+		}
+		for _, t := range model.Types {
+			if t.ID == r.Matcher.ID && t.Prefix != "" && strings.HasPrefix(branch, t.Prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func makeDCBranchRef(name string, restrictions []dcRestriction, settings *dcPullRequestSettings) *clients.BranchRef {
+	protected := len(restrictions) > 0
+	ret := &clients.BranchRef{
+		Name:      &name,
+		Protected: &protected,
+	}
+	if !protected {
+		return ret
+	}
+
+	rule := &ret.BranchProtectionRule
+	rule.AllowForcePushes = asPtr(true)
+	rule.AllowDeletions = asPtr(true)
+	rule.PullRequestRule.Required = asPtr(false)
+	for _, r := range restrictions {
+		switch r.Type {
+		case "read-only":
+			rule.AllowForcePushes = asPtr(false)
+			rule.AllowDeletions = asPtr(false)
+			rule.PullRequestRule.Required = asPtr(true)
+		case "fast-forward-only":
+			rule.AllowForcePushes = asPtr(false)
+		case "no-deletes":
+			rule.AllowDeletions = asPtr(false)
+		case "pull-request-only":
+			rule.PullRequestRule.Required = asPtr(true)
+		}
+	}
+
+	if settings.RequiredApprovers != nil {
+		rule.PullRequestRule.RequiredApprovingReviewCount = asPtr(int32(*settings.RequiredApprovers))
+	}
+	if settings.RequiredSuccessfulBuilds != nil {
+		rule.CheckRules.RequiresStatusChecks = asPtr(*settings.RequiredSuccessfulBuilds > 0)
+	}
+	if settings.UnapproveOnUpdate != nil {
+		rule.PullRequestRule.DismissStaleReviews = settings.UnapproveOnUpdate
+		rule.RequireLastPushApproval = settings.UnapproveOnUpdate
+	}
+	return ret
+}
+
+func asPtr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_getBranch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   *clients.BranchRef
+		branch string
+		cloud  bool
+	}{
+		{
+			name:   "cloud restrictions across pages and branching model",
+			cloud:  true,
+			branch: "main",
+			routes: fakeRoutes{
+				cloudRepoPath + "/refs/branches/main":         "testdata/cloud/branch-main.json",
+				cloudRepoPath + "/branch-restrictions":        "testdata/cloud/branch-restrictions.json",
+				cloudRepoPath + "/branch-restrictions?page=2": "testdata/cloud/branch-restrictions-2.json",
+				cloudRepoPath + "/branching-model":            "testdata/cloud/branching-model.json",
+			},
+			want: &clients.BranchRef{
+				Name:      asPtr("main"),
+				Protected: asPtr(true),
+				BranchProtectionRule: clients.BranchProtectionRule{
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     asPtr(true),
+						RequiredApprovingReviewCount: asPtr(int32(2)),
+						DismissStaleReviews:          asPtr(true),
+						RequireCodeOwnerReviews:      asPtr(false),
+					},
+					AllowDeletions:          asPtr(true),
+					AllowForcePushes:        asPtr(false),
+					EnforceAdmins:           asPtr(false),
+					RequireLastPushApproval: asPtr(true),
+					CheckRules: clients.StatusChecksRule{
+						UpToDateBeforeMerge:  asPtr(true),
+						RequiresStatusChecks: asPtr(true),
+					},
+				},
+			},
+		},
+		{
+			name:   "cloud restrictions require admin access",
+			cloud:  true,
+			branch: "main",
+			routes: fakeRoutes{
+				cloudRepoPath + "/refs/branches/main":  "testdata/cloud/branch-main.json",
+				cloudRepoPath + "/branch-restrictions": "status:403",
+			},
+			want: &clients.BranchRef{Name: asPtr("main")},
+		},
+		{
+			name:   "cloud branch not found",
+			cloud:  true,
+			branch: "does-not-exist",
+			routes: fakeRoutes{},
+			want:   nil,
+		},
+		{
+			name:   "data center branch without restrictions",
+			branch: "master-old",
+			routes: fakeRoutes{
+				dcRepoPath + "/branches": "testdata/datacenter/branches.json",
+				"/branch-permissions/2.0/projects/OSSF/repos/scorecard-check/restrictions": "testdata/empty.json",
+				dcRepoPath + "/settings/pull-requests":                                     "testdata/datacenter/pull-request-settings.json",
+			},
+			want: &clients.BranchRef{Name: asPtr("master-old"), Protected: asPtr(false)},
+		},
+		{
+			name:   "data center restrictions and pull request settings",
+			branch: "master",
+			routes: fakeRoutes{
+				dcRepoPath + "/branches": "testdata/datacenter/branches.json",
+				"/branch-permissions/2.0/projects/OSSF/repos/scorecard-check/restrictions":         "testdata/datacenter/restrictions.json",
+				"/branch-permissions/2.0/projects/OSSF/repos/scorecard-check/restrictions?start=2": "testdata/datacenter/restrictions-2.json",
+				"/branch-utils/1.0/projects/OSSF/repos/scorecard-check/branchmodel":                "testdata/datacenter/branchmodel.json",
+				dcRepoPath + "/settings/pull-requests":                                             "testdata/datacenter/pull-request-settings.json",
+			},
+			want: &clients.BranchRef{
+				Name:      asPtr("master"),
+				Protected: asPtr(true),
+				BranchProtectionRule: clients.BranchProtectionRule{
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     asPtr(false),
+						RequiredApprovingReviewCount: asPtr(int32(1)),
+						DismissStaleReviews:          asPtr(true),
+					},
+					AllowDeletions:          asPtr(false),
+					AllowForcePushes:        asPtr(false),
+					RequireLastPushApproval: asPtr(true),
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: asPtr(true),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeBitbucket(t, tt.routes)
+			handler := &branchesHandler{api: newTestAPI(srv, tt.cloud)}
+			handler.init(context.Background(), testRepo(tt.cloud))
+			got, err := handler.getBranch(tt.branch)
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("getBranch: expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getBranch: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getBranch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getDefaultBranch_nonHead(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{})
+	handler := &branchesHandler{api: newTestAPI(srv, true)}
+	repo := testRepo(true)
+	repo.commitSHA = "1111111111111111111111111111111111111111"
+	handler.init(context.Background(), repo)
+	_, err := handler.getDefaultBranch()
+	if !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Fatalf("getDefaultBranch: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+}
+
+func Test_globMatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		branch  string
+		want    bool
+	}{
+		{pattern: "*", branch: "feature/a/b", want: true},
+		{pattern: "main", branch: "main", want: true},
+		{pattern: "main", branch: "maintenance", want: false},
+		{pattern: "release/*", branch: "release/1.x", want: true},
+		{pattern: "release/*", branch: "release/1.x/rc", want: true},
+		{pattern: "release-?", branch: "release-1", want: true},
+		{pattern: "release/*", branch: "hotfix/1.x", want: false},
+	}
+	for _, tt := range tests {
+		if got := globMatch(tt.pattern, tt.branch); got != tt.want {
+			t.Errorf("globMatch(%q, %q) = %t, want %t", tt.pattern, tt.branch, got, tt.want)
+		}
+	}
+}
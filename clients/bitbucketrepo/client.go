@@ -0,0 +1,255 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitbucketrepo implements clients.RepoClient for Bitbucket Cloud
+// and Bitbucket Data Center.
+package bitbucketrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+var (
+	_                clients.RepoClient = &Client{}
+	errInputRepoType                    = errors.New("input repo should be of type bitbucketrepo.Repo")
+)
+
+type Client struct {
+	api          *apiClient
+	ctx          context.Context
+	repourl      *Repo
+	project      *projectHandler
+	branches     *branchesHandler
+	commits      *commitsHandler
+	contributors *contributorsHandler
+	issues       *issuesHandler
+	pipelines    *pipelinesHandler
+	releases     *releasesHandler
+	statuses     *statusesHandler
+	tarball      *tarballHandler
+	webhook      *webhookHandler
+	commitDepth  int
+}
+
+// InitRepo sets up the Bitbucket repository in local storage for improving performance and API usage efficiency.
+func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	bbRepo, ok := inputRepo.(*Repo)
+	if !ok {
+		return fmt.Errorf("%w: %v", errInputRepoType, inputRepo)
+	}
+
+	if commitDepth <= 0 {
+		client.commitDepth = 30 // default
+	} else {
+		client.commitDepth = commitDepth
+	}
+
+	client.repourl = &Repo{
+		scheme:    bbRepo.scheme,
+		host:      bbRepo.host,
+		owner:     bbRepo.owner,
+		slug:      bbRepo.slug,
+		cloud:     bbRepo.cloud,
+		commitSHA: commitSHA,
+	}
+
+	// Sanity check.
+	if err := client.project.init(client.ctx, client.repourl); err != nil {
+		return sce.WithMessage(sce.ErrRepoUnreachable, client.repourl.Path()+"\t"+err.Error())
+	}
+	client.repourl.defaultBranch = client.project.defaultBranch
+
+	client.branches.init(client.ctx, client.repourl)
+	client.commits.init(client.ctx, client.repourl, client.commitDepth)
+	client.contributors.init(client.ctx, client.repourl)
+	client.issues.init(client.ctx, client.repourl, client.project.hasIssues)
+	client.pipelines.init(client.ctx, client.repourl)
+	client.releases.init(client.ctx, client.repourl)
+	client.statuses.init(client.ctx, client.repourl)
+	client.tarball.init(client.ctx, client.repourl)
+	client.webhook.init(client.ctx, client.repourl)
+	return nil
+}
+
+func (client *Client) URI() string {
+	return client.repourl.URI()
+}
+
+func (client *Client) IsArchived() (bool, error) {
+	return client.project.isArchived(), nil
+}
+
+func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return client.tarball.listFiles(predicate)
+}
+
+func (client *Client) LocalPath() (string, error) {
+	return client.tarball.getLocalPath()
+}
+
+func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	return client.tarball.getFile(filename)
+}
+
+func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
+	return client.branches.getBranch(branch)
+}
+
+func (client *Client) GetCreatedAt() (time.Time, error) {
+	if !client.project.createdAt.IsZero() {
+		return client.project.createdAt, nil
+	}
+	// Bitbucket Data Center doesn't record when a repository was created.
+	return client.contributors.getFirstCommitCreatedAt()
+}
+
+func (client *Client) GetDefaultBranchName() (string, error) {
+	return client.repourl.defaultBranch, nil
+}
+
+func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
+	return client.branches.getDefaultBranch()
+}
+
+// Org repository, AKA the <org>/.github repository, is a GitHub-specific feature.
+func (client *Client) GetOrgRepoClient(context.Context) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetOrgRepoClient (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListCommits() ([]clients.Commit, error) {
+	return client.commits.listCommits()
+}
+
+func (client *Client) ListIssues() ([]clients.Issue, error) {
+	return client.issues.listIssues()
+}
+
+// Bitbucket doesn't have a license detection feature.
+// Thankfully, the License check falls back to file-based detection.
+func (client *Client) ListLicenses() ([]clients.License, error) {
+	return nil, fmt.Errorf("ListLicenses (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListReleases() ([]clients.Release, error) {
+	return client.releases.getReleases()
+}
+
+func (client *Client) ListContributors() ([]clients.User, error) {
+	return client.contributors.listContributors()
+}
+
+func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	return client.pipelines.listSuccessfulWorkflowRuns(filename)
+}
+
+func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	return client.pipelines.listCheckRunsForRef(ref)
+}
+
+func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
+	return client.statuses.listStatuses(ref)
+}
+
+func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
+	return client.webhook.listWebhooks()
+}
+
+func (client *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("GetReleaseAssetReader: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListProgrammingLanguages returns the language configured on Bitbucket Cloud.
+// Bitbucket doesn't compute a language breakdown, so all languages are assumed
+// when none is configured.
+func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
+	if lang := client.project.language; lang != "" {
+		return []clients.Language{{Name: clients.LanguageName(strings.ToLower(lang)), NumLines: 1}}, nil
+	}
+	return []clients.Language{{Name: clients.All, NumLines: 1}}, nil
+}
+
+func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	return clients.SearchResponse{}, fmt.Errorf("Search (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	return nil, fmt.Errorf("SearchCommits (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Close() error {
+	return client.tarball.cleanup()
+}
+
+// CreateBitbucketClient returns a client for the host of repo, authenticated with
+// BITBUCKET_AUTH_TOKEN. If BITBUCKET_USERNAME is also set, the token is used as an
+// app password with basic authentication, otherwise as a bearer access token.
+func CreateBitbucketClient(ctx context.Context, repo clients.Repo) (*Client, error) {
+	token := os.Getenv("BITBUCKET_AUTH_TOKEN")
+	username := os.Getenv("BITBUCKET_USERNAME")
+	return CreateBitbucketClientWithToken(ctx, username, token, repo)
+}
+
+func CreateBitbucketClientWithToken(ctx context.Context, username, token string, repo clients.Repo) (*Client, error) {
+	bbRepo, ok := repo.(*Repo)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", errInputRepoType, repo)
+	}
+	api := &apiClient{
+		httpClient: &http.Client{Transport: clients.WrapTransport(http.DefaultTransport)},
+		username:   username,
+		token:      token,
+		cloud:      bbRepo.cloud,
+	}
+	if bbRepo.cloud {
+		api.baseURL = cloudAPIURL
+	} else {
+		api.baseURL = fmt.Sprintf("%s://%s/rest", bbRepo.scheme, bbRepo.host)
+	}
+	return newClient(ctx, api), nil
+}
+
+func newClient(ctx context.Context, api *apiClient) *Client {
+	return &Client{
+		api:          api,
+		ctx:          ctx,
+		project:      &projectHandler{api: api},
+		branches:     &branchesHandler{api: api},
+		commits:      &commitsHandler{api: api},
+		contributors: &contributorsHandler{api: api},
+		issues:       &issuesHandler{api: api},
+		pipelines:    &pipelinesHandler{api: api},
+		releases:     &releasesHandler{api: api},
+		statuses:     &statusesHandler{api: api},
+		tarball:      &tarballHandler{api: api},
+		webhook:      &webhookHandler{api: api},
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	cloudRepoPath = "/repositories/ossf-tests/scorecard-check"
+	dcRepoPath    = "/api/1.0/projects/OSSF/repos/scorecard-check"
+)
+
+// fakeRoutes maps a request path, optionally followed by "?" and query
+// parameters which must all be present, to a recorded response in testdata,
+// or to "status:<code>" to fail with the given status code.
+type fakeRoutes map[string]string
+
+// newFakeBitbucket serves recorded API responses. The SERVER_URL placeholder
+// in responses is replaced by the address of the server, so pagination links work.
+func newFakeBitbucket(t *testing.T, routes fakeRoutes) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := matchRoute(routes, r.URL)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if code, ok := strings.CutPrefix(file, "status:"); ok {
+			status, err := strconv.Atoi(code)
+			if err != nil {
+				t.Errorf("invalid status route %s: %v", file, err)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("reading %s: %v", file, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		content = bytes.ReplaceAll(content, []byte("SERVER_URL"), []byte(srv.URL))
+		//nolint:errcheck
+		w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func matchRoute(routes fakeRoutes, u *url.URL) (string, bool) {
+	best, bestLen := "", -1
+	for route, file := range routes {
+		path, rawQuery, _ := strings.Cut(route, "?")
+		if path != u.Path {
+			continue
+		}
+		want, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			continue
+		}
+		matches := true
+		for k := range want {
+			if u.Query().Get(k) != want.Get(k) {
+				matches = false
+			}
+		}
+		// Prefer the most specific route.
+		if matches && len(want) > bestLen {
+			best, bestLen = file, len(want)
+		}
+	}
+	return best, bestLen >= 0
+}
+
+func newTestAPI(srv *httptest.Server, cloud bool) *apiClient {
+	return &apiClient{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+		cloud:      cloud,
+	}
+}
+
+func testRepo(cloud bool) *Repo {
+	if cloud {
+		return &Repo{
+			scheme: "https", host: cloudHost, owner: "ossf-tests", slug: "scorecard-check",
+			defaultBranch: "main", commitSHA: clients.HeadSHA, cloud: true,
+		}
+	}
+	return &Repo{
+		scheme: "https", host: "bitbucket.example.com", owner: "OSSF", slug: "scorecard-check",
+		defaultBranch: "master", commitSHA: clients.HeadSHA,
+	}
+}
+
+func newTestClient(t *testing.T, srv *httptest.Server, cloud bool) *Client {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	client := newClient(context.Background(), newTestAPI(srv, cloud))
+	repo := &Repo{scheme: u.Scheme, host: u.Host, cloud: cloud}
+	if cloud {
+		repo.owner, repo.slug = "ossf-tests", "scorecard-check"
+	} else {
+		repo.owner, repo.slug = "OSSF", "scorecard-check"
+	}
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+	return client
+}
+
+func TestList_nextOnOtherHost(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{
+		cloudRepoPath + "/branch-restrictions": "testdata/cloud/next-other-host.json",
+	})
+	var seen int
+	err := newTestAPI(srv, true).list(context.Background(), cloudRepoPath+"/branch-restrictions", nil, 0,
+		func(json.RawMessage) error {
+			seen++
+			return nil
+		})
+	if !errors.Is(err, errAPIResponse) {
+		t.Errorf("list() error = %v, want %v", err, errAPIResponse)
+	}
+	if seen != 1 {
+		t.Errorf("list() saw %d values, want 1", seen)
+	}
+}
+
+func TestClient_Cloud(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{
+		cloudRepoPath: "testdata/cloud/repository.json",
+		"/ossf-tests/scorecard-check/get/main.tar.gz": "testdata/basic.tar.gz",
+	})
+	client := newTestClient(t, srv, true)
+
+	if got := client.URI(); !strings.HasSuffix(got, "/ossf-tests/scorecard-check") {
+		t.Errorf("URI() = %s", got)
+	}
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "main" {
+		t.Errorf("GetDefaultBranchName() = %s, %v, want main", branch, err)
+	}
+	createdAt, err := client.GetCreatedAt()
+	if err != nil {
+		t.Fatalf("GetCreatedAt: %v", err)
+	}
+	if want := time.Date(2021, 3, 4, 10, 11, 12, 0, time.UTC); !createdAt.Equal(want) {
+		t.Errorf("GetCreatedAt() = %v, want %v", createdAt, want)
+	}
+	archived, err := client.IsArchived()
+	if err != nil || archived {
+		t.Errorf("IsArchived() = %t, %v, want false", archived, err)
+	}
+	langs, err := client.ListProgrammingLanguages()
+	if err != nil {
+		t.Fatalf("ListProgrammingLanguages: %v", err)
+	}
+	if diff := cmp.Diff([]clients.Language{{Name: clients.Go, NumLines: 1}}, langs); diff != "" {
+		t.Errorf("ListProgrammingLanguages() mismatch (-want +got):\n%s", diff)
+	}
+
+	files, err := client.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	wantFiles := []string{"README.md", "bitbucket-pipelines.yml", "src/main.go"}
+	if diff := cmp.Diff(wantFiles, files); diff != "" {
+		t.Errorf("ListFiles() mismatch (-want +got):\n%s", diff)
+	}
+	r, err := client.GetFileReader("src/main.go")
+	if err != nil {
+		t.Fatalf("GetFileReader: %v", err)
+	}
+	r.Close()
+}
+
+func TestClient_DataCenter(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{
+		dcRepoPath:                     "testdata/datacenter/repository.json",
+		dcRepoPath + "/default-branch": "testdata/datacenter/default-branch.json",
+		dcRepoPath + "/commits":        "testdata/datacenter/commits.json",
+		dcRepoPath + "/archive?at=master&format=tgz&prefix=scorecard-check/": "testdata/basic.tar.gz",
+	})
+	client := newTestClient(t, srv, false)
+
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "master" {
+		t.Errorf("GetDefaultBranchName() = %s, %v, want master", branch, err)
+	}
+	archived, err := client.IsArchived()
+	if err != nil || !archived {
+		t.Errorf("IsArchived() = %t, %v, want true", archived, err)
+	}
+	// Data Center falls back to the first commit.
+	createdAt, err := client.GetCreatedAt()
+	if err != nil {
+		t.Fatalf("GetCreatedAt: %v", err)
+	}
+	if want := time.UnixMilli(1714651200000); !createdAt.Equal(want) {
+		t.Errorf("GetCreatedAt() = %v, want %v", createdAt, want)
+	}
+	contributors, err := client.ListContributors()
+	if err != nil {
+		t.Fatalf("ListContributors: %v", err)
+	}
+	wantContributors := []clients.User{{Login: "alice", NumContributions: 1, Companies: []string{"OSSF"}}}
+	if diff := cmp.Diff(wantContributors, contributors); diff != "" {
+		t.Errorf("ListContributors() mismatch (-want +got):\n%s", diff)
+	}
+	files, err := client.ListFiles(func(f string) (bool, error) { return strings.HasSuffix(f, ".go"), nil })
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if diff := cmp.Diff([]string{"src/main.go"}, files); diff != "" {
+		t.Errorf("ListFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_InitRepoUnreachable(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{})
+	client := newClient(context.Background(), newTestAPI(srv, true))
+	err := client.InitRepo(&Repo{host: "bitbucket.org", owner: "ossf-tests", slug: "missing", cloud: true},
+		clients.HeadSHA, 0)
+	if err == nil {
+		t.Fatal("InitRepo: expected error for missing repository")
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const prStateMerged = "MERGED"
+
+type cloudUser struct {
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+	AccountID   string `json:"account_id"`
+}
+
+func (u *cloudUser) login() string {
+	if u == nil {
+		return ""
+	}
+	if u.Nickname != "" {
+		return u.Nickname
+	}
+	return u.DisplayName
+}
+
+type cloudCommit struct {
+	Date   time.Time `json:"date"`
+	Author struct {
+		User *cloudUser `json:"user"`
+		Raw  string     `json:"raw"`
+	} `json:"author"`
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+}
+
+type cloudPullRequest struct {
+	UpdatedOn    time.Time  `json:"updated_on"`
+	Author       *cloudUser `json:"author"`
+	ClosedBy     *cloudUser `json:"closed_by"`
+	State        string     `json:"state"`
+	Participants []struct {
+		User     *cloudUser `json:"user"`
+		State    string     `json:"state"`
+		Approved bool       `json:"approved"`
+	} `json:"participants"`
+	Source struct {
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+	} `json:"source"`
+	ID int `json:"id"`
+}
+
+type dcUser struct {
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+	Slug         string `json:"slug"`
+	ID           int64  `json:"id"`
+}
+
+func (u *dcUser) login() string {
+	if u.Slug != "" {
+		return u.Slug
+	}
+	return u.Name
+}
+
+type dcCommit struct {
+	ID                 string `json:"id"`
+	Message            string `json:"message"`
+	Committer          dcUser `json:"committer"`
+	CommitterTimestamp int64  `json:"committerTimestamp"`
+}
+
+type dcPullRequest struct {
+	State  string `json:"state"`
+	Author struct {
+		User dcUser `json:"user"`
+	} `json:"author"`
+	Reviewers []struct {
+		User     dcUser `json:"user"`
+		Status   string `json:"status"`
+		Approved bool   `json:"approved"`
+	} `json:"reviewers"`
+	FromRef struct {
+		LatestCommit string `json:"latestCommit"`
+	} `json:"fromRef"`
+	ClosedDate int64 `json:"closedDate"`
+	ID         int   `json:"id"`
+}
+
+type dcActivity struct {
+	Action string `json:"action"`
+	User   dcUser `json:"user"`
+}
+
+type commitsHandler struct {
+	api         *apiClient
+	ctx         context.Context
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	commits     []clients.Commit
+	commitDepth int
+}
+
+func (handler *commitsHandler) init(ctx context.Context, repourl *Repo, commitDepth int) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.commitDepth = commitDepth
+	handler.commits = nil
+}
+
+func (handler *commitsHandler) setup() error {
+	handler.once.Do(func() {
+		if handler.repourl.cloud {
+			handler.commits, handler.errSetup = handler.listCloudCommits()
+		} else {
+			handler.commits, handler.errSetup = handler.listDCCommits()
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *commitsHandler) listCommits() ([]clients.Commit, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during commitsHandler.setup: %w", err)
+	}
+	return handler.commits, nil
+}
+
+func (handler *commitsHandler) listCloudCommits() ([]clients.Commit, error) {
+	path := fmt.Sprintf("%s/commits/%s", handler.repourl.apiPath(), url.PathEscape(handler.repourl.ref()))
+	raw, err := listInto[cloudCommit](handler.ctx, handler.api, path, nil, handler.commitDepth)
+	if err != nil {
+		return nil, fmt.Errorf("request for commits failed with %w", err)
+	}
+
+	// Several commits usually belong to the same pull request.
+	prs := make(map[int]*clients.PullRequest)
+	commits := make([]clients.Commit, 0, len(raw))
+	for i := range raw {
+		c := clients.Commit{
+			CommittedDate: raw[i].Date,
+			Message:       raw[i].Message,
+			SHA:           raw[i].Hash,
+			Committer:     clients.User{Login: raw[i].Author.User.login()},
+		}
+		pr, err := handler.cloudPullRequestFor(raw[i].Hash, prs)
+		if err != nil {
+			return nil, err
+		}
+		if pr != nil {
+			c.AssociatedMergeRequest = *pr
+		}
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+func (handler *commitsHandler) cloudPullRequestFor(sha string,
+	cache map[int]*clients.PullRequest,
+) (*clients.PullRequest, error) {
+	type prRef struct {
+		State string `json:"state"`
+		ID    int    `json:"id"`
+	}
+	path := fmt.Sprintf("%s/commit/%s/pullrequests", handler.repourl.apiPath(), sha)
+	refs, err := listInto[prRef](handler.ctx, handler.api, path, nil, 0)
+	// The commit to pull request index is built lazily and may be unavailable
+	// for the repository. Treat that as the commit having no pull request.
+	if hasStatus(err, http.StatusNotFound, http.StatusAccepted) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for pull requests of commit %s failed with %w", sha, err)
+	}
+
+	for _, ref := range refs {
+		if ref.State != prStateMerged {
+			continue
+		}
+		if pr, ok := cache[ref.ID]; ok {
+			return pr, nil
+		}
+		var raw cloudPullRequest
+		if err := handler.api.get(handler.ctx, fmt.Sprintf("%s/pullrequests/%d", handler.repourl.apiPath(), ref.ID),
+			nil, &raw); err != nil {
+			return nil, fmt.Errorf("request for pull request %d failed with %w", ref.ID, err)
+		}
+		pr := &clients.PullRequest{
+			Number:  raw.ID,
+			HeadSHA: raw.Source.Commit.Hash,
+			Author:  clients.User{Login: raw.Author.login()},
+			// Bitbucket Cloud doesn't expose a merge timestamp. A merged pull request
+			// can't be modified afterwards, so its last update is the merge.
+			MergedAt: raw.UpdatedOn,
+			MergedBy: clients.User{Login: raw.ClosedBy.login()},
+		}
+		for _, p := range raw.Participants {
+			if p.User == nil || (!p.Approved && p.State == "") {
+				continue
+			}
+			state := reviewState(p.State)
+			if p.Approved {
+				state = reviewStateApproved
+			}
+			pr.Reviews = append(pr.Reviews, clients.Review{
+				Author: &clients.User{Login: p.User.login()},
+				State:  state,
+			})
+		}
+		cache[ref.ID] = pr
+		return pr, nil
+	}
+	return nil, nil
+}
+
+func (handler *commitsHandler) listDCCommits() ([]clients.Commit, error) {
+	query := url.Values{"until": {handler.repourl.ref()}}
+	raw, err := listInto[dcCommit](handler.ctx, handler.api, handler.repourl.apiPath()+"/commits",
+		query, handler.commitDepth)
+	if err != nil {
+		return nil, fmt.Errorf("request for commits failed with %w", err)
+	}
+
+	prs := make(map[int]*clients.PullRequest)
+	commits := make([]clients.Commit, 0, len(raw))
+	for i := range raw {
+		c := clients.Commit{
+			CommittedDate: time.UnixMilli(raw[i].CommitterTimestamp),
+			Message:       raw[i].Message,
+			SHA:           raw[i].ID,
+			Committer: clients.User{
+				Login: raw[i].Committer.login(),
+				ID:    raw[i].Committer.ID,
+			},
+		}
+		pr, err := handler.dcPullRequestFor(raw[i].ID, prs)
+		if err != nil {
+			return nil, err
+		}
+		if pr != nil {
+			c.AssociatedMergeRequest = *pr
+		}
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+func (handler *commitsHandler) dcPullRequestFor(sha string,
+	cache map[int]*clients.PullRequest,
+) (*clients.PullRequest, error) {
+	path := fmt.Sprintf("%s/commits/%s/pull-requests", handler.repourl.apiPath(), sha)
+	raws, err := listInto[dcPullRequest](handler.ctx, handler.api, path, nil, 0)
+	if hasStatus(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for pull requests of commit %s failed with %w", sha, err)
+	}
+
+	for i := range raws {
+		raw := &raws[i]
+		if raw.State != prStateMerged {
+			continue
+		}
+		if pr, ok := cache[raw.ID]; ok {
+			return pr, nil
+		}
+		pr := &clients.PullRequest{
+			Number:   raw.ID,
+			HeadSHA:  raw.FromRef.LatestCommit,
+			Author:   clients.User{Login: raw.Author.User.login(), ID: raw.Author.User.ID},
+			MergedAt: time.UnixMilli(raw.ClosedDate),
+		}
+		for _, r := range raw.Reviewers {
+			state := reviewState(r.Status)
+			if r.Approved {
+				state = reviewStateApproved
+			}
+			pr.Reviews = append(pr.Reviews, clients.Review{
+				Author: &clients.User{Login: r.User.login(), ID: r.User.ID},
+				State:  state,
+			})
+		}
+
+		// The merger is only recorded in the pull request activity stream.
+		activitiesPath := fmt.Sprintf("%s/pull-requests/%d/activities", handler.repourl.apiPath(), raw.ID)
+		activities, err := listInto[dcActivity](handler.ctx, handler.api, activitiesPath, nil, maxPageSize)
+		if err != nil && !hasStatus(err, http.StatusNotFound, http.StatusForbidden) {
+			return nil, fmt.Errorf("request for pull request %d activities failed with %w", raw.ID, err)
+		}
+		for _, a := range activities {
+			if a.Action == prStateMerged {
+				pr.MergedBy = clients.User{Login: a.User.login(), ID: a.User.ID}
+				break
+			}
+		}
+		cache[raw.ID] = pr
+		return pr, nil
+	}
+	return nil, nil
+}
+
+const (
+	reviewStateApproved         = "APPROVED"
+	reviewStateChangesRequested = "CHANGES_REQUESTED"
+	reviewStateCommented        = "COMMENTED"
+)
+
+// reviewState maps Bitbucket participant states onto the GitHub review
+// states the Code-Review check understands.
+func reviewState(state string) string {
+	switch strings.ToLower(state) {
+	case "approved":
+		return reviewStateApproved
+	case "changes_requested", "needs_work":
+		return reviewStateChangesRequested
+	default:
+		return reviewStateCommented
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listCommits(t *testing.T) {
+	t.Parallel()
+	merged := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   []clients.Commit
+		cloud  bool
+	}{
+		{
+			name:  "cloud commits with and without merged pull request",
+			cloud: true,
+			routes: fakeRoutes{
+				cloudRepoPath + "/commits/main": "testdata/cloud/commits.json",
+				cloudRepoPath + "/commit/1111111111111111111111111111111111111111/pullrequests": "testdata/cloud/commit-1-pullrequests.json",
+				cloudRepoPath + "/commit/2222222222222222222222222222222222222222/pullrequests": "status:202",
+				cloudRepoPath + "/pullrequests/7":                                               "testdata/cloud/pullrequest-7.json",
+			},
+			want: []clients.Commit{
+				{
+					SHA:           "1111111111111111111111111111111111111111",
+					Message:       "Merged in feature/docs (pull request #7)\n",
+					CommittedDate: merged,
+					Committer:     clients.User{Login: "jdoe"},
+					AssociatedMergeRequest: clients.PullRequest{
+						Number:   7,
+						HeadSHA:  "3333333333333333333333333333333333333333",
+						Author:   clients.User{Login: "alice"},
+						MergedAt: merged,
+						MergedBy: clients.User{Login: "jdoe"},
+						Reviews: []clients.Review{
+							{Author: &clients.User{Login: "jdoe"}, State: "APPROVED"},
+							{Author: &clients.User{Login: "carol"}, State: "CHANGES_REQUESTED"},
+						},
+					},
+				},
+				{
+					SHA:           "2222222222222222222222222222222222222222",
+					Message:       "Direct push\n",
+					CommittedDate: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "data center commit with merged pull request",
+			routes: fakeRoutes{
+				dcRepoPath + "/commits": "testdata/datacenter/commits.json",
+				dcRepoPath + "/commits/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa/pull-requests": "testdata/datacenter/commit-pull-requests.json",
+				dcRepoPath + "/pull-requests/3/activities":                                     "testdata/datacenter/activities.json",
+			},
+			want: []clients.Commit{
+				{
+					SHA:           "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+					Message:       "Pull request #3: Add SECURITY.md",
+					CommittedDate: time.UnixMilli(1714651200000),
+					Committer:     clients.User{Login: "jdoe", ID: 1},
+					AssociatedMergeRequest: clients.PullRequest{
+						Number:   3,
+						HeadSHA:  "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+						Author:   clients.User{Login: "alice", ID: 2},
+						MergedAt: time.UnixMilli(1714651200000),
+						MergedBy: clients.User{Login: "jdoe", ID: 1},
+						Reviews: []clients.Review{
+							{Author: &clients.User{Login: "jdoe", ID: 1}, State: "APPROVED"},
+							{Author: &clients.User{Login: "carol", ID: 3}, State: "CHANGES_REQUESTED"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeBitbucket(t, tt.routes)
+			handler := &commitsHandler{api: newTestAPI(srv, tt.cloud)}
+			handler.init(context.Background(), testRepo(tt.cloud), 30)
+			got, err := handler.listCommits()
+			if err != nil {
+				t.Fatalf("listCommits: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("listCommits() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
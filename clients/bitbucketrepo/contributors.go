@@ -0,0 +1,142 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// contributorsHandler derives contributors from the commit history, as neither
+// Bitbucket Cloud nor Bitbucket Data Center have a contributors API.
+// The walk also yields the date of the first commit, which is used as the
+// creation date on Bitbucket Data Center.
+type contributorsHandler struct {
+	api                  *apiClient
+	ctx                  context.Context
+	once                 *sync.Once
+	errSetup             error
+	repourl              *Repo
+	contributors         []clients.User
+	firstCommitCreatedAt time.Time
+}
+
+func (handler *contributorsHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.contributors = nil
+	handler.firstCommitCreatedAt = time.Time{}
+}
+
+func (handler *contributorsHandler) setup() error {
+	handler.once.Do(func() {
+		contributors := make(map[string]*clients.User)
+		var order []string
+		add := func(login string, date time.Time) {
+			if !date.IsZero() && (handler.firstCommitCreatedAt.IsZero() || date.Before(handler.firstCommitCreatedAt)) {
+				handler.firstCommitCreatedAt = date
+			}
+			if login == "" {
+				return
+			}
+			if u, ok := contributors[login]; ok {
+				u.NumContributions++
+				return
+			}
+			contributors[login] = &clients.User{
+				Login:            login,
+				NumContributions: 1,
+				Companies:        []string{handler.repourl.owner},
+			}
+			order = append(order, login)
+		}
+
+		var err error
+		if handler.repourl.cloud {
+			path := fmt.Sprintf("%s/commits/%s", handler.repourl.apiPath(), url.PathEscape(handler.repourl.defaultBranch))
+			err = handler.api.list(handler.ctx, path, nil, 0, func(raw json.RawMessage) error {
+				var c cloudCommit
+				if err := json.Unmarshal(raw, &c); err != nil {
+					return fmt.Errorf("json.Unmarshal: %w", err)
+				}
+				login := c.Author.User.login()
+				if login == "" {
+					login = authorEmail(c.Author.Raw)
+				}
+				add(login, c.Date)
+				return nil
+			})
+		} else {
+			query := url.Values{"until": {handler.repourl.defaultBranch}}
+			err = handler.api.list(handler.ctx, handler.repourl.apiPath()+"/commits", query, 0,
+				func(raw json.RawMessage) error {
+					var c struct {
+						Author          dcUser `json:"author"`
+						AuthorTimestamp int64  `json:"authorTimestamp"`
+					}
+					if err := json.Unmarshal(raw, &c); err != nil {
+						return fmt.Errorf("json.Unmarshal: %w", err)
+					}
+					login := c.Author.login()
+					if login == "" {
+						login = c.Author.EmailAddress
+					}
+					add(login, time.UnixMilli(c.AuthorTimestamp))
+					return nil
+				})
+		}
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for commits failed with %w", err)
+			return
+		}
+
+		for _, login := range order {
+			handler.contributors = append(handler.contributors, *contributors[login])
+		}
+	})
+	return handler.errSetup
+}
+
+// authorEmail extracts the address from a raw "Name <email>" author string.
+func authorEmail(raw string) string {
+	addr, err := mail.ParseAddress(raw)
+	if err != nil {
+		return raw
+	}
+	return addr.Address
+}
+
+func (handler *contributorsHandler) listContributors() ([]clients.User, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during contributorsHandler.setup: %w", err)
+	}
+	return handler.contributors, nil
+}
+
+func (handler *contributorsHandler) getFirstCommitCreatedAt() (time.Time, error) {
+	if err := handler.setup(); err != nil {
+		return time.Time{}, fmt.Errorf("error during contributorsHandler.setup: %w", err)
+	}
+	return handler.firstCommitCreatedAt, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// issuesLookBack bounds the number of issues fetched, newest first.
+const issuesLookBack = 100
+
+type cloudIssue struct {
+	CreatedOn time.Time  `json:"created_on"`
+	Reporter  *cloudUser `json:"reporter"`
+	Links     struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+// issuesHandler lists issues from the Bitbucket Cloud issue tracker.
+// Bitbucket Data Center has no issue tracker (it relies on Jira), and
+// Bitbucket Cloud repositories may have it disabled, in which case
+// no issues are returned.
+type issuesHandler struct {
+	api       *apiClient
+	ctx       context.Context
+	once      *sync.Once
+	errSetup  error
+	repourl   *Repo
+	issues    []clients.Issue
+	hasIssues bool
+}
+
+func (handler *issuesHandler) init(ctx context.Context, repourl *Repo, hasIssues bool) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.issues = nil
+	handler.hasIssues = hasIssues
+}
+
+func (handler *issuesHandler) setup() error {
+	handler.once.Do(func() {
+		if !handler.repourl.cloud || !handler.hasIssues {
+			return
+		}
+		query := url.Values{"sort": {"-created_on"}}
+		raw, err := listInto[cloudIssue](handler.ctx, handler.api, handler.repourl.apiPath()+"/issues",
+			query, issuesLookBack)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for issues failed with error %w", err)
+			return
+		}
+		for i := range raw {
+			issue := clients.Issue{
+				URI:       &raw[i].Links.HTML.Href,
+				CreatedAt: &raw[i].CreatedOn,
+			}
+			if raw[i].Reporter != nil {
+				issue.Author = &clients.User{Login: raw[i].Reporter.login()}
+			}
+			handler.issues = append(handler.issues, issue)
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *issuesHandler) listIssues() ([]clients.Issue, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during issuesHandler.setup: %w", err)
+	}
+	return handler.issues, nil
+}
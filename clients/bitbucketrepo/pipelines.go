@@ -0,0 +1,166 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// pipelinesFile is the only workflow file Bitbucket Pipelines supports.
+	pipelinesFile = "bitbucket-pipelines.yml"
+	pipelinesSlug = "bitbucket-pipelines"
+	// Pipelines are matched to refs client-side, so look at enough of them
+	// to cover the commits Scorecard analyzes by default.
+	pipelinesLookBack = 100
+)
+
+type cloudPipeline struct {
+	State struct {
+		Name   string `json:"name"`
+		Result *struct {
+			Name string `json:"name"`
+		} `json:"result"`
+	} `json:"state"`
+	Target struct {
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+		RefName string `json:"ref_name"`
+	} `json:"target"`
+	BuildNumber int `json:"build_number"`
+}
+
+// pipelinesHandler maps Bitbucket Pipelines onto check runs and workflow runs.
+// Bitbucket Data Center has no built-in CI, external build results are
+// reported through build statuses instead.
+type pipelinesHandler struct {
+	api       *apiClient
+	ctx       context.Context
+	once      *sync.Once
+	errSetup  error
+	repourl   *Repo
+	pipelines []cloudPipeline
+}
+
+func (handler *pipelinesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.pipelines = nil
+}
+
+func (handler *pipelinesHandler) setup() error {
+	handler.once.Do(func() {
+		if !handler.repourl.cloud {
+			return
+		}
+		query := url.Values{"sort": {"-created_on"}}
+		pipelines, err := listInto[cloudPipeline](handler.ctx, handler.api, handler.repourl.apiPath()+"/pipelines",
+			query, pipelinesLookBack)
+		// Pipelines are not enabled for the repository.
+		if hasStatus(err, http.StatusNotFound) {
+			return
+		}
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for pipelines failed with error %w", err)
+			return
+		}
+		handler.pipelines = pipelines
+	})
+	return handler.errSetup
+}
+
+func (handler *pipelinesHandler) listCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during pipelinesHandler.setup: %w", err)
+	}
+	var checkRuns []clients.CheckRun
+	for i := range handler.pipelines {
+		p := &handler.pipelines[i]
+		if p.Target.Commit.Hash != ref && p.Target.RefName != ref {
+			continue
+		}
+		checkRuns = append(checkRuns, handler.checkRunFrom(p))
+	}
+	return checkRuns, nil
+}
+
+func (handler *pipelinesHandler) listSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	if path.Base(filename) != pipelinesFile {
+		return nil, nil
+	}
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during pipelinesHandler.setup: %w", err)
+	}
+	var runs []clients.WorkflowRun
+	for i := range handler.pipelines {
+		p := &handler.pipelines[i]
+		if p.State.Result == nil || p.State.Result.Name != "SUCCESSFUL" {
+			continue
+		}
+		sha := p.Target.Commit.Hash
+		runs = append(runs, clients.WorkflowRun{
+			HeadSHA: &sha,
+			URL:     handler.pipelineURL(p),
+		})
+	}
+	return runs, nil
+}
+
+func (handler *pipelinesHandler) pipelineURL(p *cloudPipeline) string {
+	return fmt.Sprintf("%s/pipelines/results/%d", handler.repourl.webURL(), p.BuildNumber)
+}
+
+// checkRunFrom parses the pipeline state into the GitHub check run status and conclusion.
+func (handler *pipelinesHandler) checkRunFrom(p *cloudPipeline) clients.CheckRun {
+	const completed = "completed"
+	checkRun := clients.CheckRun{
+		URL: handler.pipelineURL(p),
+		App: clients.CheckRunApp{Slug: pipelinesSlug},
+	}
+	switch p.State.Name {
+	case "PENDING":
+		checkRun.Status = "queued"
+	case "IN_PROGRESS":
+		checkRun.Status = "in_progress"
+	case "COMPLETED":
+		checkRun.Status = completed
+		if p.State.Result == nil {
+			break
+		}
+		switch p.State.Result.Name {
+		case "SUCCESSFUL":
+			checkRun.Conclusion = "success"
+		case "FAILED", "ERROR":
+			checkRun.Conclusion = "failure"
+		case "STOPPED":
+			checkRun.Conclusion = "cancelled"
+		default:
+			checkRun.Conclusion = p.State.Result.Name
+		}
+	default:
+		checkRun.Status = p.State.Name
+	}
+	return checkRun
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listCheckRunsForRef(t *testing.T) {
+	t.Parallel()
+	const pipelinesURL = "https://bitbucket.org/ossf-tests/scorecard-check/pipelines/results/"
+	tests := []struct {
+		name string
+		ref  string
+		want []clients.CheckRun
+	}{
+		{
+			name: "commit with successful and failed pipelines",
+			ref:  "3333333333333333333333333333333333333333",
+			want: []clients.CheckRun{
+				{Status: "completed", Conclusion: "success", URL: pipelinesURL + "12", App: clients.CheckRunApp{Slug: pipelinesSlug}},
+				{Status: "completed", Conclusion: "failure", URL: pipelinesURL + "11", App: clients.CheckRunApp{Slug: pipelinesSlug}},
+			},
+		},
+		{
+			name: "commit with running pipeline",
+			ref:  "6666666666666666666666666666666666666666",
+			want: []clients.CheckRun{
+				{Status: "in_progress", URL: pipelinesURL + "10", App: clients.CheckRunApp{Slug: pipelinesSlug}},
+			},
+		},
+		{
+			name: "unknown commit",
+			ref:  "7777777777777777777777777777777777777777",
+		},
+	}
+	srv := newFakeBitbucket(t, fakeRoutes{
+		cloudRepoPath + "/pipelines": "testdata/cloud/pipelines.json",
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &pipelinesHandler{api: newTestAPI(srv, true)}
+			handler.init(context.Background(), testRepo(true))
+			got, err := handler.listCheckRunsForRef(tt.ref)
+			if err != nil {
+				t.Fatalf("listCheckRunsForRef: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("listCheckRunsForRef() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_listSuccessfulWorkflowRuns(t *testing.T) {
+	t.Parallel()
+	srv := newFakeBitbucket(t, fakeRoutes{
+		cloudRepoPath + "/pipelines": "testdata/cloud/pipelines.json",
+	})
+	handler := &pipelinesHandler{api: newTestAPI(srv, true)}
+	handler.init(context.Background(), testRepo(true))
+
+	runs, err := handler.listSuccessfulWorkflowRuns(".github/workflows/ci.yml")
+	if err != nil || runs != nil {
+		t.Errorf("listSuccessfulWorkflowRuns(GitHub workflow) = %v, %v, want no runs", runs, err)
+	}
+	runs, err = handler.listSuccessfulWorkflowRuns("bitbucket-pipelines.yml")
+	if err != nil {
+		t.Fatalf("listSuccessfulWorkflowRuns: %v", err)
+	}
+	sha := "3333333333333333333333333333333333333333"
+	want := []clients.WorkflowRun{
+		{HeadSHA: &sha, URL: "https://bitbucket.org/ossf-tests/scorecard-check/pipelines/results/12"},
+	}
+	if diff := cmp.Diff(want, runs); diff != "" {
+		t.Errorf("listSuccessfulWorkflowRuns() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// cloudRepository is the subset of the Bitbucket Cloud repository resource used by Scorecard.
+type cloudRepository struct {
+	CreatedOn  time.Time `json:"created_on"`
+	MainBranch *struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+	FullName  string `json:"full_name"`
+	Language  string `json:"language"`
+	HasIssues bool   `json:"has_issues"`
+}
+
+// dcRepository is the subset of the Bitbucket Data Center repository resource used by Scorecard.
+type dcRepository struct {
+	Slug     string `json:"slug"`
+	ID       int64  `json:"id"`
+	Archived bool   `json:"archived"`
+}
+
+type dcBranch struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+	IsDefault    bool   `json:"isDefault"`
+}
+
+// projectHandler holds the repository level metadata fetched during InitRepo.
+type projectHandler struct {
+	api           *apiClient
+	repourl       *Repo
+	createdAt     time.Time
+	defaultBranch string
+	language      string
+	archived      bool
+	hasIssues     bool
+}
+
+func (handler *projectHandler) init(ctx context.Context, repourl *Repo) error {
+	handler.repourl = repourl
+	if repourl.cloud {
+		var repo cloudRepository
+		if err := handler.api.get(ctx, repourl.apiPath(), nil, &repo); err != nil {
+			return fmt.Errorf("request for repository failed with error: %w", err)
+		}
+		handler.createdAt = repo.CreatedOn
+		handler.language = repo.Language
+		handler.hasIssues = repo.HasIssues
+		if repo.MainBranch != nil {
+			handler.defaultBranch = repo.MainBranch.Name
+		}
+		return nil
+	}
+
+	var repo dcRepository
+	if err := handler.api.get(ctx, repourl.apiPath(), nil, &repo); err != nil {
+		return fmt.Errorf("request for repository failed with error: %w", err)
+	}
+	handler.archived = repo.Archived
+
+	var branch dcBranch
+	if err := handler.api.get(ctx, repourl.apiPath()+"/default-branch", nil, &branch); err != nil {
+		return fmt.Errorf("request for default branch failed with error: %w", err)
+	}
+	handler.defaultBranch = branch.DisplayID
+	return nil
+}
+
+func (handler *projectHandler) isArchived() bool {
+	// Bitbucket Cloud has no notion of archived repositories.
+	return handler.archived
+}
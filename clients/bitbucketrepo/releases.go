@@ -0,0 +1,161 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// Bitbucket has no releases, so tags are treated as releases.
+// This matches the number of releases Scorecard looks at on GitHub.
+const releaseLookBack = 30
+
+type cloudTag struct {
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+type cloudDownload struct {
+	Name  string `json:"name"`
+	Links struct {
+		Self struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+type dcTag struct {
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+}
+
+type releasesHandler struct {
+	api      *apiClient
+	ctx      context.Context
+	once     *sync.Once
+	errSetup error
+	repourl  *Repo
+	releases []clients.Release
+}
+
+func (handler *releasesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.releases = nil
+}
+
+func (handler *releasesHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: ListReleases only supported for HEAD queries", clients.ErrUnsupportedFeature)
+			return
+		}
+		if handler.repourl.cloud {
+			handler.releases, handler.errSetup = handler.listCloudReleases()
+		} else {
+			handler.releases, handler.errSetup = handler.listDCReleases()
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during releasesHandler.setup: %w", err)
+	}
+	return handler.releases, nil
+}
+
+func (handler *releasesHandler) listCloudReleases() ([]clients.Release, error) {
+	query := url.Values{"sort": {"-target.date"}}
+	tags, err := listInto[cloudTag](handler.ctx, handler.api, handler.repourl.apiPath()+"/refs/tags",
+		query, releaseLookBack)
+	if err != nil {
+		return nil, fmt.Errorf("request for tags failed with error %w", err)
+	}
+
+	downloads, err := listInto[cloudDownload](handler.ctx, handler.api, handler.repourl.apiPath()+"/downloads",
+		nil, 0)
+	if err != nil && !hasStatus(err, http.StatusNotFound, http.StatusForbidden) {
+		return nil, fmt.Errorf("request for downloads failed with error %w", err)
+	}
+
+	releases := make([]clients.Release, 0, len(tags))
+	for _, t := range tags {
+		releases = append(releases, clients.Release{
+			TagName:         t.Name,
+			URL:             t.Links.HTML.Href,
+			TargetCommitish: t.Target.Hash,
+		})
+	}
+
+	// Downloads are attached to the repository, not to a tag. Assign each
+	// download to the most specific tag named in its file name, so that
+	// "lib-v1.10.tar.gz" goes to v1.10 rather than v1.1.
+	for _, d := range downloads {
+		best := -1
+		for i := range releases {
+			if !strings.Contains(d.Name, releases[i].TagName) {
+				continue
+			}
+			if best == -1 || len(releases[i].TagName) > len(releases[best].TagName) {
+				best = i
+			}
+		}
+		if best == -1 {
+			continue
+		}
+		releases[best].Assets = append(releases[best].Assets, clients.ReleaseAsset{
+			Name: d.Name,
+			URL:  d.Links.Self.Href,
+		})
+	}
+	return releases, nil
+}
+
+func (handler *releasesHandler) listDCReleases() ([]clients.Release, error) {
+	query := url.Values{"orderBy": {"MODIFICATION"}}
+	tags, err := listInto[dcTag](handler.ctx, handler.api, handler.repourl.apiPath()+"/tags", query, releaseLookBack)
+	if err != nil {
+		return nil, fmt.Errorf("request for tags failed with error %w", err)
+	}
+
+	releases := make([]clients.Release, 0, len(tags))
+	for _, t := range tags {
+		releases = append(releases, clients.Release{
+			TagName: t.DisplayID,
+			URL: fmt.Sprintf("%s/browse?at=%s", handler.repourl.webURL(),
+				url.QueryEscape("refs/tags/"+t.DisplayID)),
+			TargetCommitish: t.LatestCommit,
+		})
+	}
+	return releases, nil
+}
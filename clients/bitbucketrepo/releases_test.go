@@ -0,0 +1,119 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_getReleases(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   []clients.Release
+		cloud  bool
+	}{
+		{
+			name:  "cloud tags with downloads",
+			cloud: true,
+			routes: fakeRoutes{
+				cloudRepoPath + "/refs/tags": "testdata/cloud/tags.json",
+				cloudRepoPath + "/downloads": "testdata/cloud/downloads.json",
+			},
+			want: []clients.Release{
+				{
+					TagName:         "v1.10",
+					URL:             "https://bitbucket.org/ossf-tests/scorecard-check/commits/tag/v1.10",
+					TargetCommitish: "4444444444444444444444444444444444444444",
+					Assets: []clients.ReleaseAsset{
+						{
+							Name: "tool-v1.10.tar.gz",
+							URL:  "https://bitbucket.org/ossf-tests/scorecard-check/downloads/tool-v1.10.tar.gz",
+						},
+						{
+							Name: "tool-v1.10.tar.gz.sig",
+							URL:  "https://bitbucket.org/ossf-tests/scorecard-check/downloads/tool-v1.10.tar.gz.sig",
+						},
+					},
+				},
+				{
+					TagName:         "v1.1",
+					URL:             "https://bitbucket.org/ossf-tests/scorecard-check/commits/tag/v1.1",
+					TargetCommitish: "5555555555555555555555555555555555555555",
+					Assets: []clients.ReleaseAsset{
+						{
+							Name: "tool-v1.1.tar.gz",
+							URL:  "https://bitbucket.org/ossf-tests/scorecard-check/downloads/tool-v1.1.tar.gz",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "cloud downloads unavailable",
+			cloud: true,
+			routes: fakeRoutes{
+				cloudRepoPath + "/refs/tags": "testdata/cloud/tags.json",
+				cloudRepoPath + "/downloads": "status:403",
+			},
+			want: []clients.Release{
+				{
+					TagName:         "v1.10",
+					URL:             "https://bitbucket.org/ossf-tests/scorecard-check/commits/tag/v1.10",
+					TargetCommitish: "4444444444444444444444444444444444444444",
+				},
+				{
+					TagName:         "v1.1",
+					URL:             "https://bitbucket.org/ossf-tests/scorecard-check/commits/tag/v1.1",
+					TargetCommitish: "5555555555555555555555555555555555555555",
+				},
+			},
+		},
+		{
+			name: "data center tags",
+			routes: fakeRoutes{
+				dcRepoPath + "/tags": "testdata/datacenter/tags.json",
+			},
+			want: []clients.Release{
+				{
+					TagName:         "v2.0.0",
+					URL:             "https://bitbucket.example.com/projects/OSSF/repos/scorecard-check/browse?at=refs%2Ftags%2Fv2.0.0",
+					TargetCommitish: "dddddddddddddddddddddddddddddddddddddddd",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeBitbucket(t, tt.routes)
+			handler := &releasesHandler{api: newTestAPI(srv, tt.cloud)}
+			handler.init(context.Background(), testRepo(tt.cloud))
+			got, err := handler.getReleases()
+			if err != nil {
+				t.Fatalf("getReleases: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getReleases() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// cloudHost is the host of Bitbucket Cloud. Any other host is assumed
+// to be a Bitbucket Data Center (formerly Bitbucket Server) instance.
+const cloudHost = "bitbucket.org"
+
+var errInvalidBitbucketRepoURL = errors.New("repo is not a bitbucket repo")
+
+// Repo identifies a Bitbucket Cloud or Bitbucket Data Center repository.
+// For Bitbucket Cloud, owner is the workspace. For Bitbucket Data Center,
+// owner is the project key.
+type Repo struct {
+	scheme        string
+	host          string
+	owner         string
+	slug          string
+	defaultBranch string
+	commitSHA     string
+	metadata      []string
+	cloud         bool
+}
+
+// Parses input string into repoURL struct
+/*
+ Accepted input string formats are as follows:
+	- "bitbucket.org/<workspace:string>/<repository:string>"
+	- "https://bitbucket.org/<workspace:string>/<repository:string>"
+	- "https://<host>[/<context>]/projects/<project:string>/repos/<repository:string>"
+	- "https://<host>[/<context>]/scm/<project:string>/<repository:string>.git"
+*/
+func (r *Repo) parse(input string) error {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("url.Parse: %v", err))
+	}
+
+	split := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if strings.EqualFold(u.Host, cloudHost) {
+		const minLen = 2
+		if len(split) < minLen {
+			return sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("%v. Expected full repository url", input))
+		}
+		r.scheme, r.host, r.owner, r.slug = u.Scheme, cloudHost, split[0], strings.TrimSuffix(split[1], ".git")
+		r.cloud = true
+		return nil
+	}
+
+	// Bitbucket Data Center may be served under a context path, which we
+	// keep as part of the host, similar to how GL_HOST is handled for GitLab.
+	for i := 0; i+2 < len(split); i++ {
+		switch {
+		case split[i] == "projects" && i+3 < len(split) && split[i+2] == "repos":
+			r.owner, r.slug = split[i+1], split[i+3]
+		case split[i] == "scm":
+			r.owner, r.slug = split[i+1], strings.TrimSuffix(split[i+2], ".git")
+		default:
+			continue
+		}
+		r.scheme = u.Scheme
+		r.host = u.Host
+		if i > 0 {
+			r.host += "/" + strings.Join(split[:i], "/")
+		}
+		return nil
+	}
+
+	return sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("%v: %v", errInvalidBitbucketRepoURL, input))
+}
+
+// Allow skipping scheme for ease-of-use, default to https.
+func withDefaultScheme(uri string) string {
+	if strings.Contains(uri, "://") {
+		return uri
+	}
+	return "https://" + uri
+}
+
+// URI implements Repo.URI().
+func (r *Repo) URI() string {
+	if r.cloud {
+		return fmt.Sprintf("%s/%s/%s", r.host, r.owner, r.slug)
+	}
+	return fmt.Sprintf("%s/projects/%s/repos/%s", r.host, r.owner, r.slug)
+}
+
+func (r *Repo) Host() string {
+	return r.host
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return fmt.Sprintf("%s-%s_%s", r.host, r.owner, r.slug)
+}
+
+// IsValid implements Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if strings.TrimSpace(r.host) == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected full repository url: "+r.URI())
+	}
+	if strings.TrimSpace(r.owner) == "" || strings.TrimSpace(r.slug) == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected full repository url: "+r.URI())
+	}
+	return nil
+}
+
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// Path() implements RepoClient.Path.
+func (r *Repo) Path() string {
+	return fmt.Sprintf("%s/%s", r.owner, r.slug)
+}
+
+// IsCloud returns whether the repository is hosted on Bitbucket Cloud,
+// as opposed to a Bitbucket Data Center instance.
+func (r *Repo) IsCloud() bool {
+	return r.cloud
+}
+
+// ref returns the revision to query: the requested commit, or the default branch for HEAD.
+func (r *Repo) ref() string {
+	if strings.EqualFold(r.commitSHA, clients.HeadSHA) || r.commitSHA == "" {
+		return r.defaultBranch
+	}
+	return r.commitSHA
+}
+
+// apiPath returns the REST path of the repository resource, relative to the API base URL.
+func (r *Repo) apiPath() string {
+	if r.cloud {
+		return fmt.Sprintf("/repositories/%s/%s", url.PathEscape(r.owner), url.PathEscape(r.slug))
+	}
+	return fmt.Sprintf("/api/1.0/projects/%s/repos/%s", url.PathEscape(r.owner), url.PathEscape(r.slug))
+}
+
+// webURL returns the browsable address of the repository.
+func (r *Repo) webURL() string {
+	return fmt.Sprintf("%s://%s", r.scheme, r.URI())
+}
+
+// MakeBitbucketRepo takes input of forms in parse and returns and implementation
+// of clients.Repo interface.
+func MakeBitbucketRepo(input string) (clients.Repo, error) {
+	var repo Repo
+	if err := repo.parse(input); err != nil {
+		return nil, fmt.Errorf("error during parse: %w", err)
+	}
+	if err := repo.IsValid(); err != nil {
+		return nil, fmt.Errorf("error in IsValid: %w", err)
+	}
+
+	return &repo, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepoURL_parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		inputURL string
+		expected Repo
+		uri      string
+		wantErr  bool
+	}{
+		{
+			name:     "cloud without scheme",
+			inputURL: "bitbucket.org/ossf-tests/scorecard-check",
+			expected: Repo{scheme: "https", host: "bitbucket.org", owner: "ossf-tests", slug: "scorecard-check", cloud: true},
+			uri:      "bitbucket.org/ossf-tests/scorecard-check",
+		},
+		{
+			name:     "cloud clone url",
+			inputURL: "https://bitbucket.org/ossf-tests/scorecard-check.git",
+			expected: Repo{scheme: "https", host: "bitbucket.org", owner: "ossf-tests", slug: "scorecard-check", cloud: true},
+			uri:      "bitbucket.org/ossf-tests/scorecard-check",
+		},
+		{
+			name:     "cloud browse url",
+			inputURL: "https://bitbucket.org/ossf-tests/scorecard-check/src/main/",
+			expected: Repo{scheme: "https", host: "bitbucket.org", owner: "ossf-tests", slug: "scorecard-check", cloud: true},
+			uri:      "bitbucket.org/ossf-tests/scorecard-check",
+		},
+		{
+			name:     "data center browse url",
+			inputURL: "https://bitbucket.example.com/projects/OSSF/repos/scorecard-check/browse",
+			expected: Repo{scheme: "https", host: "bitbucket.example.com", owner: "OSSF", slug: "scorecard-check"},
+			uri:      "bitbucket.example.com/projects/OSSF/repos/scorecard-check",
+		},
+		{
+			name:     "data center clone url with context path",
+			inputURL: "https://example.com/bitbucket/scm/ossf/scorecard-check.git",
+			expected: Repo{scheme: "https", host: "example.com/bitbucket", owner: "ossf", slug: "scorecard-check"},
+			uri:      "example.com/bitbucket/projects/ossf/repos/scorecard-check",
+		},
+		{
+			name:     "cloud workspace only",
+			inputURL: "https://bitbucket.org/ossf-tests",
+			wantErr:  true,
+		},
+		{
+			name:     "not a bitbucket url",
+			inputURL: "https://github.com/ossf/scorecard",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var r Repo
+			err := r.parse(tt.inputURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.expected, r, cmp.AllowUnexported(Repo{})); diff != "" {
+				t.Errorf("parse() mismatch (-want +got):\n%s", diff)
+			}
+			if got := r.URI(); got != tt.uri {
+				t.Errorf("URI() = %s, want %s", got, tt.uri)
+			}
+		})
+	}
+}
+
+func TestRepoURL_MakeBitbucketRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		repouri  string
+		expected bool
+	}{
+		{repouri: "bitbucket.org/ossf-tests/scorecard-check", expected: true},
+		{repouri: "https://git.example.com/projects/OSSF/repos/scorecard", expected: true},
+		{repouri: "github.com/ossf/scorecard", expected: false},
+		{repouri: "gitlab.com/gitlab-org/gitlab", expected: false},
+		{repouri: "ossf/scorecard", expected: false},
+	}
+	for _, tt := range tests {
+		r, err := MakeBitbucketRepo(tt.repouri)
+		if isBitbucket := r != nil && err == nil; isBitbucket != tt.expected {
+			t.Errorf("MakeBitbucketRepo(%s): got %t (err: %v), want %t", tt.repouri, isBitbucket, err, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// buildStatus is the shape shared by Bitbucket Cloud commit statuses
+// and Bitbucket Data Center build statuses.
+type buildStatus struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+	URL   string `json:"url"`
+}
+
+type statusesHandler struct {
+	api     *apiClient
+	ctx     context.Context
+	repourl *Repo
+}
+
+func (handler *statusesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+}
+
+func (handler *statusesHandler) listStatuses(ref string) ([]clients.Status, error) {
+	var statusPath string
+	if handler.repourl.cloud {
+		statusPath = fmt.Sprintf("%s/commit/%s/statuses", handler.repourl.apiPath(), url.PathEscape(ref))
+	} else {
+		statusPath = "/build-status/1.0/commits/" + url.PathEscape(ref)
+	}
+	raw, err := listInto[buildStatus](handler.ctx, handler.api, statusPath, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for statuses failed with error %w", err)
+	}
+
+	statuses := make([]clients.Status, 0, len(raw))
+	for _, s := range raw {
+		name := s.Name
+		if name == "" {
+			name = s.Key
+		}
+		statuses = append(statuses, clients.Status{
+			State:     statusState(s.State),
+			Context:   name,
+			URL:       s.URL,
+			TargetURL: s.URL,
+		})
+	}
+	return statuses, nil
+}
+
+// statusState maps Bitbucket build states onto GitHub commit status states.
+func statusState(state string) string {
+	switch state {
+	case "SUCCESSFUL":
+		return "success"
+	case "FAILED":
+		return "failure"
+	case "INPROGRESS":
+		return "pending"
+	case "STOPPED":
+		return "error"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listStatuses(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   []clients.Status
+		cloud  bool
+	}{
+		{
+			name:  "cloud",
+			cloud: true,
+			routes: fakeRoutes{
+				cloudRepoPath + "/commit/main/statuses": "testdata/cloud/statuses.json",
+			},
+			want: []clients.Status{
+				{
+					State:     "success",
+					Context:   "Pipeline #12 for main",
+					URL:       "https://bitbucket.org/ossf-tests/scorecard-check/pipelines/results/12",
+					TargetURL: "https://bitbucket.org/ossf-tests/scorecard-check/pipelines/results/12",
+				},
+				{
+					State:     "failure",
+					Context:   "jenkins-tests",
+					URL:       "https://ci.example.com/job/1",
+					TargetURL: "https://ci.example.com/job/1",
+				},
+			},
+		},
+		{
+			name: "data center",
+			routes: fakeRoutes{
+				"/build-status/1.0/commits/master": "testdata/datacenter/build-status.json",
+			},
+			want: []clients.Status{
+				{State: "success", Context: "Unit tests", URL: "https://ci.example.com/1", TargetURL: "https://ci.example.com/1"},
+				{State: "pending", Context: "lint", URL: "https://ci.example.com/2", TargetURL: "https://ci.example.com/2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeBitbucket(t, tt.routes)
+			handler := &statusesHandler{api: newTestAPI(srv, tt.cloud)}
+			handler.init(context.Background(), testRepo(tt.cloud))
+			got, err := handler.listStatuses(handler.repourl.ref())
+			if err != nil {
+				t.Fatalf("listStatuses: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("listStatuses() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
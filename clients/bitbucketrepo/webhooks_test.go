@@ -0,0 +1,67 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listWebhooks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   []clients.Webhook
+		cloud  bool
+	}{
+		{
+			name:   "cloud",
+			cloud:  true,
+			routes: fakeRoutes{cloudRepoPath + "/hooks": "testdata/cloud/hooks.json"},
+			want: []clients.Webhook{
+				{Path: "https://example.com/hook", UsesAuthSecret: true},
+				{Path: "https://example.com/other", UsesAuthSecret: false},
+			},
+		},
+		{
+			name:   "data center",
+			routes: fakeRoutes{dcRepoPath + "/webhooks": "testdata/datacenter/webhooks.json"},
+			want: []clients.Webhook{
+				{ID: 10, Path: "https://example.com/hook", UsesAuthSecret: true},
+				{ID: 11, Path: "https://example.com/other", UsesAuthSecret: false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeBitbucket(t, tt.routes)
+			handler := &webhookHandler{api: newTestAPI(srv, tt.cloud)}
+			handler.init(context.Background(), testRepo(tt.cloud))
+			got, err := handler.listWebhooks()
+			if err != nil {
+				t.Fatalf("listWebhooks: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("listWebhooks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
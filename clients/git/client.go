@@ -30,18 +30,16 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/plumbing/storer"
 	cp "github.com/otiai10/copy"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/git/history"
 )
 
 const repoDir = "repo*"
 
 var (
-	errNilCommitFound = errors.New("nil commit found")
-	errEmptyQuery     = errors.New("query is empty")
-	errDefaultBranch  = errors.New("default branch name could not be determined")
+	errEmptyQuery = errors.New("query is empty")
 
 	// ensure Client implements clients.RepoClient.
 	_ clients.RepoClient = (*Client)(nil)
@@ -117,40 +115,7 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 
 func (c *Client) ListCommits() ([]clients.Commit, error) {
 	c.listCommits.Do(func() {
-		commitIter, err := c.gitRepo.Log(&git.LogOptions{
-			Order: git.LogOrderCommitterTime,
-		})
-		if err != nil {
-			c.errListCommits = fmt.Errorf("git.CommitObjects: %w", err)
-			return
-		}
-		c.commits = make([]clients.Commit, 0, c.commitDepth)
-		for i := 0; i < c.commitDepth; i++ {
-			commit, err := commitIter.Next()
-			if err != nil && !errors.Is(err, io.EOF) {
-				c.errListCommits = fmt.Errorf("commitIter.Next: %w", err)
-				return
-			}
-			// No more commits.
-			if errors.Is(err, io.EOF) {
-				break
-			}
-
-			if commit == nil {
-				// Not sure in what case a nil commit is returned. Fail explicitly.
-				c.errListCommits = fmt.Errorf("%w", errNilCommitFound)
-				return
-			}
-
-			c.commits = append(c.commits, clients.Commit{
-				SHA:           commit.Hash.String(),
-				Message:       commit.Message,
-				CommittedDate: commit.Committer.When,
-				Committer: clients.User{
-					Login: commit.Committer.Email,
-				},
-			})
-		}
+		c.commits, c.errListCommits = history.ListCommits(c.gitRepo, c.commitDepth)
 	})
 	return c.commits, c.errListCommits
 }
@@ -288,44 +253,19 @@ func (c *Client) GetBranch(branch string) (*clients.BranchRef, error) {
 }
 
 func (c *Client) GetCreatedAt() (time.Time, error) {
-	// Retrieve the first commit of the repository
-	commitIter, err := c.gitRepo.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
+	createdAt, err := history.FirstCommitDate(c.gitRepo)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("git.Log: %w", err)
-	}
-	defer commitIter.Close()
-
-	// Iterate through the commits to find the first one
-	var firstCommit *object.Commit
-	err = commitIter.ForEach(func(c *object.Commit) error {
-		firstCommit = c
-		return storer.ErrStop
-	})
-	if err != nil && !errors.Is(err, storer.ErrStop) {
-		return time.Time{}, fmt.Errorf("commitIter.ForEach: %w", err)
-	}
-
-	if firstCommit == nil {
-		return time.Time{}, errNilCommitFound
+		return time.Time{}, fmt.Errorf("history.FirstCommitDate: %w", err)
 	}
-
-	// Return the commit time of the first commit
-	return firstCommit.Committer.When, nil
+	return createdAt, nil
 }
 
 func (c *Client) GetDefaultBranchName() (string, error) {
-	headRef, err := c.gitRepo.Head()
+	branch, err := history.DefaultBranchName(c.gitRepo)
 	if err != nil {
-		return "", fmt.Errorf("git.Head: %w", err)
-	}
-
-	// Extract the branch name from the Head reference
-	defaultBranch := headRef.Name()
-	if defaultBranch == "" {
-		return "", errDefaultBranch
+		return "", fmt.Errorf("history.DefaultBranchName: %w", err)
 	}
-
-	return string(defaultBranch), nil
+	return branch, nil
 }
 
 func (c *Client) GetDefaultBranch() (*clients.BranchRef, error) {
@@ -346,7 +286,11 @@ func (c *Client) ListLicenses() ([]clients.License, error) {
 }
 
 func (c *Client) ListReleases() ([]clients.Release, error) {
-	return nil, clients.ErrUnsupportedFeature
+	releases, err := history.ListReleases(c.gitRepo)
+	if err != nil {
+		return nil, fmt.Errorf("history.ListReleases: %w", err)
+	}
+	return releases, nil
 }
 
 func (c *Client) ListContributors() ([]clients.User, error) {
@@ -370,6 +314,18 @@ func (c *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
+func (c *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) ListProgrammingLanguages() ([]clients.Language, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
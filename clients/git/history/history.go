@@ -0,0 +1,235 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history derives forge-independent repository data,
+// such as commits, releases and review metadata, from a git object database.
+package history
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// releaseLookBack bounds the number of tags returned as releases,
+// matching the number of releases requested from forges.
+const releaseLookBack = 30
+
+var (
+	errNilCommitFound = errors.New("nil commit found")
+	errDefaultBranch  = errors.New("default branch name could not be determined")
+
+	// Merge commits created by GitHub and GitLab when merging a pull or merge request.
+	reGitHubMerge = regexp.MustCompile(`^Merge pull request #(\d+) from `)
+	reGitLabMerge = regexp.MustCompile(`(?m)^See merge request \S*!(\d+)\s*$`)
+	// Trailers recording who reviewed a change.
+	reReviewTrailer = regexp.MustCompile(`(?mi)^(?:Reviewed-by|Approved-by|Acked-by):\s*(.+?)\s*$`)
+	reEmail         = regexp.MustCompile(`<([^>]+)>`)
+)
+
+// ListCommits returns up to depth commits reachable from HEAD, most recent first.
+// Merge commits of pull or merge requests are associated with the request they merged,
+// with reviews taken from Reviewed-by, Approved-by and Acked-by trailers.
+func ListCommits(repo *git.Repository, depth int) ([]clients.Commit, error) {
+	commitIter, err := repo.Log(&git.LogOptions{
+		Order: git.LogOrderCommitterTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("git.Log: %w", err)
+	}
+	defer commitIter.Close()
+
+	commits := make([]clients.Commit, 0, depth)
+	for i := 0; i < depth; i++ {
+		commit, err := commitIter.Next()
+		// No more commits.
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("commitIter.Next: %w", err)
+		}
+		if commit == nil {
+			// Not sure in what case a nil commit is returned. Fail explicitly.
+			return nil, errNilCommitFound
+		}
+
+		commits = append(commits, clients.Commit{
+			SHA:                    commit.Hash.String(),
+			Message:                commit.Message,
+			CommittedDate:          commit.Committer.When,
+			Committer:              clients.User{Login: commit.Committer.Email},
+			AssociatedMergeRequest: mergeRequest(repo, commit),
+		})
+	}
+	return commits, nil
+}
+
+// mergeRequest returns the pull or merge request merged by commit, if any.
+func mergeRequest(repo *git.Repository, commit *object.Commit) clients.PullRequest {
+	if commit.NumParents() < 2 {
+		return clients.PullRequest{}
+	}
+	match := reGitHubMerge.FindStringSubmatch(commit.Message)
+	if match == nil {
+		match = reGitLabMerge.FindStringSubmatch(commit.Message)
+	}
+	if match == nil {
+		return clients.PullRequest{}
+	}
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return clients.PullRequest{}
+	}
+
+	// Forges author merge commits as the user who merged the request.
+	pr := clients.PullRequest{
+		Number:   number,
+		MergedAt: commit.Committer.When,
+		MergedBy: clients.User{Login: commit.Author.Email},
+		HeadSHA:  commit.ParentHashes[1].String(),
+		Reviews:  reviews(commit.Message),
+	}
+	// The head of the merged branch is usually authored by the author of the request.
+	if head, err := repo.CommitObject(commit.ParentHashes[1]); err == nil {
+		pr.Author = clients.User{Login: head.Author.Email}
+	}
+	return pr
+}
+
+func reviews(message string) []clients.Review {
+	var ret []clients.Review
+	for _, match := range reReviewTrailer.FindAllStringSubmatch(message, -1) {
+		login := match[1]
+		if email := reEmail.FindStringSubmatch(login); email != nil {
+			login = email[1]
+		}
+		ret = append(ret, clients.Review{
+			Author: &clients.User{Login: login},
+			State:  "APPROVED",
+		})
+	}
+	return ret
+}
+
+// FirstCommitDate returns the date of the oldest commit reachable from HEAD.
+func FirstCommitDate(repo *git.Repository) (time.Time, error) {
+	commitIter, err := repo.Log(&git.LogOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("git.Log: %w", err)
+	}
+	defer commitIter.Close()
+
+	var first time.Time
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		if first.IsZero() || c.Committer.When.Before(first) {
+			first = c.Committer.When
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("commitIter.ForEach: %w", err)
+	}
+	if first.IsZero() {
+		return time.Time{}, errNilCommitFound
+	}
+	return first, nil
+}
+
+// DefaultBranchName returns the branch checked out at HEAD. For a detached HEAD,
+// as is common in CI, the default branch of the origin remote is used instead.
+func DefaultBranchName(repo *git.Repository) (string, error) {
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return "", fmt.Errorf("git.Reference: %w", err)
+	}
+	if head.Type() == plumbing.SymbolicReference && head.Target().IsBranch() {
+		return head.Target().Short(), nil
+	}
+
+	originHead, err := repo.Reference(plumbing.NewRemoteHEADReferenceName("origin"), false)
+	if err == nil && originHead.Type() == plumbing.SymbolicReference {
+		return strings.TrimPrefix(originHead.Target().Short(), "origin/"), nil
+	}
+	return "", errDefaultBranch
+}
+
+// ListReleases returns the most recent tags as releases. Git has no notion of
+// release assets, so none are returned.
+func ListReleases(repo *git.Repository) ([]clients.Release, error) {
+	tagIter, err := repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("git.Tags: %w", err)
+	}
+	defer tagIter.Close()
+
+	type tag struct {
+		date    time.Time
+		release clients.Release
+	}
+	var tags []tag
+	err = tagIter.ForEach(func(ref *plumbing.Reference) error {
+		hash := ref.Hash()
+		date := time.Time{}
+		// Annotated tags point to a tag object, lightweight tags to the commit.
+		if tagObj, err := repo.TagObject(hash); err == nil {
+			commit, err := tagObj.Commit()
+			if err != nil {
+				// Tags of trees and blobs aren't releases.
+				return nil //nolint:nilerr
+			}
+			hash, date = commit.Hash, tagObj.Tagger.When
+		} else {
+			commit, err := repo.CommitObject(hash)
+			if err != nil {
+				return nil //nolint:nilerr
+			}
+			date = commit.Committer.When
+		}
+		tags = append(tags, tag{
+			date: date,
+			release: clients.Release{
+				TagName:         ref.Name().Short(),
+				TargetCommitish: hash.String(),
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tagIter.ForEach: %w", err)
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].date.After(tags[j].date)
+	})
+	if len(tags) > releaseLookBack {
+		tags = tags[:releaseLookBack]
+	}
+	releases := make([]clients.Release, 0, len(tags))
+	for i := range tags {
+		releases = append(releases, tags[i].release)
+	}
+	return releases, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func signature(email string, day int) *object.Signature {
+	return &object.Signature{Name: email, Email: email, When: start.AddDate(0, 0, day)}
+}
+
+type testRepo struct {
+	t    *testing.T
+	repo *git.Repository
+	wt   *git.Worktree
+	dir  string
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("git.PlainInit: %v", err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+	return &testRepo{t: t, repo: repo, wt: wt, dir: dir}
+}
+
+func (r *testRepo) commit(message string, author, committer *object.Signature, parents ...plumbing.Hash) plumbing.Hash {
+	r.t.Helper()
+	if err := os.WriteFile(filepath.Join(r.dir, "file"), []byte(message), 0o600); err != nil {
+		r.t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := r.wt.Add("file"); err != nil {
+		r.t.Fatalf("Add: %v", err)
+	}
+	opts := &git.CommitOptions{Author: author, Committer: committer}
+	if len(parents) > 0 {
+		head, err := r.repo.Head()
+		if err != nil {
+			r.t.Fatalf("Head: %v", err)
+		}
+		opts.Parents = append([]plumbing.Hash{head.Hash()}, parents...)
+	}
+	hash, err := r.wt.Commit(message, opts)
+	if err != nil {
+		r.t.Fatalf("Commit: %v", err)
+	}
+	return hash
+}
+
+func TestListCommits(t *testing.T) {
+	t.Parallel()
+	r := newTestRepo(t)
+	first := r.commit("Initial commit", signature("alice@example.com", 0), signature("alice@example.com", 0))
+	// A feature branch commit, merged through a GitHub pull request.
+	feature := r.commit("Add feature", signature("bob@example.com", 1), signature("bob@example.com", 1))
+	if err := r.wt.Checkout(&git.CheckoutOptions{Hash: first, Force: true}); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	merge := r.commit("Merge pull request #12 from bob/feature\n\nAdd feature\n\nReviewed-by: Carol <carol@example.com>\n",
+		signature("alice@example.com", 2), signature("noreply@github.com", 2), feature)
+	squash := r.commit("Fix typo (#13)", signature("bob@example.com", 3), signature("noreply@github.com", 3))
+
+	commits, err := ListCommits(r.repo, 3)
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	want := []clients.Commit{
+		{
+			SHA:           squash.String(),
+			Message:       "Fix typo (#13)",
+			CommittedDate: start.AddDate(0, 0, 3),
+			Committer:     clients.User{Login: "noreply@github.com"},
+		},
+		{
+			SHA:           merge.String(),
+			Message:       "Merge pull request #12 from bob/feature\n\nAdd feature\n\nReviewed-by: Carol <carol@example.com>\n",
+			CommittedDate: start.AddDate(0, 0, 2),
+			Committer:     clients.User{Login: "noreply@github.com"},
+			AssociatedMergeRequest: clients.PullRequest{
+				Number:   12,
+				HeadSHA:  feature.String(),
+				MergedAt: start.AddDate(0, 0, 2),
+				Author:   clients.User{Login: "bob@example.com"},
+				MergedBy: clients.User{Login: "alice@example.com"},
+				Reviews: []clients.Review{
+					{Author: &clients.User{Login: "carol@example.com"}, State: "APPROVED"},
+				},
+			},
+		},
+		{
+			SHA:           feature.String(),
+			Message:       "Add feature",
+			CommittedDate: start.AddDate(0, 0, 1),
+			Committer:     clients.User{Login: "bob@example.com"},
+		},
+	}
+	if diff := cmp.Diff(want, commits, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("ListCommits() mismatch (-want +got):\n%s", diff)
+	}
+
+	createdAt, err := FirstCommitDate(r.repo)
+	if err != nil {
+		t.Fatalf("FirstCommitDate: %v", err)
+	}
+	if !createdAt.Equal(start) {
+		t.Errorf("FirstCommitDate() = %v, want %v", createdAt, start)
+	}
+}
+
+func Test_mergeRequestNumber(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		message string
+		want    int
+	}{
+		{
+			name:    "github",
+			message: "Merge pull request #42 from org/branch\n\nTitle\n",
+			want:    42,
+		},
+		{
+			name:    "gitlab",
+			message: "Merge branch 'feature' into 'main'\n\nTitle\n\nSee merge request group/project!7\n",
+			want:    7,
+		},
+		{
+			name:    "local merge",
+			message: "Merge branch 'feature'\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestRepo(t)
+			base := r.commit("base", signature("alice@example.com", 0), signature("alice@example.com", 0))
+			side := r.commit("side", signature("bob@example.com", 1), signature("bob@example.com", 1))
+			if err := r.wt.Checkout(&git.CheckoutOptions{Hash: base, Force: true}); err != nil {
+				t.Fatalf("Checkout: %v", err)
+			}
+			merge := r.commit(tt.message, signature("alice@example.com", 2), signature("alice@example.com", 2), side)
+			commit, err := r.repo.CommitObject(merge)
+			if err != nil {
+				t.Fatalf("CommitObject: %v", err)
+			}
+			if got := mergeRequest(r.repo, commit).Number; got != tt.want {
+				t.Errorf("mergeRequest().Number = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	t.Parallel()
+	r := newTestRepo(t)
+	v1 := r.commit("v1", signature("alice@example.com", 0), signature("alice@example.com", 0))
+	if _, err := r.repo.CreateTag("v1.0.0", v1, nil); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	v2 := r.commit("v2", signature("alice@example.com", 1), signature("alice@example.com", 1))
+	if _, err := r.repo.CreateTag("v2.0.0", v2, &git.CreateTagOptions{
+		Tagger:  signature("alice@example.com", 2),
+		Message: "Release v2.0.0",
+	}); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	releases, err := ListReleases(r.repo)
+	if err != nil {
+		t.Fatalf("ListReleases: %v", err)
+	}
+	want := []clients.Release{
+		{TagName: "v2.0.0", TargetCommitish: v2.String()},
+		{TagName: "v1.0.0", TargetCommitish: v1.String()},
+	}
+	if diff := cmp.Diff(want, releases); diff != "" {
+		t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDefaultBranchName(t *testing.T) {
+	t.Parallel()
+	r := newTestRepo(t)
+	head := r.commit("Initial commit", signature("alice@example.com", 0), signature("alice@example.com", 0))
+
+	got, err := DefaultBranchName(r.repo)
+	if err != nil || got != "master" {
+		t.Errorf("DefaultBranchName() = %s, %v, want master", got, err)
+	}
+
+	// CI systems usually check out a detached HEAD.
+	if err := r.wt.Checkout(&git.CheckoutOptions{Hash: head}); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if _, err := DefaultBranchName(r.repo); err == nil {
+		t.Errorf("DefaultBranchName(): expected error for detached HEAD without remote")
+	}
+	if _, err := r.repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{"https://example.com/repo.git"}}); err != nil {
+		t.Fatalf("CreateRemote: %v", err)
+	}
+	originHead := plumbing.NewSymbolicReference(plumbing.NewRemoteHEADReferenceName("origin"),
+		plumbing.NewRemoteReferenceName("origin", "main"))
+	if err := r.repo.Storer.SetReference(originHead); err != nil {
+		t.Fatalf("SetReference: %v", err)
+	}
+	got, err = DefaultBranchName(r.repo)
+	if err != nil || got != "main" {
+		t.Errorf("DefaultBranchName() = %s, %v, want main", got, err)
+	}
+}
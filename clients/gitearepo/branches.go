@@ -0,0 +1,131 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type branchesHandler struct {
+	giteaClient   *gitea.Client
+	once          *sync.Once
+	errSetup      error
+	repourl       *Repo
+	defaultBranch *clients.BranchRef
+}
+
+func (handler *branchesHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.defaultBranch = nil
+}
+
+func (handler *branchesHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: branches only supported for HEAD queries", clients.ErrUnsupportedFeature)
+			return
+		}
+		handler.defaultBranch, handler.errSetup = handler.getBranch(handler.repourl.defaultBranch)
+	})
+	return handler.errSetup
+}
+
+func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
+	}
+	return handler.defaultBranch, nil
+}
+
+func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, error) {
+	bran, _, err := handler.giteaClient.GetRepoBranch(handler.repourl.owner, handler.repourl.repo, branch)
+	if err != nil {
+		return nil, fmt.Errorf("request for repo branch failed with error %w", err)
+	}
+	if !bran.Protected {
+		return &clients.BranchRef{
+			Name:      &bran.Name,
+			Protected: &bran.Protected,
+		}, nil
+	}
+
+	// The full protection rule is only visible to repository admins.
+	protection, resp, err := handler.giteaClient.GetBranchProtection(handler.repourl.owner, handler.repourl.repo,
+		bran.EffectiveBranchProtectionName)
+	if err != nil && resp != nil &&
+		(resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnauthorized ||
+			resp.StatusCode == http.StatusNotFound) {
+		return makeBranchRefFromBranch(bran), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for branch protection failed with error %w", err)
+	}
+	return makeBranchRefFromProtection(bran.Name, protection), nil
+}
+
+// makeBranchRefFromBranch uses the subset of the protection rule
+// that is included in the branch itself.
+func makeBranchRefFromBranch(bran *gitea.Branch) *clients.BranchRef {
+	approvals := int32(bran.RequiredApprovals)
+	return &clients.BranchRef{
+		Name:      &bran.Name,
+		Protected: &bran.Protected,
+		BranchProtectionRule: clients.BranchProtectionRule{
+			PullRequestRule: clients.PullRequestRule{
+				RequiredApprovingReviewCount: &approvals,
+			},
+			CheckRules: clients.StatusChecksRule{
+				RequiresStatusChecks: &bran.EnableStatusCheck,
+				Contexts:             bran.StatusCheckContexts,
+			},
+		},
+	}
+}
+
+func makeBranchRefFromProtection(name string, bp *gitea.BranchProtection) *clients.BranchRef {
+	protected := true
+	// Protected branches can't be deleted.
+	allowDeletions := false
+	// Unless pushing is disabled or restricted to an allowlist,
+	// anyone with write access can push without a pull request.
+	requirePullRequest := !bp.EnablePush || bp.EnablePushWhitelist
+	approvals := int32(bp.RequiredApprovals)
+	return &clients.BranchRef{
+		Name:      &name,
+		Protected: &protected,
+		BranchProtectionRule: clients.BranchProtectionRule{
+			PullRequestRule: clients.PullRequestRule{
+				Required:                     &requirePullRequest,
+				RequiredApprovingReviewCount: &approvals,
+				DismissStaleReviews:          &bp.DismissStaleApprovals,
+			},
+			AllowDeletions: &allowDeletions,
+			CheckRules: clients.StatusChecksRule{
+				UpToDateBeforeMerge:  &bp.BlockOnOutdatedBranch,
+				RequiresStatusChecks: &bp.EnableStatusCheck,
+				Contexts:             bp.StatusCheckContexts,
+			},
+		},
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitearepo implements clients.RepoClient for Gitea and Forgejo,
+// including public instances like Codeberg.
+package gitearepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+var (
+	_                clients.RepoClient = &Client{}
+	errInputRepoType                    = errors.New("input repo should be of type gitearepo.Repo")
+)
+
+type Client struct {
+	repourl      *Repo
+	repo         *gitea.Repository
+	giteaClient  *gitea.Client
+	httpClient   *http.Client
+	branches     *branchesHandler
+	commits      *commitsHandler
+	contributors *contributorsHandler
+	issues       *issuesHandler
+	languages    *languagesHandler
+	releases     *releasesHandler
+	statuses     *statusesHandler
+	webhook      *webhookHandler
+	workflows    *workflowsHandler
+	tarball      *tarballHandler
+	ctx          context.Context
+	commitDepth  int
+}
+
+// InitRepo sets up the Gitea repository in local storage for improving performance and API usage efficiency.
+func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	gtRepo, ok := inputRepo.(*Repo)
+	if !ok {
+		return fmt.Errorf("%w: %v", errInputRepoType, inputRepo)
+	}
+
+	// Sanity check.
+	repo, _, err := client.giteaClient.GetRepo(gtRepo.owner, gtRepo.repo)
+	if err != nil {
+		return sce.WithMessage(sce.ErrRepoUnreachable, gtRepo.Path()+"\t"+err.Error())
+	}
+
+	if commitDepth <= 0 {
+		client.commitDepth = 30 // default
+	} else {
+		client.commitDepth = commitDepth
+	}
+	client.repo = repo
+	client.repourl = &Repo{
+		scheme:        gtRepo.scheme,
+		host:          gtRepo.host,
+		owner:         gtRepo.owner,
+		repo:          gtRepo.repo,
+		defaultBranch: repo.DefaultBranch,
+		commitSHA:     commitSHA,
+	}
+
+	client.branches.init(client.repourl)
+	client.commits.init(client.repourl, client.commitDepth)
+	client.contributors.init(client.repourl)
+	client.issues.init(client.repourl, repo)
+	client.languages.init(client.repourl)
+	client.releases.init(client.repourl)
+	client.statuses.init(client.repourl)
+	client.webhook.init(client.repourl)
+	client.workflows.init(client.ctx, client.repourl)
+	client.tarball.init(client.repourl)
+	return nil
+}
+
+func (client *Client) URI() string {
+	return client.repourl.URI()
+}
+
+func (client *Client) IsArchived() (bool, error) {
+	return client.repo.Archived, nil
+}
+
+func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return client.tarball.listFiles(predicate)
+}
+
+func (client *Client) LocalPath() (string, error) {
+	return client.tarball.getLocalPath()
+}
+
+func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	return client.tarball.getFile(filename)
+}
+
+func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
+	return client.branches.getBranch(branch)
+}
+
+func (client *Client) GetCreatedAt() (time.Time, error) {
+	return client.repo.Created, nil
+}
+
+func (client *Client) GetDefaultBranchName() (string, error) {
+	return client.repourl.defaultBranch, nil
+}
+
+func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
+	return client.branches.getDefaultBranch()
+}
+
+// Org repository, AKA the <org>/.github repository, is a GitHub-specific feature.
+func (client *Client) GetOrgRepoClient(context.Context) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetOrgRepoClient (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListCommits() ([]clients.Commit, error) {
+	return client.commits.listCommits()
+}
+
+func (client *Client) ListIssues() ([]clients.Issue, error) {
+	return client.issues.listIssues()
+}
+
+// The Gitea API doesn't expose license detection results.
+// Thankfully, the License check falls back to file-based detection.
+func (client *Client) ListLicenses() ([]clients.License, error) {
+	return nil, fmt.Errorf("ListLicenses (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListReleases() ([]clients.Release, error) {
+	return client.releases.getReleases()
+}
+
+func (client *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	r, err := clients.DownloadReleaseAsset(client.ctx, client.httpClient, asset)
+	if err != nil {
+		return nil, fmt.Errorf("downloading release asset: %w", err)
+	}
+	return r, nil
+}
+
+func (client *Client) ListContributors() ([]clients.User, error) {
+	return client.contributors.getContributors()
+}
+
+func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	return client.workflows.listSuccessfulWorkflowRuns(filename)
+}
+
+// Gitea has no check runs. Gitea Actions reports the result of each job
+// as a commit status instead, which is returned by ListStatuses.
+func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	return []clients.CheckRun{}, nil
+}
+
+func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
+	return client.statuses.listStatuses(ref)
+}
+
+func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
+	return client.webhook.listWebhooks()
+}
+
+func (client *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
+	return client.languages.listProgrammingLanguages()
+}
+
+func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	return clients.SearchResponse{}, fmt.Errorf("Search (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	return nil, fmt.Errorf("SearchCommits (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Close() error {
+	return client.tarball.cleanup()
+}
+
+func CreateGiteaClient(ctx context.Context, host string) (clients.RepoClient, error) {
+	token := os.Getenv("GITEA_AUTH_TOKEN")
+	return CreateGiteaClientWithToken(ctx, token, host)
+}
+
+func CreateGiteaClientWithToken(ctx context.Context, token, host string) (clients.RepoClient, error) {
+	httpClient := &http.Client{Transport: clients.WrapTransport(http.DefaultTransport)}
+	return newClient(ctx, "https://"+host, token, httpClient)
+}
+
+func newClient(ctx context.Context, baseURL, token string, httpClient *http.Client) (*Client, error) {
+	options := []gitea.ClientOption{
+		gitea.SetContext(ctx),
+		gitea.SetHTTPClient(httpClient),
+		// Skip the server version request, as no endpoint used depends on it.
+		gitea.SetGiteaVersion(""),
+	}
+	if token != "" {
+		options = append(options, gitea.SetToken(token))
+	}
+	client, err := gitea.NewClient(baseURL, options...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gitea client with error: %w", err)
+	}
+
+	return &Client{
+		ctx:          ctx,
+		giteaClient:  client,
+		httpClient:   httpClient,
+		branches:     &branchesHandler{giteaClient: client},
+		commits:      &commitsHandler{giteaClient: client},
+		contributors: &contributorsHandler{giteaClient: client},
+		issues:       &issuesHandler{giteaClient: client},
+		languages:    &languagesHandler{giteaClient: client},
+		releases:     &releasesHandler{giteaClient: client},
+		statuses:     &statusesHandler{giteaClient: client},
+		webhook:      &webhookHandler{giteaClient: client},
+		workflows: &workflowsHandler{
+			httpClient: httpClient,
+			baseURL:    baseURL,
+			token:      token,
+		},
+		tarball: &tarballHandler{giteaClient: client},
+	}, nil
+}
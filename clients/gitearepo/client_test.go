@@ -0,0 +1,312 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const repoPath = "/api/v1/repos/ossf-tests/scorecard-check"
+
+// fakeRoutes maps a request path to a recorded response in testdata,
+// or to "status:<code>" to fail with the given status code.
+type fakeRoutes map[string]string
+
+func newFakeGitea(t *testing.T, routes fakeRoutes) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if code, ok := strings.CutPrefix(file, "status:"); ok {
+			status, err := strconv.Atoi(code)
+			if err != nil {
+				t.Errorf("invalid status route %s: %v", file, err)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("reading %s: %v", file, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(t *testing.T, srv *httptest.Server, commitSHA string) *Client {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	client, err := newClient(context.Background(), srv.URL, "", srv.Client())
+	if err != nil {
+		t.Fatalf("newClient: %v", err)
+	}
+	repo := &Repo{scheme: u.Scheme, host: u.Host, owner: "ossf-tests", repo: "scorecard-check"}
+	if err := client.InitRepo(repo, commitSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+	return client
+}
+
+func asPtr[T any](v T) *T {
+	return &v
+}
+
+func TestClient_InitRepo(t *testing.T) {
+	t.Parallel()
+	srv := newFakeGitea(t, fakeRoutes{repoPath: "testdata/repository.json"})
+	client := newTestClient(t, srv, clients.HeadSHA)
+
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "main" {
+		t.Errorf("GetDefaultBranchName() = %s, %v, want main", branch, err)
+	}
+	archived, err := client.IsArchived()
+	if err != nil || archived {
+		t.Errorf("IsArchived() = %t, %v, want false", archived, err)
+	}
+	createdAt, err := client.GetCreatedAt()
+	if err != nil {
+		t.Fatalf("GetCreatedAt: %v", err)
+	}
+	if want := time.Date(2023, 5, 6, 7, 8, 9, 0, time.UTC); !createdAt.Equal(want) {
+		t.Errorf("GetCreatedAt() = %v, want %v", createdAt, want)
+	}
+	if _, err := client.ListLicenses(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListLicenses: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+}
+
+func TestClient_InitRepoUnreachable(t *testing.T) {
+	t.Parallel()
+	srv := newFakeGitea(t, fakeRoutes{})
+	client, err := newClient(context.Background(), srv.URL, "", srv.Client())
+	if err != nil {
+		t.Fatalf("newClient: %v", err)
+	}
+	err = client.InitRepo(&Repo{host: "codeberg.org", owner: "ossf-tests", repo: "missing"}, clients.HeadSHA, 0)
+	if err == nil {
+		t.Fatal("InitRepo: expected error for missing repository")
+	}
+}
+
+func TestClient_GetBranch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		routes fakeRoutes
+		want   *clients.BranchRef
+		branch string
+	}{
+		{
+			name:   "unprotected branch",
+			branch: "dev",
+			routes: fakeRoutes{repoPath + "/branches/dev": "testdata/branch-dev.json"},
+			want:   &clients.BranchRef{Name: asPtr("dev"), Protected: asPtr(false)},
+		},
+		{
+			name:   "protection rule",
+			branch: "main",
+			routes: fakeRoutes{
+				repoPath + "/branches/main":           "testdata/branch-main.json",
+				repoPath + "/branch_protections/main": "testdata/branch-protection-main.json",
+			},
+			want: &clients.BranchRef{
+				Name:      asPtr("main"),
+				Protected: asPtr(true),
+				BranchProtectionRule: clients.BranchProtectionRule{
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     asPtr(true),
+						RequiredApprovingReviewCount: asPtr(int32(2)),
+						DismissStaleReviews:          asPtr(true),
+					},
+					AllowDeletions: asPtr(false),
+					CheckRules: clients.StatusChecksRule{
+						UpToDateBeforeMerge:  asPtr(true),
+						RequiresStatusChecks: asPtr(true),
+						Contexts:             []string{"ci/build", "ci/test"},
+					},
+				},
+			},
+		},
+		{
+			name:   "protection rule requires admin access",
+			branch: "main",
+			routes: fakeRoutes{
+				repoPath + "/branches/main":           "testdata/branch-main.json",
+				repoPath + "/branch_protections/main": "status:403",
+			},
+			want: &clients.BranchRef{
+				Name:      asPtr("main"),
+				Protected: asPtr(true),
+				BranchProtectionRule: clients.BranchProtectionRule{
+					PullRequestRule: clients.PullRequestRule{
+						RequiredApprovingReviewCount: asPtr(int32(1)),
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: asPtr(true),
+						Contexts:             []string{"ci/build"},
+					},
+				},
+			},
+		},
+		{
+			name:   "branch not found",
+			branch: "does-not-exist",
+			routes: fakeRoutes{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.routes[repoPath] = "testdata/repository.json"
+			client := newTestClient(t, newFakeGitea(t, tt.routes), clients.HeadSHA)
+			got, err := client.GetBranch(tt.branch)
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("GetBranch: expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetBranch: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetBranch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_GetDefaultBranch_nonHead(t *testing.T) {
+	t.Parallel()
+	srv := newFakeGitea(t, fakeRoutes{repoPath: "testdata/repository.json"})
+	client := newTestClient(t, srv, "5d9a7c3f1e2d4b6a8a6f0b1d3b7c6e0e1c2f8e4b")
+	if _, err := client.GetDefaultBranch(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Fatalf("GetDefaultBranch: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+}
+
+func TestClient_ListCommits(t *testing.T) {
+	t.Parallel()
+	srv := newFakeGitea(t, fakeRoutes{
+		repoPath:                      "testdata/repository.json",
+		repoPath + "/commits":         "testdata/commits.json",
+		repoPath + "/pulls":           "testdata/pulls-closed.json",
+		repoPath + "/pulls/7/reviews": "testdata/pull-7-reviews.json",
+	})
+	client := newTestClient(t, srv, clients.HeadSHA)
+	got, err := client.ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	want := []clients.Commit{
+		{
+			SHA:           "8a6f0b1d3b7c6e0e1c2f8e4b5d9a7c3f1e2d4b6a",
+			Message:       "Merge pull request 'Add fuzzing' (#7) from fuzz into main",
+			CommittedDate: time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC),
+			Committer:     clients.User{Login: "alice", ID: 10},
+			AssociatedMergeRequest: clients.PullRequest{
+				Number:   7,
+				HeadSHA:  "0b1d3b7c6e0e1c2f8e4b5d9a7c3f1e2d4b6a8a6f",
+				MergedAt: time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC),
+				Author:   clients.User{Login: "bob", ID: 11},
+				MergedBy: clients.User{Login: "alice", ID: 10},
+				Labels:   []clients.Label{{Name: "enhancement"}},
+				Reviews: []clients.Review{
+					{Author: &clients.User{Login: "alice", ID: 10}, State: "APPROVED"},
+					{Author: &clients.User{Login: "dave", ID: 13}, State: "COMMENTED"},
+				},
+			},
+		},
+		{
+			SHA:           "5d9a7c3f1e2d4b6a8a6f0b1d3b7c6e0e1c2f8e4b",
+			Message:       "Fix typo",
+			CommittedDate: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			Committer:     clients.User{Login: "bob", ID: 11},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListCommits() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_ListSuccessfulWorkflowRuns(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		routes   fakeRoutes
+		filename string
+		want     []clients.WorkflowRun
+	}{
+		{
+			name:     "successful runs of the workflow",
+			filename: ".forgejo/workflows/build.yml",
+			routes:   fakeRoutes{repoPath + "/actions/tasks": "testdata/actions-tasks.json"},
+			want: []clients.WorkflowRun{{
+				HeadSHA: asPtr("8a6f0b1d3b7c6e0e1c2f8e4b5d9a7c3f1e2d4b6a"),
+				URL:     "https://codeberg.org/ossf-tests/scorecard-check/actions/runs/3",
+			}},
+		},
+		{
+			name:     "actions not available",
+			filename: ".gitea/workflows/build.yml",
+			routes:   fakeRoutes{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.routes[repoPath] = "testdata/repository.json"
+			client := newTestClient(t, newFakeGitea(t, tt.routes), clients.HeadSHA)
+			got, err := client.ListSuccessfulWorkflowRuns(tt.filename)
+			if err != nil {
+				t.Fatalf("ListSuccessfulWorkflowRuns: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListSuccessfulWorkflowRuns() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
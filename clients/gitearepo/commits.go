@@ -0,0 +1,202 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// pullRequestLookBack bounds the number of recently closed pull requests
+// which are matched against the commits.
+const pullRequestLookBack = 50
+
+type commitsHandler struct {
+	giteaClient *gitea.Client
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	commits     []clients.Commit
+	commitDepth int
+}
+
+func (handler *commitsHandler) init(repourl *Repo, commitDepth int) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.commitDepth = commitDepth
+	handler.commits = nil
+}
+
+func (handler *commitsHandler) setup() error {
+	handler.once.Do(func() {
+		commits, err := handler.listRawCommits()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+		prs, err := handler.listMergedPullRequests()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+
+		reviewed := make(map[int64]*clients.PullRequest)
+		for _, c := range commits {
+			commit := clients.Commit{
+				SHA: c.SHA,
+			}
+			if c.RepoCommit != nil {
+				commit.Message = c.RepoCommit.Message
+				if c.RepoCommit.Committer != nil {
+					commit.CommittedDate, _ = time.Parse(time.RFC3339, c.RepoCommit.Committer.Date)
+				}
+			}
+			if c.Committer != nil {
+				commit.Committer = clients.User{Login: c.Committer.UserName, ID: c.Committer.ID}
+			}
+
+			if pr, ok := prs[c.SHA]; ok {
+				associated, ok := reviewed[pr.Index]
+				if !ok {
+					associated, err = handler.makePullRequest(pr)
+					if err != nil {
+						handler.errSetup = err
+						return
+					}
+					reviewed[pr.Index] = associated
+				}
+				commit.AssociatedMergeRequest = *associated
+			}
+			handler.commits = append(handler.commits, commit)
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *commitsHandler) listRawCommits() ([]*gitea.Commit, error) {
+	var commits []*gitea.Commit
+	opts := gitea.ListCommitOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: handler.commitDepth},
+		SHA:         handler.repourl.ref(),
+	}
+	for len(commits) < handler.commitDepth {
+		page, resp, err := handler.giteaClient.ListRepoCommits(handler.repourl.owner, handler.repourl.repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("request for commits failed with %w", err)
+		}
+		commits = append(commits, page...)
+		if resp == nil || resp.NextPage == 0 || len(page) == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if len(commits) > handler.commitDepth {
+		commits = commits[:handler.commitDepth]
+	}
+	return commits, nil
+}
+
+// listMergedPullRequests indexes recently merged pull requests by the commits
+// they introduce to the base branch: the merge (or squash) commit, and for
+// fast-forward and rebase merges, the head commit.
+func (handler *commitsHandler) listMergedPullRequests() (map[string]*gitea.PullRequest, error) {
+	prs := make(map[string]*gitea.PullRequest)
+	opts := gitea.ListPullRequestsOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: pullRequestLookBack},
+		State:       gitea.StateClosed,
+		Sort:        "recentupdate",
+	}
+	page, _, err := handler.giteaClient.ListRepoPullRequests(handler.repourl.owner, handler.repourl.repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("request for pull requests failed with %w", err)
+	}
+	for _, pr := range page {
+		if !pr.HasMerged {
+			continue
+		}
+		if pr.MergedCommitID != nil && *pr.MergedCommitID != "" {
+			prs[*pr.MergedCommitID] = pr
+		}
+		if pr.Head != nil && pr.Head.Sha != "" {
+			if _, ok := prs[pr.Head.Sha]; !ok {
+				prs[pr.Head.Sha] = pr
+			}
+		}
+	}
+	return prs, nil
+}
+
+func (handler *commitsHandler) makePullRequest(pr *gitea.PullRequest) (*clients.PullRequest, error) {
+	ret := clients.PullRequest{
+		Number: int(pr.Index),
+	}
+	if pr.Merged != nil {
+		ret.MergedAt = *pr.Merged
+	}
+	if pr.Head != nil {
+		ret.HeadSHA = pr.Head.Sha
+	}
+	if pr.Poster != nil {
+		ret.Author = clients.User{Login: pr.Poster.UserName, ID: pr.Poster.ID}
+	}
+	if pr.MergedBy != nil {
+		ret.MergedBy = clients.User{Login: pr.MergedBy.UserName, ID: pr.MergedBy.ID}
+	}
+	for _, l := range pr.Labels {
+		ret.Labels = append(ret.Labels, clients.Label{Name: l.Name})
+	}
+
+	reviews, _, err := handler.giteaClient.ListPullReviews(handler.repourl.owner, handler.repourl.repo, pr.Index,
+		gitea.ListPullReviewsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("request for reviews of pull request %d failed with %w", pr.Index, err)
+	}
+	for _, r := range reviews {
+		if r.Reviewer == nil || r.Dismissed {
+			continue
+		}
+		ret.Reviews = append(ret.Reviews, clients.Review{
+			Author: &clients.User{Login: r.Reviewer.UserName, ID: r.Reviewer.ID},
+			State:  reviewState(r.State),
+		})
+	}
+	return &ret, nil
+}
+
+// reviewState maps Gitea review states onto the GitHub review
+// states the Code-Review check understands.
+func reviewState(state gitea.ReviewStateType) string {
+	switch state {
+	case gitea.ReviewStateRequestChanges:
+		return "CHANGES_REQUESTED"
+	case gitea.ReviewStateComment:
+		return "COMMENTED"
+	default:
+		return string(state)
+	}
+}
+
+func (handler *commitsHandler) listCommits() ([]clients.Commit, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during commitsHandler.setup: %w", err)
+	}
+	return handler.commits, nil
+}
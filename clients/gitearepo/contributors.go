@@ -0,0 +1,126 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// contributorsLookBack bounds the number of commits walked to find contributors,
+	// as Gitea has no contributors API.
+	contributorsLookBack = 1000
+	// maxContributors is the number of top contributors returned, matching GitHub.
+	maxContributors = 100
+)
+
+type contributorsHandler struct {
+	giteaClient  *gitea.Client
+	once         *sync.Once
+	errSetup     error
+	repourl      *Repo
+	contributors []clients.User
+}
+
+func (handler *contributorsHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.contributors = nil
+}
+
+func (handler *contributorsHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: ListContributors only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+
+		contributors := make(map[string]*clients.User)
+		opts := gitea.ListCommitOptions{
+			ListOptions: gitea.ListOptions{Page: 1, PageSize: 50},
+			SHA:         handler.repourl.defaultBranch,
+		}
+		for walked := 0; walked < contributorsLookBack; {
+			commits, resp, err := handler.giteaClient.ListRepoCommits(handler.repourl.owner, handler.repourl.repo, opts)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for commits failed with %w", err)
+				return
+			}
+			for _, c := range commits {
+				// Commits by an email without a matching account have no author.
+				if c.Author == nil || c.Author.UserName == "" {
+					continue
+				}
+				if u, ok := contributors[c.Author.UserName]; ok {
+					u.NumContributions++
+					continue
+				}
+				contributors[c.Author.UserName] = &clients.User{
+					Login:            c.Author.UserName,
+					ID:               c.Author.ID,
+					NumContributions: 1,
+				}
+			}
+			walked += len(commits)
+			if resp == nil || resp.NextPage == 0 || len(commits) == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+
+		for _, u := range contributors {
+			handler.contributors = append(handler.contributors, *u)
+		}
+		sort.Slice(handler.contributors, func(i, j int) bool {
+			if handler.contributors[i].NumContributions != handler.contributors[j].NumContributions {
+				return handler.contributors[i].NumContributions > handler.contributors[j].NumContributions
+			}
+			return handler.contributors[i].Login < handler.contributors[j].Login
+		})
+		if len(handler.contributors) > maxContributors {
+			handler.contributors = handler.contributors[:maxContributors]
+		}
+
+		for i := range handler.contributors {
+			orgs, _, err := handler.giteaClient.ListUserOrgs(handler.contributors[i].Login, gitea.ListOrgsOptions{})
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for organizations of %s failed with %w",
+					handler.contributors[i].Login, err)
+				return
+			}
+			for _, org := range orgs {
+				handler.contributors[i].Organizations = append(handler.contributors[i].Organizations,
+					clients.User{Login: org.UserName})
+			}
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *contributorsHandler) getContributors() ([]clients.User, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during contributorsHandler.setup: %w", err)
+	}
+	return handler.contributors, nil
+}
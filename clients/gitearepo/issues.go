@@ -0,0 +1,169 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// issuesLookBack bounds the number of issues fetched, most recently updated first.
+	issuesLookBack = 50
+	// commentsMaxPages bounds the pages of issue comments fetched.
+	commentsMaxPages = 10
+)
+
+type issuesHandler struct {
+	giteaClient *gitea.Client
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	repo        *gitea.Repository
+	issues      []clients.Issue
+}
+
+func (handler *issuesHandler) init(repourl *Repo, repo *gitea.Repository) {
+	handler.repourl = repourl
+	handler.repo = repo
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.issues = nil
+}
+
+func (handler *issuesHandler) setup() error {
+	handler.once.Do(func() {
+		// The issue tracker may be disabled, or replaced by an external one.
+		if !handler.repo.HasIssues || handler.repo.ExternalTracker != nil {
+			return
+		}
+
+		issues, _, err := handler.giteaClient.ListRepoIssues(handler.repourl.owner, handler.repourl.repo,
+			gitea.ListIssueOption{
+				ListOptions: gitea.ListOptions{Page: 1, PageSize: issuesLookBack},
+				State:       gitea.StateAll,
+				Type:        gitea.IssueTypeIssue,
+			})
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for issues failed with %w", err)
+			return
+		}
+		if len(issues) == 0 {
+			return
+		}
+
+		associations, err := handler.listAssociations()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+
+		index := make(map[string]int, len(issues))
+		oldest := issues[0].Created
+		for i, issue := range issues {
+			index[issue.HTMLURL] = i
+			if issue.Created.Before(oldest) {
+				oldest = issue.Created
+			}
+			handler.issues = append(handler.issues, clients.Issue{
+				URI:               &issue.HTMLURL,
+				CreatedAt:         &issue.Created,
+				Author:            makeUser(issue.Poster),
+				AuthorAssociation: associationOf(issue.Poster, associations),
+			})
+		}
+
+		// Comments on the listed issues can't predate the oldest of them.
+		opts := gitea.ListIssueCommentOptions{
+			ListOptions: gitea.ListOptions{Page: 1, PageSize: issuesLookBack},
+			Since:       oldest,
+		}
+		for page := 0; page < commentsMaxPages; page++ {
+			comments, resp, err := handler.giteaClient.ListRepoIssueComments(handler.repourl.owner,
+				handler.repourl.repo, opts)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for issue comments failed with %w", err)
+				return
+			}
+			for _, c := range comments {
+				i, ok := index[c.IssueURL]
+				if !ok {
+					continue
+				}
+				handler.issues[i].Comments = append(handler.issues[i].Comments, clients.IssueComment{
+					CreatedAt:         &c.Created,
+					Author:            makeUser(c.Poster),
+					AuthorAssociation: associationOf(c.Poster, associations),
+				})
+			}
+			if resp == nil || resp.NextPage == 0 || len(comments) == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	})
+	return handler.errSetup
+}
+
+// listAssociations returns the association of the repository owner and its collaborators.
+// Collaborators are only visible with push access, so they are skipped otherwise.
+func (handler *issuesHandler) listAssociations() (map[string]clients.RepoAssociation, error) {
+	associations := make(map[string]clients.RepoAssociation)
+	collaborators, resp, err := handler.giteaClient.ListCollaborators(handler.repourl.owner, handler.repourl.repo,
+		gitea.ListCollaboratorsOptions{})
+	switch {
+	case err != nil && resp != nil &&
+		(resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnauthorized):
+	case err != nil:
+		return nil, fmt.Errorf("request for collaborators failed with %w", err)
+	default:
+		for _, c := range collaborators {
+			associations[c.UserName] = clients.RepoAssociationCollaborator
+		}
+	}
+	if handler.repo.Owner != nil {
+		associations[handler.repo.Owner.UserName] = clients.RepoAssociationOwner
+	}
+	return associations, nil
+}
+
+func associationOf(user *gitea.User, associations map[string]clients.RepoAssociation) *clients.RepoAssociation {
+	association := clients.RepoAssociationNone
+	if user != nil {
+		if a, ok := associations[user.UserName]; ok {
+			association = a
+		}
+	}
+	return &association
+}
+
+func makeUser(user *gitea.User) *clients.User {
+	if user == nil {
+		return nil
+	}
+	return &clients.User{Login: user.UserName, ID: user.ID}
+}
+
+func (handler *issuesHandler) listIssues() ([]clients.Issue, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during issuesHandler.setup: %w", err)
+	}
+	return handler.issues, nil
+}
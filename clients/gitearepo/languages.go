@@ -0,0 +1,65 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type languagesHandler struct {
+	giteaClient *gitea.Client
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	languages   []clients.Language
+}
+
+func (handler *languagesHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.languages = nil
+}
+
+func (handler *languagesHandler) setup() error {
+	handler.once.Do(func() {
+		languageMap, _, err := handler.giteaClient.GetRepoLanguages(handler.repourl.owner, handler.repourl.repo)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for repo languages failed with %w", err)
+			return
+		}
+		// Like GitHub, Gitea reports the number of bytes per language.
+		for name, size := range languageMap {
+			handler.languages = append(handler.languages, clients.Language{
+				Name:     clients.LanguageName(strings.ToLower(name)),
+				NumLines: int(size),
+			})
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *languagesHandler) listProgrammingLanguages() ([]clients.Language, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during languagesHandler.setup: %w", err)
+	}
+	return handler.languages, nil
+}
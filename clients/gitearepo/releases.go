@@ -0,0 +1,86 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// releaseLookBack is the number of most recent releases fetched.
+const releaseLookBack = 30
+
+type releasesHandler struct {
+	giteaClient *gitea.Client
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	releases    []clients.Release
+}
+
+func (handler *releasesHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.releases = nil
+}
+
+func (handler *releasesHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: ListReleases only supported for HEAD queries", clients.ErrUnsupportedFeature)
+			return
+		}
+		isDraft := false
+		releases, _, err := handler.giteaClient.ListReleases(handler.repourl.owner, handler.repourl.repo,
+			gitea.ListReleasesOptions{
+				ListOptions: gitea.ListOptions{Page: 1, PageSize: releaseLookBack},
+				IsDraft:     &isDraft,
+			})
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for releases failed with %w", err)
+			return
+		}
+		for _, r := range releases {
+			release := clients.Release{
+				PublishedAt:     r.PublishedAt,
+				TagName:         r.TagName,
+				URL:             r.HTMLURL,
+				TargetCommitish: r.Target,
+			}
+			for _, a := range r.Attachments {
+				release.Assets = append(release.Assets, clients.ReleaseAsset{
+					Name:        a.Name,
+					URL:         a.DownloadURL,
+					DownloadURL: a.DownloadURL,
+				})
+			}
+			handler.releases = append(handler.releases, release)
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during releasesHandler.setup: %w", err)
+	}
+	return handler.releases, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// knownHosts are public Gitea and Forgejo instances, which are accepted
+// without probing their API.
+var knownHosts = []string{"codeberg.org", "gitea.com"}
+
+// otherForges are hosts which are known not to run Gitea or Forgejo.
+var otherForges = []string{"github.com", "gitlab.com", "bitbucket.org", "dev.azure.com"}
+
+var errInvalidGiteaRepoURL = errors.New("repo is not a gitea repo")
+
+type Repo struct {
+	scheme        string
+	host          string
+	owner         string
+	repo          string
+	defaultBranch string
+	commitSHA     string
+	metadata      []string
+}
+
+// Parses input string into repoURL struct
+/*
+ Accepted input string formats are as follows:
+	- "codeberg.org/<owner:string>/<repo:string>"
+	- "https://<host>/<owner:string>/<repo:string>[.git]"
+	- "https://<host>/<owner:string>/<repo:string>/src/branch/main"
+
+ If GITEA_HOST contains a path, e.g. "foo.com/gitea", the path is treated as part of the host.
+*/
+func (r *Repo) parse(input string) error {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("url.Parse: %v", err))
+	}
+
+	// Similar to GL_HOST for GitLab, Gitea may be served under a sub-path.
+	if h := os.Getenv("GITEA_HOST"); h != "" {
+		hostURL, err := url.Parse(withDefaultScheme(h))
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("url.Parse GITEA_HOST: %v", err))
+		}
+		if hostURL.Host == u.Host {
+			u.Host = hostURL.Host + strings.TrimRight(hostURL.Path, "/")
+			u.Path = strings.TrimPrefix(u.Path, strings.TrimRight(hostURL.Path, "/"))
+		}
+	}
+
+	const minLen = 2
+	split := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(split) < minLen || split[0] == "" || split[1] == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("%v. Expected full repository url", input))
+	}
+
+	r.scheme, r.host, r.owner, r.repo = u.Scheme, u.Host, split[0], strings.TrimSuffix(split[1], ".git")
+	return nil
+}
+
+// Allow skipping scheme for ease-of-use, default to https.
+func withDefaultScheme(uri string) string {
+	if strings.Contains(uri, "://") {
+		return uri
+	}
+	return "https://" + uri
+}
+
+// URI implements Repo.URI().
+func (r *Repo) URI() string {
+	return fmt.Sprintf("%s/%s/%s", r.host, r.owner, r.repo)
+}
+
+func (r *Repo) Host() string {
+	return r.host
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return fmt.Sprintf("%s-%s_%s", r.host, r.owner, r.repo)
+}
+
+// IsValid implements Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if strings.TrimSpace(r.owner) == "" || strings.TrimSpace(r.repo) == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected full repository url: "+r.URI())
+	}
+
+	for _, host := range knownHosts {
+		if strings.EqualFold(r.host, host) {
+			return nil
+		}
+	}
+	if h := os.Getenv("GITEA_HOST"); h != "" {
+		if hostURL, err := url.Parse(withDefaultScheme(h)); err == nil &&
+			strings.EqualFold(r.host, hostURL.Host+strings.TrimRight(hostURL.Path, "/")) {
+			return nil
+		}
+	}
+	for _, host := range otherForges {
+		if strings.EqualFold(r.host, host) {
+			return fmt.Errorf("%w: %s", errInvalidGiteaRepoURL, r.host)
+		}
+	}
+
+	// The version endpoint is served without authentication by both Gitea and Forgejo.
+	baseURL := fmt.Sprintf("%s://%s", r.scheme, r.host)
+	client, err := gitea.NewClient(baseURL, gitea.SetGiteaVersion(""))
+	if err != nil {
+		return sce.WithMessage(err, fmt.Sprintf("couldn't create gitea client for %s", r.host))
+	}
+	_, resp, err := client.ServerVersion()
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		return sce.WithMessage(sce.ErrRepoUnreachable,
+			fmt.Sprintf("couldn't reach gitea instance at %s: %v", r.host, err),
+		)
+	}
+	if err != nil {
+		return sce.WithMessage(err, fmt.Sprintf("error when connecting to gitea instance at %s", r.host))
+	}
+	return nil
+}
+
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// Path() implements RepoClient.Path.
+func (r *Repo) Path() string {
+	return fmt.Sprintf("%s/%s", r.owner, r.repo)
+}
+
+// ref returns the revision to query: the requested commit, or the default branch for HEAD.
+func (r *Repo) ref() string {
+	if strings.EqualFold(r.commitSHA, clients.HeadSHA) || r.commitSHA == "" {
+		return r.defaultBranch
+	}
+	return r.commitSHA
+}
+
+// MakeGiteaRepo takes input of forms in parse and returns and implementation
+// of clients.Repo interface.
+func MakeGiteaRepo(input string) (clients.Repo, error) {
+	var repo Repo
+	if err := repo.parse(input); err != nil {
+		return nil, fmt.Errorf("error during parse: %w", err)
+	}
+	if err := repo.IsValid(); err != nil {
+		return nil, fmt.Errorf("error in IsValid: %w", err)
+	}
+
+	return &repo, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepoURL_parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputURL  string
+		giteaHost string
+		expected  Repo
+		wantErr   bool
+	}{
+		{
+			name:     "without scheme",
+			inputURL: "codeberg.org/forgejo/forgejo",
+			expected: Repo{scheme: "https", host: "codeberg.org", owner: "forgejo", repo: "forgejo"},
+		},
+		{
+			name:     "clone url",
+			inputURL: "https://gitea.com/gitea/tea.git",
+			expected: Repo{scheme: "https", host: "gitea.com", owner: "gitea", repo: "tea"},
+		},
+		{
+			name:     "browse url",
+			inputURL: "https://codeberg.org/forgejo/forgejo/src/branch/forgejo",
+			expected: Repo{scheme: "https", host: "codeberg.org", owner: "forgejo", repo: "forgejo"},
+		},
+		{
+			name:     "http instance",
+			inputURL: "http://localhost:3000/ossf/scorecard",
+			expected: Repo{scheme: "http", host: "localhost:3000", owner: "ossf", repo: "scorecard"},
+		},
+		{
+			name:      "instance served under a sub-path",
+			inputURL:  "https://example.com/gitea/ossf/scorecard",
+			giteaHost: "example.com/gitea",
+			expected:  Repo{scheme: "https", host: "example.com/gitea", owner: "ossf", repo: "scorecard"},
+		},
+		{
+			name:     "owner only",
+			inputURL: "https://codeberg.org/forgejo",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITEA_HOST", tt.giteaHost)
+			var r Repo
+			err := r.parse(tt.inputURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.expected, r, cmp.AllowUnexported(Repo{})); diff != "" {
+				t.Errorf("parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepoURL_MakeGiteaRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		repouri  string
+		expected bool
+	}{
+		{repouri: "codeberg.org/forgejo/forgejo", expected: true},
+		{repouri: "https://gitea.com/gitea/tea", expected: true},
+		{repouri: "github.com/ossf/scorecard", expected: false},
+		{repouri: "gitlab.com/gitlab-org/gitlab", expected: false},
+		{repouri: "bitbucket.org/ossf-tests/scorecard-check", expected: false},
+		{repouri: "ossf/scorecard", expected: false},
+	}
+	for _, tt := range tests {
+		r, err := MakeGiteaRepo(tt.repouri)
+		if isGitea := r != nil && err == nil; isGitea != tt.expected {
+			t.Errorf("MakeGiteaRepo(%s): got %t (err: %v), want %t", tt.repouri, isGitea, err, tt.expected)
+		}
+	}
+}
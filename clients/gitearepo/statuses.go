@@ -0,0 +1,50 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type statusesHandler struct {
+	giteaClient *gitea.Client
+	repourl     *Repo
+}
+
+func (handler *statusesHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+}
+
+func (handler *statusesHandler) listStatuses(ref string) ([]clients.Status, error) {
+	statuses, _, err := handler.giteaClient.ListStatuses(handler.repourl.owner, handler.repourl.repo, ref,
+		gitea.ListStatusesOption{})
+	if err != nil {
+		return nil, fmt.Errorf("request for statuses failed with %w", err)
+	}
+	ret := make([]clients.Status, 0, len(statuses))
+	for _, s := range statuses {
+		ret = append(ret, clients.Status{
+			State:     string(s.State),
+			Context:   s.Context,
+			URL:       s.URL,
+			TargetURL: s.TargetURL,
+		})
+	}
+	return ret, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type webhookHandler struct {
+	giteaClient *gitea.Client
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	webhooks    []clients.Webhook
+}
+
+func (handler *webhookHandler) init(repourl *Repo) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.webhooks = nil
+}
+
+func (handler *webhookHandler) setup() error {
+	handler.once.Do(func() {
+		hooks, _, err := handler.giteaClient.ListRepoHooks(handler.repourl.owner, handler.repourl.repo,
+			gitea.ListHooksOptions{})
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for webhooks failed with %w", err)
+			return
+		}
+		for _, h := range hooks {
+			// Gitea doesn't return webhook secrets, but it does return the
+			// authorization header, which also authenticates deliveries.
+			handler.webhooks = append(handler.webhooks, clients.Webhook{
+				ID:             h.ID,
+				Path:           h.Config["url"],
+				UsesAuthSecret: h.AuthorizationHeader != "" || h.Config["secret"] != "",
+			})
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
+	}
+	return handler.webhooks, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// workflowRunsLookBack is the number of most recent Actions tasks fetched.
+const workflowRunsLookBack = 50
+
+// actionTask is a run of a job of a Gitea Actions (or Forgejo Actions) workflow.
+type actionTask struct {
+	HeadSHA    string `json:"head_sha"`
+	Status     string `json:"status"`
+	WorkflowID string `json:"workflow_id"`
+	URL        string `json:"url"`
+}
+
+// workflowsHandler lists Actions tasks. The endpoint was added in Gitea 1.22
+// and isn't part of the Gitea SDK yet, so it's requested directly.
+type workflowsHandler struct {
+	httpClient *http.Client
+	once       *sync.Once
+	errSetup   error
+	ctx        context.Context
+	repourl    *Repo
+	baseURL    string
+	token      string
+	tasks      []actionTask
+}
+
+func (handler *workflowsHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.tasks = nil
+}
+
+func (handler *workflowsHandler) setup() error {
+	handler.once.Do(func() {
+		u := fmt.Sprintf("%s/api/v1/repos/%s/%s/actions/tasks?limit=%d", handler.baseURL,
+			url.PathEscape(handler.repourl.owner), url.PathEscape(handler.repourl.repo), workflowRunsLookBack)
+		req, err := http.NewRequestWithContext(handler.ctx, http.MethodGet, u, nil)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("http.NewRequestWithContext: %w", err)
+			return
+		}
+		if handler.token != "" {
+			req.Header.Set("Authorization", "token "+handler.token)
+		}
+		resp, err := handler.httpClient.Do(req)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for actions tasks failed with %w", err)
+			return
+		}
+		defer resp.Body.Close()
+		// Older instances, and repositories with Actions disabled, have no tasks.
+		if resp.StatusCode == http.StatusNotFound {
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			handler.errSetup = fmt.Errorf("request for actions tasks failed with status %s", resp.Status)
+			return
+		}
+		var tasks struct {
+			WorkflowRuns []actionTask `json:"workflow_runs"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&tasks); err != nil {
+			handler.errSetup = fmt.Errorf("json.Decode: %w", err)
+			return
+		}
+		handler.tasks = tasks.WorkflowRuns
+	})
+	return handler.errSetup
+}
+
+func (handler *workflowsHandler) listSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during workflowsHandler.setup: %w", err)
+	}
+	var runs []clients.WorkflowRun
+	for i := range handler.tasks {
+		task := &handler.tasks[i]
+		if task.WorkflowID != path.Base(filename) || task.Status != "success" {
+			continue
+		}
+		runs = append(runs, clients.WorkflowRun{
+			HeadSHA: &task.HeadSHA,
+			URL:     task.URL,
+		})
+	}
+	return runs, nil
+}
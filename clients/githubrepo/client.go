@@ -58,6 +58,7 @@ type Client struct {
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	webhook       *webhookHandler
+	codeScanning  *codeScanningHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
 	git           *gitfile.Handler
@@ -151,6 +152,9 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Setup webhookHandler.
 	client.webhook.init(client.ctx, client.repourl)
 
+	// Setup codeScanningHandler.
+	client.codeScanning.init(client.ctx, client.repourl)
+
 	// Setup languagesHandler.
 	client.languages.init(client.ctx, client.repourl)
 
@@ -221,6 +225,11 @@ func (client *Client) ListReleases() ([]clients.Release, error) {
 	return client.releases.getReleases()
 }
 
+// GetReleaseAssetReader implements RepoClient.GetReleaseAssetReader.
+func (client *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return client.releases.getAssetReader(asset)
+}
+
 // ListContributors implements RepoClient.ListContributors.
 func (client *Client) ListContributors() ([]clients.User, error) {
 	return client.contributors.getContributors()
@@ -281,6 +290,24 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+// GetCodeScanningDefaultSetup implements RepoClient.GetCodeScanningDefaultSetup.
+func (client *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return client.codeScanning.getDefaultSetup()
+}
+
+// GetSecretScanningSettings implements RepoClient.GetSecretScanningSettings.
+func (client *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	// The settings are only returned to tokens with admin access to the repository.
+	analysis := client.repo.GetSecurityAndAnalysis()
+	if analysis == nil {
+		return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+	}
+	return clients.SecretScanningSettings{
+		Enabled:               analysis.GetSecretScanning().GetStatus() == "enabled",
+		PushProtectionEnabled: analysis.GetSecretScanningPushProtection().GetStatus() == "enabled",
+	}, nil
+}
+
 // ListSuccessfulWorkflowRuns implements RepoClient.WorkflowRunsByFilename.
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
@@ -409,6 +436,9 @@ func NewRepoClient(ctx context.Context, opts ...Option) (clients.RepoClient, err
 		webhook: &webhookHandler{
 			ghClient: client,
 		},
+		codeScanning: &codeScanningHandler{
+			ghClient: client,
+		},
 		languages: &languagesHandler{
 			ghclient: client,
 		},
@@ -0,0 +1,83 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type codeScanningHandler struct {
+	ghClient     *github.Client
+	once         *sync.Once
+	ctx          context.Context
+	errSetup     error
+	repourl      *Repo
+	defaultSetup clients.CodeScanningDefaultSetup
+}
+
+func (handler *codeScanningHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.defaultSetup = clients.CodeScanningDefaultSetup{}
+}
+
+func (handler *codeScanningHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: GetCodeScanningDefaultSetup only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+		// defined at docs.github.com/en/rest/code-scanning/code-scanning#get-a-code-scanning-default-setup-configuration
+		config, _, err := handler.ghClient.CodeScanning.GetDefaultSetupConfiguration(
+			handler.ctx, handler.repourl.owner, handler.repourl.repo)
+		if err != nil {
+			// The endpoint requires the security_events scope, or code scanning to be available
+			// for the repository, e.g. GitHub Advanced Security for private repositories.
+			var errResp *github.ErrorResponse
+			if errors.As(err, &errResp) && errResp.Response != nil &&
+				(errResp.Response.StatusCode == http.StatusForbidden || errResp.Response.StatusCode == http.StatusNotFound) {
+				handler.errSetup = fmt.Errorf("%w: %v", clients.ErrUnsupportedFeature, err)
+				return
+			}
+			handler.errSetup = fmt.Errorf("error during GetDefaultSetupConfiguration: %w", err)
+			return
+		}
+		handler.defaultSetup = clients.CodeScanningDefaultSetup{
+			Enabled:   config.GetState() == "configured",
+			Languages: config.Languages,
+		}
+		handler.errSetup = nil
+	})
+	return handler.errSetup
+}
+
+func (handler *codeScanningHandler) getDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	if err := handler.setup(); err != nil {
+		return clients.CodeScanningDefaultSetup{}, fmt.Errorf("error during codeScanningHandler.setup: %w", err)
+	}
+	return handler.defaultSetup, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_getDefaultSetup(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		responsePath string
+		want         clients.CodeScanningDefaultSetup
+		wantErr      bool
+	}{
+		{
+			name:         "configured",
+			responsePath: "./testdata/code-scanning-default-setup-configured.json",
+			want: clients.CodeScanningDefaultSetup{
+				Enabled:   true,
+				Languages: []string{"go", "javascript-typescript"},
+			},
+		},
+		{
+			name:         "not configured",
+			responsePath: "./testdata/code-scanning-default-setup-not-configured.json",
+			want: clients.CodeScanningDefaultSetup{
+				Languages: []string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			handler := &codeScanningHandler{
+				ghClient: github.NewClient(httpClient),
+			}
+
+			repoURL := Repo{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: clients.HeadSHA,
+			}
+			handler.init(ctx, &repoURL)
+			got, err := handler.getDefaultSetup()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getDefaultSetup error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getDefaultSetup() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
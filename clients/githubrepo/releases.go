@@ -17,6 +17,7 @@ package githubrepo
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
@@ -66,18 +67,28 @@ func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
 	return handler.releases, nil
 }
 
+func (handler *releasesHandler) getAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	r, err := clients.DownloadReleaseAsset(handler.ctx, handler.client.Client(), asset)
+	if err != nil {
+		return nil, fmt.Errorf("downloading release asset: %w", err)
+	}
+	return r, nil
+}
+
 func releasesFrom(data []*github.RepositoryRelease) []clients.Release {
 	var releases []clients.Release
 	for _, r := range data {
 		release := clients.Release{
+			PublishedAt:     r.GetPublishedAt().Time,
 			TagName:         r.GetTagName(),
 			URL:             r.GetURL(),
 			TargetCommitish: r.GetTargetCommitish(),
 		}
 		for _, a := range r.Assets {
 			release.Assets = append(release.Assets, clients.ReleaseAsset{
-				Name: a.GetName(),
-				URL:  r.GetHTMLURL(),
+				Name:        a.GetName(),
+				URL:         r.GetHTMLURL(),
+				DownloadURL: a.GetBrowserDownloadURL(),
 			})
 		}
 		releases = append(releases, release)
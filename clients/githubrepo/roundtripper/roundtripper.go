@@ -24,6 +24,7 @@ import (
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 
+	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper/tokens"
 	"github.com/ossf/scorecard/v5/log"
 )
@@ -41,7 +42,8 @@ var errGithubCredentials = errors.New("an error occurred while getting GitHub cr
 
 // NewTransport returns a configured http.Transport for use with GitHub.
 func NewTransport(ctx context.Context, logger *log.Logger) http.RoundTripper {
-	transport := http.DefaultTransport
+	// The cache sits below the credentials so entries are scoped to the token in use.
+	transport := clients.WrapTransport(http.DefaultTransport)
 
 	//nolint:nestif
 	if tokenAccessor := tokens.MakeTokenAccessor(); tokenAccessor != nil {
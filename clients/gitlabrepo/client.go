@@ -21,7 +21,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -31,10 +34,14 @@ import (
 )
 
 var (
-	_                clients.RepoClient = &Client{}
-	errInputRepoType                    = errors.New("input repo should be of type repoURL")
+	_                  clients.RepoClient = &Client{}
+	errInputRepoType                      = errors.New("input repo should be of type repoURL")
+	errReleaseAssetURL                    = errors.New("release asset isn't on the GitLab host of the project")
 )
 
+// maxReleaseAssetRedirects is how many redirects are followed to download a release asset.
+const maxReleaseAssetRedirects = 10
+
 type Client struct {
 	repourl       *Repo
 	repo          *gitlab.Project
@@ -212,6 +219,45 @@ func (client *Client) ListReleases() ([]clients.Release, error) {
 	return client.releases.getReleases()
 }
 
+// GetReleaseAssetReader downloads release assets from the GitLab instance of the
+// project, which hosts its uploads and package registry. The links of a release can
+// point anywhere, including internal services, so other locations aren't followed.
+func (client *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	if asset.DownloadURL != "" {
+		u, err := url.Parse(asset.DownloadURL)
+		if err != nil {
+			return nil, fmt.Errorf("url.Parse: %w", err)
+		}
+		if err := client.checkReleaseAssetURL(u); err != nil {
+			return nil, err
+		}
+	}
+	httpClient := &http.Client{
+		Transport: clients.WrapTransport(http.DefaultTransport),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxReleaseAssetRedirects {
+				return fmt.Errorf("%w: too many redirects", errReleaseAssetURL)
+			}
+			return client.checkReleaseAssetURL(req.URL)
+		},
+	}
+	r, err := clients.DownloadReleaseAsset(client.ctx, httpClient, asset)
+	if err != nil {
+		return nil, fmt.Errorf("downloading release asset: %w", err)
+	}
+	return r, nil
+}
+
+// checkReleaseAssetURL returns an error unless u is an https URL of the GitLab host
+// of the project.
+func (client *Client) checkReleaseAssetURL(u *url.URL) error {
+	host, _, _ := strings.Cut(client.repourl.host, "/")
+	if u.Scheme != "https" || !strings.EqualFold(u.Host, host) {
+		return fmt.Errorf("%w: %s", errReleaseAssetURL, u.Redacted())
+	}
+	return nil
+}
+
 func (client *Client) ListContributors() ([]clients.User, error) {
 	return client.contributors.getContributors()
 }
@@ -244,6 +290,14 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+func (client *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
 }
@@ -284,7 +338,13 @@ func CreateGitlabClient(ctx context.Context, host string) (clients.RepoClient, e
 
 func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clients.RepoClient, error) {
 	url := "https://" + host
-	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(url))
+	options := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(url)}
+	if clients.TransportWrapped() {
+		options = append(options, gitlab.WithHTTPClient(&http.Client{
+			Transport: clients.WrapTransport(http.DefaultTransport),
+		}))
+	}
+	client, err := gitlab.NewClient(token, options...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create gitlab client with error: %w", err)
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -75,6 +76,54 @@ func TestCheckRepoInaccessible(t *testing.T) {
 	}
 }
 
+func TestGetReleaseAssetReader_hosts(t *testing.T) {
+	t.Parallel()
+	client := &Client{
+		repourl: &Repo{scheme: "https", host: "gitlab.example.com", owner: "owner", project: "project"},
+		ctx:     context.Background(),
+	}
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "http", url: "http://gitlab.example.com/owner/project/-/releases/v1.0.0/downloads/tool.tar.gz"},
+		{name: "another host", url: "https://downloads.example.com/tool.tar.gz"},
+		{name: "host suffix", url: "https://gitlab.example.com.attacker.test/tool.tar.gz"},
+		{name: "link-local address", url: "https://169.254.169.254/latest/meta-data/"},
+		{name: "loopback address", url: "https://127.0.0.1:8080/tool.tar.gz"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := client.GetReleaseAssetReader(&clients.ReleaseAsset{Name: "tool.tar.gz", DownloadURL: tt.url})
+			if r != nil {
+				r.Close()
+			}
+			if !errors.Is(err, errReleaseAssetURL) {
+				t.Errorf("GetReleaseAssetReader() error = %v, want %v", err, errReleaseAssetURL)
+			}
+		})
+	}
+}
+
+func TestCheckReleaseAssetURL(t *testing.T) {
+	t.Parallel()
+	client := &Client{repourl: &Repo{scheme: "https", host: "gitlab.example.com/gitlab"}}
+	for _, raw := range []string{
+		"https://gitlab.example.com/gitlab/owner/project/-/releases/v1.0.0/downloads/tool.tar.gz",
+		"https://GitLab.example.com/api/v4/projects/1/packages/generic/tool/1.0.0/tool.tar.gz",
+	} {
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.checkReleaseAssetURL(u); err != nil {
+			t.Errorf("checkReleaseAssetURL(%s) = %v, want nil", raw, err)
+		}
+	}
+}
+
 func TestListCommits(t *testing.T) {
 	t.Parallel()
 
@@ -72,14 +72,30 @@ func releasesFrom(data []*gitlab.Release) []clients.Release {
 			TagName:         r.TagName,
 			TargetCommitish: r.CommitPath,
 		}
+		if r.ReleasedAt != nil {
+			release.PublishedAt = *r.ReleasedAt
+		}
 		if len(r.Assets.Links) > 0 {
 			release.URL = r.Assets.Links[0].DirectAssetURL
 		}
 
 		for _, a := range r.Assets.Sources {
 			release.Assets = append(release.Assets, clients.ReleaseAsset{
-				Name: a.Format,
-				URL:  a.URL,
+				Name:        a.Format,
+				URL:         a.URL,
+				DownloadURL: a.URL,
+			})
+		}
+		// Links are the files attached to the release, e.g. packages, SBOMs or signatures.
+		for _, l := range r.Assets.Links {
+			downloadURL := l.DirectAssetURL
+			if downloadURL == "" {
+				downloadURL = l.URL
+			}
+			release.Assets = append(release.Assets, clients.ReleaseAsset{
+				Name:        l.Name,
+				URL:         l.URL,
+				DownloadURL: downloadURL,
 			})
 		}
 		releases = append(releases, release)
@@ -0,0 +1,81 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestReleasesFrom(t *testing.T) {
+	t.Parallel()
+	// A release of the GitLab API, with its source archives and the links to its packages.
+	const data = `[{
+		"tag_name": "v1.0.0",
+		"commit_path": "/owner/repo/-/commit/abc123",
+		"assets": {
+			"sources": [
+				{"format": "tar.gz", "url": "https://gitlab.com/owner/repo/-/archive/v1.0.0/repo-v1.0.0.tar.gz"}
+			],
+			"links": [
+				{
+					"name": "repo-linux-amd64.tar.gz",
+					"url": "https://gitlab.com/owner/repo/-/package_files/1/download",
+					"direct_asset_url": "https://gitlab.com/owner/repo/-/releases/v1.0.0/downloads/repo-linux-amd64.tar.gz"
+				},
+				{
+					"name": "repo-linux-amd64.tar.gz.sig",
+					"url": "https://example.com/repo-linux-amd64.tar.gz.sig"
+				}
+			]
+		}
+	}]`
+	var releases []*gitlab.Release
+	if err := json.Unmarshal([]byte(data), &releases); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []clients.Release{
+		{
+			TagName:         "v1.0.0",
+			TargetCommitish: "/owner/repo/-/commit/abc123",
+			URL:             "https://gitlab.com/owner/repo/-/releases/v1.0.0/downloads/repo-linux-amd64.tar.gz",
+			Assets: []clients.ReleaseAsset{
+				{
+					Name:        "tar.gz",
+					URL:         "https://gitlab.com/owner/repo/-/archive/v1.0.0/repo-v1.0.0.tar.gz",
+					DownloadURL: "https://gitlab.com/owner/repo/-/archive/v1.0.0/repo-v1.0.0.tar.gz",
+				},
+				{
+					Name:        "repo-linux-amd64.tar.gz",
+					URL:         "https://gitlab.com/owner/repo/-/package_files/1/download",
+					DownloadURL: "https://gitlab.com/owner/repo/-/releases/v1.0.0/downloads/repo-linux-amd64.tar.gz",
+				},
+				{
+					Name:        "repo-linux-amd64.tar.gz.sig",
+					URL:         "https://example.com/repo-linux-amd64.tar.gz.sig",
+					DownloadURL: "https://example.com/repo-linux-amd64.tar.gz.sig",
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, releasesFrom(releases)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
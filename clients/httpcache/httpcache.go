@@ -0,0 +1,450 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpcache implements an opt-in, on-disk cache for forge API
+// responses which revalidates entries using conditional requests.
+package httpcache
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxSize is the cache size limit in bytes used when none is configured.
+	DefaultMaxSize int64 = 512 << 20
+
+	// FromCacheHeader is set on responses served from the cache.
+	FromCacheHeader = "X-From-Cache"
+
+	tempPrefix = "tmp-"
+)
+
+// scopeHeaders hold credentials. Their values are hashed into the cache key
+// so that responses fetched with one token are never served for another.
+var scopeHeaders = []string{"Authorization", "Private-Token", "Job-Token"}
+
+// volatileHeaders describe the state of the server at the time of the response,
+// not the resource itself. They are not stored so that rate limit handling
+// only ever sees live values.
+var volatileHeaders = []string{
+	"Date",
+	"Retry-After",
+	"X-Ratelimit-Limit",
+	"X-Ratelimit-Remaining",
+	"X-Ratelimit-Reset",
+	"X-Ratelimit-Resource",
+	"X-Ratelimit-Used",
+	"Ratelimit-Limit",
+	"Ratelimit-Remaining",
+	"Ratelimit-Reset",
+	"Ratelimit-Observed",
+}
+
+// Options configure the cache.
+type Options struct {
+	// Dir is the directory entries are stored in. The cache is disabled when empty.
+	Dir string
+	// MaxSize is the total size of all entries in bytes. DefaultMaxSize is used when <= 0.
+	MaxSize int64
+	// TTL is how long an entry is served without contacting the server.
+	// Once it elapses, entries are revalidated with If-None-Match/If-Modified-Since.
+	TTL time.Duration
+}
+
+var (
+	configMu sync.Mutex
+	config   Options
+	stores   = map[string]*store{}
+)
+
+// Configure sets the options used by Wrap. It is typically called once
+// from the command line handling, before any clients are created.
+func Configure(opts Options) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	config = opts
+}
+
+// Enabled returns whether Configure was called with a cache directory.
+func Enabled() bool {
+	configMu.Lock()
+	defer configMu.Unlock()
+	return config.Dir != ""
+}
+
+// Wrap returns base wrapped in a cache using the options passed to Configure,
+// or base itself when caching is disabled.
+func Wrap(base http.RoundTripper) http.RoundTripper {
+	configMu.Lock()
+	opts := config
+	configMu.Unlock()
+	if opts.Dir == "" {
+		return base
+	}
+	return NewTransport(base, opts)
+}
+
+// Transport is an http.RoundTripper which caches successful GET responses on disk.
+type Transport struct {
+	base  http.RoundTripper
+	store *store
+	ttl   time.Duration
+}
+
+// NewTransport returns a Transport storing entries in opts.Dir.
+// Transports with the same directory share their size accounting.
+func NewTransport(base http.RoundTripper, opts Options) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	maxSize := opts.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	ttl := opts.TTL
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	configMu.Lock()
+	defer configMu.Unlock()
+	dir := filepath.Clean(opts.Dir)
+	s, ok := stores[dir]
+	if !ok {
+		s = &store{dir: dir}
+		stores[dir] = s
+	}
+	s.setMaxSize(maxSize)
+	return &Transport{base: base, store: s, ttl: ttl}
+}
+
+// RoundTrip serves fresh entries from disk, revalidates stale ones and stores
+// cacheable responses as their body is read.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !cacheableRequest(r) {
+		return t.base.RoundTrip(r)
+	}
+
+	key := cacheKey(r)
+	// Unreadable or corrupt entries are treated as misses and overwritten.
+	cached, validatedAt, _ := t.store.get(key, r)
+	if cached != nil {
+		if t.ttl > 0 && time.Since(validatedAt) < t.ttl {
+			return cached, nil
+		}
+		r = withValidators(r, cached.Header)
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		if cached != nil {
+			cached.Body.Close()
+		}
+		return nil, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+
+	if cached != nil {
+		if resp.StatusCode == http.StatusNotModified {
+			resp.Body.Close()
+			for k, v := range resp.Header {
+				if k == "Content-Length" || k == "Transfer-Encoding" {
+					continue
+				}
+				cached.Header[k] = v
+			}
+			t.store.touch(key)
+			return cached, nil
+		}
+		cached.Body.Close()
+	}
+
+	if !t.cacheableResponse(resp) {
+		return resp, nil
+	}
+	return t.store.tee(key, resp), nil
+}
+
+func cacheableRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+	// Requests which are already conditional or partial expect the server's
+	// answer to reach the caller as is.
+	for _, h := range []string{"If-None-Match", "If-Modified-Since", "Range"} {
+		if r.Header.Get(h) != "" {
+			return false
+		}
+	}
+	return !strings.Contains(r.Header.Get("Cache-Control"), "no-store")
+}
+
+func (t *Transport) cacheableResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	// Without validators an entry can only ever be used within its TTL.
+	return t.ttl > 0 || resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+func withValidators(r *http.Request, stored http.Header) *http.Request {
+	r = r.Clone(r.Context())
+	if etag := stored.Get("ETag"); etag != "" {
+		r.Header.Set("If-None-Match", etag)
+	}
+	if lastModified := stored.Get("Last-Modified"); lastModified != "" {
+		r.Header.Set("If-Modified-Since", lastModified)
+	}
+	return r
+}
+
+// cacheKey identifies a response by request URL, representation and
+// credential scope. Only the hash is stored so tokens never reach the disk.
+func cacheKey(r *http.Request) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", r.Method, r.URL.String())
+	fmt.Fprintf(h, "Accept: %s\n", r.Header.Get("Accept"))
+	for _, name := range scopeHeaders {
+		fmt.Fprintf(h, "%s: %s\n", name, r.Header.Get(name))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// store keeps one file per entry, holding the status line and headers in
+// wire format followed by the body.
+type store struct {
+	dir     string
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	sized   bool
+}
+
+func (s *store) setMaxSize(maxSize int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxSize = maxSize
+}
+
+func (s *store) path(key string) string {
+	return filepath.Join(s.dir, key[:2], key)
+}
+
+func (s *store) get(key string, r *http.Request) (*http.Response, time.Time, error) {
+	f, err := os.Open(s.path(key))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("opening cache entry: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, time.Time{}, fmt.Errorf("stat cache entry: %w", err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(f), r)
+	if err != nil {
+		f.Close()
+		return nil, time.Time{}, fmt.Errorf("reading cache entry: %w", err)
+	}
+	resp.Body = &entryBody{ReadCloser: resp.Body, f: f}
+	resp.Header.Set(FromCacheHeader, "1")
+	return resp, info.ModTime(), nil
+}
+
+// touch marks an entry as validated now.
+func (s *store) touch(key string) {
+	now := time.Now()
+	//nolint:errcheck // a missed touch only causes an extra revalidation
+	os.Chtimes(s.path(key), now, now)
+}
+
+// tee returns resp with a body that writes to a new entry as it is read.
+// The entry is only committed once the body has been read completely.
+func (s *store) tee(key string, resp *http.Response) *http.Response {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return resp
+	}
+	tmp, err := os.CreateTemp(s.dir, tempPrefix+"*")
+	if err != nil {
+		return resp
+	}
+
+	header := resp.Header.Clone()
+	for _, h := range volatileHeaders {
+		header.Del(h)
+	}
+	header.Del("Content-Length")
+	header.Del("Transfer-Encoding")
+	w := bufio.NewWriter(tmp)
+	fmt.Fprintf(w, "HTTP/1.1 %s\r\n", resp.Status)
+	header.Write(w) //nolint:errcheck // checked by Flush below
+	w.WriteString("\r\n")
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return resp
+	}
+
+	s.mu.Lock()
+	// Entries larger than a quarter of the cache would evict most of it.
+	limit := s.maxSize / 4
+	s.mu.Unlock()
+	resp.Body = &teeBody{
+		ReadCloser: resp.Body,
+		store:      s,
+		key:        key,
+		tmp:        tmp,
+		limit:      limit,
+	}
+	return resp
+}
+
+func (s *store) commit(key string, tmp *os.File) {
+	info, err := tmp.Stat()
+	if err != nil {
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.sized {
+		s.evict()
+		return
+	}
+	s.size += info.Size()
+	if s.size > s.maxSize {
+		s.evict()
+	}
+}
+
+// evict recomputes the size of the cache directory and removes the least
+// recently validated entries until it is below 90% of the limit.
+// Other processes may share the directory, so the walk is the source of truth.
+// s.mu must be held.
+func (s *store) evict() {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	var total int64
+	//nolint:errcheck // entries which can't be listed can't be evicted either
+	filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		entries = append(entries, entry{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	s.sized = true
+	s.size = total
+	if total <= s.maxSize {
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+	target := s.maxSize / 10 * 9
+	for _, e := range entries {
+		if s.size <= target {
+			break
+		}
+		if err := os.Remove(e.path); err == nil {
+			s.size -= e.size
+		}
+	}
+}
+
+type entryBody struct {
+	io.ReadCloser
+	f *os.File
+}
+
+func (b *entryBody) Close() error {
+	b.ReadCloser.Close()
+	return b.f.Close() //nolint:wrapcheck // passed through to the caller
+}
+
+type teeBody struct {
+	io.ReadCloser
+	store   *store
+	key     string
+	tmp     *os.File
+	limit   int64
+	written int64
+}
+
+func (b *teeBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.tmp != nil && n > 0 {
+		b.written += int64(n)
+		if b.written > b.limit {
+			b.abandon()
+		} else if _, werr := b.tmp.Write(p[:n]); werr != nil {
+			b.abandon()
+		}
+	}
+	if err == io.EOF && b.tmp != nil {
+		b.store.commit(b.key, b.tmp)
+		b.tmp = nil
+	}
+	return n, err //nolint:wrapcheck // io.EOF must not be wrapped
+}
+
+func (b *teeBody) Close() error {
+	// Responses which weren't read to the end are not stored.
+	if b.tmp != nil {
+		b.abandon()
+	}
+	return b.ReadCloser.Close() //nolint:wrapcheck // passed through to the caller
+}
+
+func (b *teeBody) abandon() {
+	b.tmp.Close()
+	os.Remove(b.tmp.Name())
+	b.tmp = nil
+}
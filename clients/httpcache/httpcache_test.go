@@ -0,0 +1,294 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeServer struct {
+	*httptest.Server
+	body         atomic.Value
+	requests     atomic.Int32
+	notModified  atomic.Int32
+	cacheControl string
+}
+
+// newFakeServer serves the current body with a strong ETag derived from it.
+func newFakeServer(t *testing.T, body string) *fakeServer {
+	t.Helper()
+	s := &fakeServer{}
+	s.body.Store(body)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		body, ok := s.body.Load().(string)
+		if !ok {
+			t.Error("unexpected body type")
+		}
+		etag := `"` + body + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		if s.cacheControl != "" {
+			w.Header().Set("Cache-Control", s.cacheControl)
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			s.notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		io.WriteString(w, body) //nolint:errcheck
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func get(t *testing.T, rt http.RoundTripper, url, token string) (string, http.Header) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	return string(body), resp.Header
+}
+
+func TestTransport_revalidates(t *testing.T) {
+	t.Parallel()
+	srv := newFakeServer(t, "v1")
+	rt := NewTransport(http.DefaultTransport, Options{Dir: t.TempDir()})
+
+	body, header := get(t, rt, srv.URL, "token")
+	if body != "v1" || header.Get(FromCacheHeader) != "" {
+		t.Errorf("first response: got %q (cached: %q), want uncached v1", body, header.Get(FromCacheHeader))
+	}
+
+	body, header = get(t, rt, srv.URL, "token")
+	if body != "v1" || header.Get(FromCacheHeader) == "" {
+		t.Errorf("second response: got %q (cached: %q), want cached v1", body, header.Get(FromCacheHeader))
+	}
+	if got := srv.notModified.Load(); got != 1 {
+		t.Errorf("304 responses: got %d, want 1", got)
+	}
+	// Rate limit headers must come from the live 304, they are never stored.
+	if got := header.Get("X-RateLimit-Remaining"); got != "42" {
+		t.Errorf("X-RateLimit-Remaining: got %q, want 42", got)
+	}
+
+	srv.body.Store("v2")
+	body, _ = get(t, rt, srv.URL, "token")
+	if body != "v2" {
+		t.Errorf("after change: got %q, want v2", body)
+	}
+}
+
+func TestTransport_ttl(t *testing.T) {
+	t.Parallel()
+	srv := newFakeServer(t, "v1")
+	rt := NewTransport(http.DefaultTransport, Options{Dir: t.TempDir(), TTL: time.Hour})
+
+	get(t, rt, srv.URL, "token")
+	srv.body.Store("v2")
+	body, header := get(t, rt, srv.URL, "token")
+	if body != "v1" {
+		t.Errorf("within TTL: got %q, want v1", body)
+	}
+	if got := srv.requests.Load(); got != 1 {
+		t.Errorf("requests: got %d, want 1", got)
+	}
+	if got := header.Get("X-RateLimit-Remaining"); got != "" {
+		t.Errorf("X-RateLimit-Remaining: got %q, want it to be dropped", got)
+	}
+}
+
+func TestTransport_uncached(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		cacheControl string
+		tokens       []string
+	}{
+		{
+			name:   "tokens scope entries",
+			tokens: []string{"token-a", "token-b", "token-c"},
+		},
+		{
+			name:         "no-store",
+			cacheControl: "no-store",
+			tokens:       []string{"token", "token", "token"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newFakeServer(t, "v1")
+			srv.cacheControl = tt.cacheControl
+			rt := NewTransport(http.DefaultTransport, Options{Dir: t.TempDir()})
+			for _, token := range tt.tokens {
+				get(t, rt, srv.URL, token)
+			}
+			if got := srv.notModified.Load(); got != 0 {
+				t.Errorf("304 responses: got %d, want 0", got)
+			}
+		})
+	}
+}
+
+func TestTransport_partialBodyNotStored(t *testing.T) {
+	t.Parallel()
+	srv := newFakeServer(t, "a long body")
+	dir := t.TempDir()
+	rt := NewTransport(http.DefaultTransport, Options{Dir: dir})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	buf := make([]byte, 2)
+	if _, err := io.ReadFull(resp.Body, buf); err != nil {
+		t.Fatalf("ReadFull: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := countFiles(t, dir); got != 0 {
+		t.Errorf("files in cache: got %d, want 0", got)
+	}
+}
+
+func TestTransport_evicts(t *testing.T) {
+	t.Parallel()
+	body := strings.Repeat("x", 100)
+	srv := newFakeServer(t, body)
+	dir := t.TempDir()
+	// Each entry is a little over 100 bytes, so only a few fit.
+	rt := NewTransport(http.DefaultTransport, Options{Dir: dir, MaxSize: 600})
+
+	for i := range 10 {
+		get(t, rt, srv.URL+"/"+string(rune('a'+i)), "")
+	}
+	var total int64
+	//nolint:errcheck
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	if total > 600 {
+		t.Errorf("cache size: got %d, want <= 600", total)
+	}
+	if got := countFiles(t, dir); got == 0 {
+		t.Error("cache is empty, want the most recent entries kept")
+	}
+}
+
+func Test_cacheKey(t *testing.T) {
+	t.Parallel()
+	newReq := func(url string, header map[string]string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		return req
+	}
+	base := cacheKey(newReq("https://api.github.com/repos/a/b", map[string]string{"Authorization": "token x"}))
+	tests := []struct {
+		req  *http.Request
+		name string
+		same bool
+	}{
+		{
+			name: "same request",
+			req:  newReq("https://api.github.com/repos/a/b", map[string]string{"Authorization": "token x"}),
+			same: true,
+		},
+		{
+			name: "unrelated header",
+			req: newReq("https://api.github.com/repos/a/b", map[string]string{
+				"Authorization": "token x",
+				"User-Agent":    "scorecard",
+			}),
+			same: true,
+		},
+		{
+			name: "different token",
+			req:  newReq("https://api.github.com/repos/a/b", map[string]string{"Authorization": "token y"}),
+		},
+		{
+			name: "gitlab token",
+			req:  newReq("https://api.github.com/repos/a/b", map[string]string{"Private-Token": "token x"}),
+		},
+		{
+			name: "different accept",
+			req: newReq("https://api.github.com/repos/a/b", map[string]string{
+				"Authorization": "token x",
+				"Accept":        "application/vnd.github.raw",
+			}),
+		},
+		{
+			name: "different url",
+			req:  newReq("https://api.github.com/repos/a/c", map[string]string{"Authorization": "token x"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := cacheKey(tt.req) == base
+			if diff := cmp.Diff(tt.same, got); diff != "" {
+				t.Errorf("same key mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func countFiles(t *testing.T, dir string) int {
+	t.Helper()
+	n := 0
+	//nolint:errcheck
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			n++
+		}
+		return nil
+	})
+	return n
+}
@@ -44,6 +44,9 @@ const (
 	// C#: https://docs.microsoft.com/en-us/dotnet/csharp/
 	CSharp LanguageName = "c#"
 
+	// F#: https://fsharp.org/
+	FSharp LanguageName = "f#"
+
 	// ObjectiveC: the objective c language.
 	ObjectiveC LanguageName = "objectivec"
 
@@ -25,17 +25,24 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/go-git/go-git/v5"
+
 	clients "github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/git/history"
 	"github.com/ossf/scorecard/v5/log"
 )
 
 var (
 	_                clients.RepoClient = &Client{}
 	errInputRepoType                    = errors.New("input repo should be of type repoLocal")
+	errCommitSHA                        = errors.New("local directories can only be scanned at HEAD")
+	// Shorter prefixes of the commit of HEAD could be ambiguous.
+	reCommitSHA = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
 )
 
 //nolint:govet
@@ -47,6 +54,12 @@ type Client struct {
 	errFiles    error
 	files       []string
 	commitDepth int
+	// gitRepo is set when the directory is a git repository,
+	// in which case history is served from the local object database.
+	gitRepo     *git.Repository
+	commitsOnce *sync.Once
+	errCommits  error
+	commits     []clients.Commit
 }
 
 // InitRepo sets up the local repo.
@@ -62,6 +75,33 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	}
 	client.path = strings.TrimPrefix(localRepo.URI(), "file://")
 
+	client.commitsOnce = new(sync.Once)
+	client.commits = nil
+	client.gitRepo = nil
+	gitRepo, err := git.PlainOpen(client.path)
+	switch {
+	case errors.Is(err, git.ErrRepositoryNotExists):
+		// Not a git repository: only file-based checks are supported.
+	case err != nil:
+		return fmt.Errorf("git.PlainOpen: %w", err)
+	default:
+		client.gitRepo = gitRepo
+	}
+
+	// Files are read from the working tree, so history must start there too.
+	if commitSHA != "" && commitSHA != clients.HeadSHA {
+		if client.gitRepo == nil {
+			return fmt.Errorf("%w: %s", errCommitSHA, commitSHA)
+		}
+		head, err := client.gitRepo.Head()
+		if err != nil {
+			return fmt.Errorf("gitRepo.Head: %w", err)
+		}
+		if !reCommitSHA.MatchString(commitSHA) || !strings.HasPrefix(head.Hash().String(), strings.ToLower(commitSHA)) {
+			return fmt.Errorf("%w: %s is not HEAD (%s)", errCommitSHA, commitSHA, head.Hash())
+		}
+	}
+
 	return nil
 }
 
@@ -72,6 +112,10 @@ func (client *Client) URI() string {
 
 // IsArchived implements RepoClient.IsArchived.
 func (client *Client) IsArchived() (bool, error) {
+	// A local repository can't be archived.
+	if client.gitRepo != nil {
+		return false, nil
+	}
 	return false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature)
 }
 
@@ -191,21 +235,46 @@ func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 
 // GetDefaultBranchName implements RepoClient.GetDefaultBranchName.
 func (client *Client) GetDefaultBranchName() (string, error) {
+	if client.gitRepo != nil {
+		branch, err := history.DefaultBranchName(client.gitRepo)
+		if err != nil {
+			return "", fmt.Errorf("history.DefaultBranchName: %w", err)
+		}
+		return branch, nil
+	}
 	return "", fmt.Errorf("GetDefaultBranchName: %w", clients.ErrUnsupportedFeature)
 }
 
 // ListCommits implements RepoClient.ListCommits.
 func (client *Client) ListCommits() ([]clients.Commit, error) {
+	if client.gitRepo != nil {
+		client.commitsOnce.Do(func() {
+			client.commits, client.errCommits = history.ListCommits(client.gitRepo, client.commitDepth)
+		})
+		return client.commits, client.errCommits
+	}
 	return nil, fmt.Errorf("ListCommits: %w", clients.ErrUnsupportedFeature)
 }
 
 // ListIssues implements RepoClient.ListIssues.
 func (client *Client) ListIssues() ([]clients.Issue, error) {
+	// Issues aren't stored in git, so there's no activity to report.
+	if client.gitRepo != nil {
+		return nil, nil
+	}
 	return nil, fmt.Errorf("ListIssues: %w", clients.ErrUnsupportedFeature)
 }
 
 // ListReleases implements RepoClient.ListReleases.
+// Tags are returned as releases for git repositories.
 func (client *Client) ListReleases() ([]clients.Release, error) {
+	if client.gitRepo != nil {
+		releases, err := history.ListReleases(client.gitRepo)
+		if err != nil {
+			return nil, fmt.Errorf("history.ListReleases: %w", err)
+		}
+		return releases, nil
+	}
 	return nil, fmt.Errorf("ListReleases: %w", clients.ErrUnsupportedFeature)
 }
 
@@ -221,6 +290,10 @@ func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.Wor
 
 // ListCheckRunsForRef implements RepoClient.ListCheckRunsForRef.
 func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	// Check runs aren't stored in git, so no commit has any.
+	if client.gitRepo != nil {
+		return nil, nil
+	}
 	return nil, fmt.Errorf("ListCheckRunsForRef: %w", clients.ErrUnsupportedFeature)
 }
 
@@ -234,6 +307,18 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+func (client *Client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("GetReleaseAssetReader: %w", clients.ErrUnsupportedFeature)
+}
+
 // Search implements RepoClient.Search.
 func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	return clients.SearchResponse{}, fmt.Errorf("Search: %w", clients.ErrUnsupportedFeature)
@@ -261,7 +346,15 @@ func (client *Client) ListLicenses() ([]clients.License, error) {
 	return nil, fmt.Errorf("ListLicenses: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetCreatedAt returns the date of the first commit for git repositories.
 func (client *Client) GetCreatedAt() (time.Time, error) {
+	if client.gitRepo != nil {
+		createdAt, err := history.FirstCommitDate(client.gitRepo)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("history.FirstCommitDate: %w", err)
+		}
+		return createdAt, nil
+	}
 	return time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature)
 }
 
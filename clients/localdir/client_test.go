@@ -19,9 +19,13 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
@@ -208,3 +212,103 @@ func TestClient_GetFileListAndContent(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GitHistory(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("git.PlainInit: %v", err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "file"), []byte("content"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := w.Add("file"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hash, err := w.Commit("Initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test Author", Email: "author@example.com", When: createdAt},
+	})
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if _, err := r.CreateTag("v1.0.0", hash, nil); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	repo, err := MakeLocalDirRepo(dir)
+	if err != nil {
+		t.Fatalf("MakeLocalDirRepo: %v", err)
+	}
+	if !repo.(*Repo).HasGitHistory() {
+		t.Errorf("HasGitHistory() = false, want true")
+	}
+	client := CreateLocalDirClient(context.Background(), log.NewLogger(log.DebugLevel))
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+
+	commits, err := client.ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	if len(commits) != 1 || commits[0].SHA != hash.String() {
+		t.Errorf("ListCommits() = %v, want commit %s", commits, hash)
+	}
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "master" {
+		t.Errorf("GetDefaultBranchName() = %s, %v, want master", branch, err)
+	}
+	got, err := client.GetCreatedAt()
+	if err != nil || !got.Equal(createdAt) {
+		t.Errorf("GetCreatedAt() = %v, %v, want %v", got, err, createdAt)
+	}
+	releases, err := client.ListReleases()
+	if err != nil {
+		t.Fatalf("ListReleases: %v", err)
+	}
+	if diff := cmp.Diff([]clients.Release{{TagName: "v1.0.0", TargetCommitish: hash.String()}}, releases); diff != "" {
+		t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+	}
+	if archived, err := client.IsArchived(); err != nil || archived {
+		t.Errorf("IsArchived() = %t, %v, want false", archived, err)
+	}
+	if err := client.InitRepo(repo, hash.String()[:12], 0); err != nil {
+		t.Errorf("InitRepo(HEAD commit): %v", err)
+	}
+	if err := client.InitRepo(repo, strings.Repeat("0", 40), 0); !errors.Is(err, errCommitSHA) {
+		t.Errorf("InitRepo(other commit): got %v, want %v", err, errCommitSHA)
+	}
+	if err := client.InitRepo(repo, hash.String()[:6], 0); !errors.Is(err, errCommitSHA) {
+		t.Errorf("InitRepo(short prefix of the HEAD commit): got %v, want %v", err, errCommitSHA)
+	}
+	if err := client.InitRepo(repo, strings.ToUpper(hash.String()[:7]), 0); err != nil {
+		t.Errorf("InitRepo(shortest prefix of the HEAD commit): %v", err)
+	}
+}
+
+func TestClient_NoGitHistory(t *testing.T) {
+	t.Parallel()
+	repo, err := MakeLocalDirRepo("testdata/repo0")
+	if err != nil {
+		t.Fatalf("MakeLocalDirRepo: %v", err)
+	}
+	if repo.(*Repo).HasGitHistory() {
+		t.Errorf("HasGitHistory() = true, want false")
+	}
+	client := CreateLocalDirClient(context.Background(), log.NewLogger(log.DebugLevel))
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	if _, err := client.ListCommits(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListCommits: got %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+	if err := client.InitRepo(repo, strings.Repeat("0", 40), 0); !errors.Is(err, errCommitSHA) {
+		t.Errorf("InitRepo(commit): got %v, want %v", err, errCommitSHA)
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"os"
 	"path"
 
+	"github.com/go-git/go-git/v5"
+
 	clients "github.com/ossf/scorecard/v5/clients"
 )
 
@@ -74,6 +76,13 @@ func (r *Repo) Path() string {
 	return r.path
 }
 
+// HasGitHistory reports whether the directory is the root of a git repository,
+// whose history can be used by checks which don't need a forge API.
+func (r *Repo) HasGitHistory() bool {
+	_, err := git.PlainOpen(r.path)
+	return err == nil
+}
+
 // MakeLocalDirRepo returns an implementation of clients.Repo interface.
 func MakeLocalDirRepo(pathfn string) (clients.Repo, error) {
 	p := path.Clean(pathfn)
@@ -81,6 +81,21 @@ func (mr *MockRepoClientMockRecorder) GetBranch(branch interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockRepoClient)(nil).GetBranch), branch)
 }
 
+// GetCodeScanningDefaultSetup mocks base method.
+func (m *MockRepoClient) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeScanningDefaultSetup")
+	ret0, _ := ret[0].(clients.CodeScanningDefaultSetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeScanningDefaultSetup indicates an expected call of GetCodeScanningDefaultSetup.
+func (mr *MockRepoClientMockRecorder) GetCodeScanningDefaultSetup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeScanningDefaultSetup", reflect.TypeOf((*MockRepoClient)(nil).GetCodeScanningDefaultSetup))
+}
+
 // GetCreatedAt mocks base method.
 func (m *MockRepoClient) GetCreatedAt() (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +171,36 @@ func (mr *MockRepoClientMockRecorder) GetOrgRepoClient(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRepoClient", reflect.TypeOf((*MockRepoClient)(nil).GetOrgRepoClient), arg0)
 }
 
+// GetReleaseAssetReader mocks base method.
+func (m *MockRepoClient) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseAssetReader", asset)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseAssetReader indicates an expected call of GetReleaseAssetReader.
+func (mr *MockRepoClientMockRecorder) GetReleaseAssetReader(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseAssetReader", reflect.TypeOf((*MockRepoClient)(nil).GetReleaseAssetReader), asset)
+}
+
+// GetSecretScanningSettings mocks base method.
+func (m *MockRepoClient) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretScanningSettings")
+	ret0, _ := ret[0].(clients.SecretScanningSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretScanningSettings indicates an expected call of GetSecretScanningSettings.
+func (mr *MockRepoClientMockRecorder) GetSecretScanningSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretScanningSettings", reflect.TypeOf((*MockRepoClient)(nil).GetSecretScanningSettings))
+}
+
 // InitRepo mocks base method.
 func (m *MockRepoClient) InitRepo(repo clients.Repo, commitSHA string, commitDepth int) error {
 	m.ctrl.T.Helper()
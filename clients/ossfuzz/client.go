@@ -247,6 +247,21 @@ func (c *client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetCodeScanningDefaultSetup implements RepoClient.GetCodeScanningDefaultSetup.
+func (c *client) GetCodeScanningDefaultSetup() (clients.CodeScanningDefaultSetup, error) {
+	return clients.CodeScanningDefaultSetup{}, fmt.Errorf("GetCodeScanningDefaultSetup: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetSecretScanningSettings implements RepoClient.GetSecretScanningSettings.
+func (c *client) GetSecretScanningSettings() (clients.SecretScanningSettings, error) {
+	return clients.SecretScanningSettings{}, fmt.Errorf("GetSecretScanningSettings: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetReleaseAssetReader implements RepoClient.GetReleaseAssetReader.
+func (c *client) GetReleaseAssetReader(asset *clients.ReleaseAsset) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("GetReleaseAssetReader: %w", clients.ErrUnsupportedFeature)
+}
+
 // SearchCommits implements RepoClient.SearchCommits.
 func (c *client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
 	return nil, fmt.Errorf("SearchCommits: %w", clients.ErrUnsupportedFeature)
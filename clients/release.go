@@ -14,8 +14,21 @@
 
 package clients
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+var errReleaseAssetDownload = errors.New("release asset download failed")
+
 // Release represents a release version of a package/repo.
 type Release struct {
+	// PublishedAt is when the release was published, zero if unknown.
+	PublishedAt     time.Time
 	TagName         string
 	URL             string
 	TargetCommitish string
@@ -26,4 +39,27 @@ type Release struct {
 type ReleaseAsset struct {
 	Name string
 	URL  string
+	// DownloadURL is the location of the content of the asset, empty if unknown.
+	DownloadURL string
+}
+
+// DownloadReleaseAsset returns the content of asset fetched with httpClient,
+// for the RepoClient implementations of GetReleaseAssetReader.
+func DownloadReleaseAsset(ctx context.Context, httpClient *http.Client, asset *ReleaseAsset) (io.ReadCloser, error) {
+	if asset.DownloadURL == "" {
+		return nil, fmt.Errorf("%w: no download URL for release asset %s", ErrUnsupportedFeature, asset.Name)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, asset.DownloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http.Client.Do: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s: %s", errReleaseAssetDownload, asset.DownloadURL, resp.Status)
+	}
+	return resp.Body, nil
 }
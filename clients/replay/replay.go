@@ -0,0 +1,420 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay records the HTTP exchanges of a scorecard run into a single
+// archive, and serves them back so the run can be repeated offline.
+package replay
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	manifestName    = "manifest.json"
+	manifestVersion = 1
+)
+
+var (
+	// ErrNotRecorded is returned when replaying a request missing from the archive.
+	ErrNotRecorded = errors.New("request not recorded")
+	errVersion     = errors.New("unsupported archive version")
+	errActive      = errors.New("recording or replay already active")
+)
+
+// manifest is the index of an archive. Response bodies are stored next to it,
+// one archive entry per exchange.
+type manifest struct {
+	Date             time.Time  `json:"date"`
+	ScorecardVersion string     `json:"scorecardVersion"`
+	Exchanges        []exchange `json:"exchanges"`
+	Version          int        `json:"version"`
+}
+
+// exchange is a recorded request and its response. Request headers are not
+// recorded, so credentials never end up in the archive.
+type exchange struct {
+	Header        http.Header `json:"header"`
+	Method        string      `json:"method"`
+	URL           string      `json:"url"`
+	RequestSHA256 string      `json:"requestSHA256,omitempty"`
+	Status        string      `json:"status"`
+	Body          string      `json:"body,omitempty"`
+	// Error is set instead of a response when the request failed.
+	Error      string `json:"error,omitempty"`
+	StatusCode int    `json:"statusCode,omitempty"`
+}
+
+func (e *exchange) key() string {
+	return e.Method + " " + e.URL + " " + e.RequestSHA256
+}
+
+// wrapper is implemented by Recorder and Player.
+type wrapper interface {
+	wrap(base http.RoundTripper) http.RoundTripper
+}
+
+var (
+	activeMu sync.Mutex
+	active   wrapper
+)
+
+func activate(a wrapper) error {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	if active != nil {
+		return errActive
+	}
+	active = a
+	return nil
+}
+
+func deactivate() {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = nil
+}
+
+// Enabled returns whether a recording or replay is active.
+func Enabled() bool {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	return active != nil
+}
+
+// Wrap returns a transport recording the exchanges made through base, or
+// serving them from the archive, when a recording or replay is active.
+// Otherwise base is returned.
+func Wrap(base http.RoundTripper) http.RoundTripper {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	if active == nil {
+		return base
+	}
+	return active.wrap(base)
+}
+
+// requestKey reads the request body, if any, and returns its hash along with
+// a copy of r that can still be sent.
+func requestKey(r *http.Request) (*http.Request, string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return r, "", nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, "", fmt.Errorf("reading request body: %w", err)
+	}
+	r = r.Clone(r.Context())
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	sum := sha256.Sum256(body)
+	return r, hex.EncodeToString(sum[:]), nil
+}
+
+// Recorder writes every exchange made through its transports to an archive.
+type Recorder struct {
+	f        *os.File
+	zw       *zip.Writer
+	manifest manifest
+	mu       sync.Mutex
+}
+
+// StartRecording creates the archive at path and routes Wrap through the recorder
+// until it is closed.
+func StartRecording(path, scorecardVersion string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating archive: %w", err)
+	}
+	rec := &Recorder{
+		f:  f,
+		zw: zip.NewWriter(f),
+		manifest: manifest{
+			Version:          manifestVersion,
+			ScorecardVersion: scorecardVersion,
+		},
+	}
+	if err := activate(rec); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return rec, nil
+}
+
+// SetDate sets the analysis time a replay of the archive reports.
+func (rec *Recorder) SetDate(date time.Time) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.manifest.Date = date
+}
+
+// Close writes the manifest and closes the archive.
+func (rec *Recorder) Close() error {
+	deactivate()
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	w, err := rec.zw.Create(manifestName)
+	if err != nil {
+		rec.f.Close()
+		return fmt.Errorf("creating manifest: %w", err)
+	}
+	if err := json.NewEncoder(w).Encode(rec.manifest); err != nil {
+		rec.f.Close()
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	if err := rec.zw.Close(); err != nil {
+		rec.f.Close()
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	if err := rec.f.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	return nil
+}
+
+func (rec *Recorder) wrap(base http.RoundTripper) http.RoundTripper {
+	return &recordingTransport{rec: rec, base: base}
+}
+
+// add stores an exchange with the body read from body.
+func (rec *Recorder) add(e *exchange, body io.Reader) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if body == nil {
+		rec.manifest.Exchanges = append(rec.manifest.Exchanges, *e)
+		return nil
+	}
+	e.Body = "bodies/" + strconv.Itoa(len(rec.manifest.Exchanges))
+	w, err := rec.zw.Create(e.Body)
+	if err != nil {
+		return fmt.Errorf("creating archive entry: %w", err)
+	}
+	if _, err := io.Copy(w, body); err != nil {
+		return fmt.Errorf("writing archive entry: %w", err)
+	}
+	rec.manifest.Exchanges = append(rec.manifest.Exchanges, *e)
+	return nil
+}
+
+type recordingTransport struct {
+	rec  *Recorder
+	base http.RoundTripper
+}
+
+// RoundTrip reads the whole response into a temporary file, so slow readers
+// don't hold up other exchanges while it is added to the archive.
+func (t *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r, sum, err := requestKey(r)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		// Failures are part of the run too, e.g. a service being unreachable.
+		e := &exchange{Method: r.Method, URL: r.URL.String(), RequestSHA256: sum, Error: err.Error()}
+		if addErr := t.rec.add(e, nil); addErr != nil {
+			return nil, addErr
+		}
+		return nil, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	defer resp.Body.Close()
+
+	tmp, err := os.CreateTemp("", "scorecard-record-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary file: %w", err)
+	}
+	body := &tempFileBody{File: tmp}
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		body.Close()
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		body.Close()
+		return nil, fmt.Errorf("rewinding response body: %w", err)
+	}
+	e := &exchange{
+		Method:        r.Method,
+		URL:           r.URL.String(),
+		RequestSHA256: sum,
+		Status:        resp.Status,
+		StatusCode:    resp.StatusCode,
+		Header:        resp.Header,
+	}
+	if err := t.rec.add(e, tmp); err != nil {
+		body.Close()
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		body.Close()
+		return nil, fmt.Errorf("rewinding response body: %w", err)
+	}
+	resp.Body = body
+	return resp, nil
+}
+
+// recordedError reproduces the message of an error returned while recording.
+type recordedError string
+
+func (e recordedError) Error() string {
+	return string(e)
+}
+
+type tempFileBody struct {
+	*os.File
+}
+
+func (b *tempFileBody) Close() error {
+	b.File.Close()
+	return os.Remove(b.Name()) //nolint:wrapcheck // passed through to the caller
+}
+
+// Player serves the exchanges of an archive without any network access.
+type Player struct {
+	archive  *zip.ReadCloser
+	files    map[string]*zip.File
+	queues   map[string][]*exchange
+	manifest manifest
+	mu       sync.Mutex
+}
+
+// StartReplay opens the archive at path and routes Wrap through the player
+// until it is closed.
+func StartReplay(path string) (*Player, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+	p := &Player{
+		archive: archive,
+		files:   make(map[string]*zip.File, len(archive.File)),
+		queues:  make(map[string][]*exchange),
+	}
+	for _, f := range archive.File {
+		p.files[f.Name] = f
+	}
+	if err := p.readManifest(); err != nil {
+		archive.Close()
+		return nil, err
+	}
+	if err := activate(p); err != nil {
+		archive.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Player) readManifest() error {
+	f, ok := p.files[manifestName]
+	if !ok {
+		return fmt.Errorf("%w: %s", os.ErrNotExist, manifestName)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("opening manifest: %w", err)
+	}
+	defer r.Close()
+	if err := json.NewDecoder(r).Decode(&p.manifest); err != nil {
+		return fmt.Errorf("reading manifest: %w", err)
+	}
+	if p.manifest.Version != manifestVersion {
+		return fmt.Errorf("%w: %d", errVersion, p.manifest.Version)
+	}
+	for i := range p.manifest.Exchanges {
+		e := &p.manifest.Exchanges[i]
+		p.queues[e.key()] = append(p.queues[e.key()], e)
+	}
+	return nil
+}
+
+// Date returns the analysis time of the recorded run.
+func (p *Player) Date() time.Time {
+	return p.manifest.Date
+}
+
+// ScorecardVersion returns the version of scorecard which made the recording.
+func (p *Player) ScorecardVersion() string {
+	return p.manifest.ScorecardVersion
+}
+
+// Close closes the archive.
+func (p *Player) Close() error {
+	deactivate()
+	if err := p.archive.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	return nil
+}
+
+// wrap ignores base: nothing is sent over the network during a replay.
+func (p *Player) wrap(http.RoundTripper) http.RoundTripper {
+	return p
+}
+
+// RoundTrip serves the recorded responses to identical requests in the order
+// they were recorded, repeating the last one once they are used up.
+func (p *Player) RoundTrip(r *http.Request) (*http.Response, error) {
+	r, sum, err := requestKey(r)
+	if err != nil {
+		return nil, err
+	}
+	key := (&exchange{Method: r.Method, URL: r.URL.String(), RequestSHA256: sum}).key()
+
+	p.mu.Lock()
+	queue := p.queues[key]
+	var e *exchange
+	if len(queue) > 0 {
+		e = queue[0]
+		if len(queue) > 1 {
+			p.queues[key] = queue[1:]
+		}
+	}
+	p.mu.Unlock()
+	if e == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, r.Method, r.URL)
+	}
+	if e.Error != "" {
+		return nil, recordedError(e.Error)
+	}
+
+	f, ok := p.files[e.Body]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", os.ErrNotExist, e.Body)
+	}
+	body, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening response body: %w", err)
+	}
+	return &http.Response{
+		Status:        e.Status,
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          body,
+		ContentLength: int64(f.UncompressedSize64),
+		Request:       r,
+	}, nil
+}
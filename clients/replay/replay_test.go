@@ -0,0 +1,176 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type result struct {
+	Body   string
+	Status int
+}
+
+func do(t *testing.T, rt http.RoundTripper, method, url, body string) (result, error) {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return result{}, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	return result{Status: resp.StatusCode, Body: string(b)}, nil
+}
+
+// Recording and replaying are process-wide, so these tests don't run in parallel.
+//
+//nolint:paralleltest
+func TestRecordReplay(t *testing.T) {
+	var counter atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/counter":
+			fmt.Fprintf(w, "count %d", counter.Add(1))
+		case "/echo":
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("ReadAll: %v", err)
+			}
+			fmt.Fprintf(w, "echo %s", b)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	requests := []struct {
+		method, path, body string
+	}{
+		{method: http.MethodGet, path: "/counter"},
+		{method: http.MethodGet, path: "/counter"},
+		{method: http.MethodPost, path: "/echo", body: "a"},
+		{method: http.MethodPost, path: "/echo", body: "b"},
+		{method: http.MethodGet, path: "/missing"},
+	}
+
+	archive := filepath.Join(t.TempDir(), "run.zip")
+	date := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	rec, err := StartRecording(archive, "v1.2.3")
+	if err != nil {
+		t.Fatalf("StartRecording: %v", err)
+	}
+	if _, err := StartRecording(filepath.Join(t.TempDir(), "other.zip"), "v1.2.3"); !errors.Is(err, errActive) {
+		t.Errorf("StartRecording while recording: got %v, want %v", err, errActive)
+	}
+	rt := Wrap(http.DefaultTransport)
+	var recorded []result
+	for _, r := range requests {
+		got, err := do(t, rt, r.method, srv.URL+r.path, r.body)
+		if err != nil {
+			t.Fatalf("recording %s %s: %v", r.method, r.path, err)
+		}
+		recorded = append(recorded, got)
+	}
+	_, recordedErr := do(t, rt, http.MethodGet, unreachable.URL, "")
+	if recordedErr == nil {
+		t.Fatal("request to a closed server succeeded")
+	}
+	rec.SetDate(date)
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if Enabled() {
+		t.Fatal("recording still enabled after Close")
+	}
+	srv.Close()
+
+	player, err := StartReplay(archive)
+	if err != nil {
+		t.Fatalf("StartReplay: %v", err)
+	}
+	defer player.Close()
+	if !player.Date().Equal(date) || player.ScorecardVersion() != "v1.2.3" {
+		t.Errorf("got date %v and version %q, want %v and v1.2.3", player.Date(), player.ScorecardVersion(), date)
+	}
+
+	rt = Wrap(http.DefaultTransport)
+	// Replay in a different order than recorded: POST requests are told apart by body.
+	order := []int{3, 0, 2, 4, 1}
+	replayed := make([]result, len(requests))
+	for _, i := range order {
+		r := requests[i]
+		got, err := do(t, rt, r.method, srv.URL+r.path, r.body)
+		if err != nil {
+			t.Fatalf("replaying %s %s: %v", r.method, r.path, err)
+		}
+		replayed[i] = got
+	}
+	if diff := cmp.Diff(recorded, replayed); diff != "" {
+		t.Errorf("replayed responses mismatch (-recorded +replayed):\n%s", diff)
+	}
+
+	// Once used up, the last response to a request is repeated.
+	got, err := do(t, rt, http.MethodGet, srv.URL+"/counter", "")
+	if err != nil {
+		t.Fatalf("replaying /counter again: %v", err)
+	}
+	if diff := cmp.Diff(recorded[1], got); diff != "" {
+		t.Errorf("repeated response mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := do(t, rt, http.MethodGet, unreachable.URL, ""); err == nil || err.Error() != recordedErr.Error() {
+		t.Errorf("replaying failed request: got %v, want %v", err, recordedErr)
+	}
+
+	if _, err := do(t, rt, http.MethodGet, srv.URL+"/unknown", ""); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("unrecorded request: got %v, want %v", err, ErrNotRecorded)
+	}
+}
+
+func TestStartReplay_invalidArchive(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "missing.zip")
+	if _, err := StartReplay(path); err == nil {
+		t.Error("StartReplay of a missing archive succeeded")
+	}
+	if Enabled() {
+		t.Error("replay enabled after failing to start")
+	}
+}
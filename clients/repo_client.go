@@ -49,11 +49,16 @@ type RepoClient interface {
 	ListIssues() ([]Issue, error)
 	ListLicenses() ([]License, error)
 	ListReleases() ([]Release, error)
+	// GetReleaseAssetReader returns an io.ReadCloser with the content of a release asset.
+	// Callers should ensure to Close the Reader when finished.
+	GetReleaseAssetReader(asset *ReleaseAsset) (io.ReadCloser, error)
 	ListContributors() ([]User, error)
 	ListSuccessfulWorkflowRuns(filename string) ([]WorkflowRun, error)
 	ListCheckRunsForRef(ref string) ([]CheckRun, error)
 	ListStatuses(ref string) ([]Status, error)
 	ListWebhooks() ([]Webhook, error)
+	GetCodeScanningDefaultSetup() (CodeScanningDefaultSetup, error)
+	GetSecretScanningSettings() (SecretScanningSettings, error)
 	ListProgrammingLanguages() ([]Language, error)
 	Search(request SearchRequest) (SearchResponse, error)
 	SearchCommits(request SearchCommitsOptions) ([]Commit, error)
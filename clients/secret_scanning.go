@@ -0,0 +1,23 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// SecretScanningSettings are the secret scanning settings of a repository.
+type SecretScanningSettings struct {
+	// Enabled is whether pushed commits are scanned for secrets.
+	Enabled bool
+	// PushProtectionEnabled is whether pushes containing secrets are blocked.
+	PushProtectionEnabled bool
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"net/http"
+
+	"github.com/ossf/scorecard/v5/clients/httpcache"
+	"github.com/ossf/scorecard/v5/clients/replay"
+)
+
+// WrapTransport layers the on-disk response cache and the recording or replay
+// of a run, when enabled, over base. Clients talking to forges and data
+// sources build their HTTP clients on it.
+func WrapTransport(base http.RoundTripper) http.RoundTripper {
+	return replay.Wrap(httpcache.Wrap(base))
+}
+
+// TransportWrapped returns whether WrapTransport changes the transport, for
+// clients which otherwise keep their library's default HTTP client.
+func TransportWrapped() bool {
+	return httpcache.Enabled() || replay.Enabled()
+}
@@ -0,0 +1,372 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
+	"github.com/ossf/scorecard/v5/clients/ossfuzz"
+	"github.com/ossf/scorecard/v5/cron/data"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+const defaultBatchParallelism = 4
+
+var (
+	errCheckpointWithoutOutput = errors.New("--checkpoint requires --output")
+	errBatchFailures           = errors.New("failed to analyze some repositories")
+)
+
+type batchOptions struct {
+	input       string
+	output      string
+	checkpoint  string
+	parallelism int
+}
+
+func batchCmd(o *options.Options) *cobra.Command {
+	bo := batchOptions{parallelism: defaultBatchParallelism}
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Run scorecard on a list of repositories",
+		Long: `Run scorecard on each repository of a CSV file, with a "repo" and an
+optional "metadata" column, and write newline-delimited JSON results.
+
+With --checkpoint, analyzed repositories are recorded, and skipped when the
+command is run again, so an interrupted run can be resumed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runBatch(cmd.Context(), o, &bo)
+		},
+	}
+	cmd.Flags().StringVar(&bo.input, "input", "", "CSV file of repositories to analyze")
+	cmd.Flags().StringVarP(&bo.output, options.FlagResultsFile, options.ShorthandFlagResultsFile, "",
+		"file to write results to, instead of stdout")
+	cmd.Flags().StringVar(&bo.checkpoint, "checkpoint", "",
+		"file recording analyzed repositories, used to resume an interrupted run")
+	cmd.Flags().IntVar(&bo.parallelism, "parallelism", bo.parallelism, "number of repositories analyzed concurrently")
+	cmd.Flags().StringSliceVar(&o.ChecksToRun, options.FlagChecks, o.ChecksToRun, "Checks to run.")
+	cmd.Flags().BoolVar(&o.ShowDetails, options.FlagShowDetails, o.ShowDetails, "show extra details about each check")
+	cmd.Flags().IntVar(&o.CommitDepth, options.FlagCommitDepth, o.CommitDepth,
+		"number of commits to check, commits begin backwards from the HEAD")
+	//nolint:errcheck // only fails if the flag doesn't exist.
+	cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+// runFunc analyzes a single repository.
+type runFunc func(ctx context.Context, repo data.RepoFormat, githubClient clients.RepoClient) (scorecard.Result, error)
+
+func runBatch(ctx context.Context, o *options.Options, bo *batchOptions) error {
+	logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+
+	checkDocs, err := docs.Read()
+	if err != nil {
+		return fmt.Errorf("cannot read yaml file: %w", err)
+	}
+	enabledChecks, err := policy.GetEnabled(nil, o.Checks(), nil)
+	if err != nil {
+		return fmt.Errorf("GetEnabled: %w", err)
+	}
+	checks := make([]string, 0, len(enabledChecks))
+	for c := range enabledChecks {
+		checks = append(checks, c)
+	}
+
+	// Clients which are safe to share between repositories are only created once.
+	// Each worker gets its own GitHub client, but all of them share the token pool.
+	rt := roundtripper.NewTransport(ctx, logger)
+	ossFuzzClient, err := ossfuzz.CreateOSSFuzzClientEager(ossfuzz.StatusURL)
+	if err != nil {
+		return fmt.Errorf("ossfuzz.CreateOSSFuzzClientEager: %w", err)
+	}
+	defer ossFuzzClient.Close()
+	ciiClient := clients.DefaultCIIBestPracticesClient()
+	vulnClient := clients.DefaultVulnerabilitiesClient()
+
+	run := func(ctx context.Context, r data.RepoFormat, githubClient clients.RepoClient) (scorecard.Result, error) {
+		repo, err := makeRepo(r.Repo)
+		if err != nil {
+			return scorecard.Result{}, err
+		}
+		repo.AppendMetadata(r.Metadata...)
+		opts := []scorecard.Option{
+			scorecard.WithLogLevel(sclog.ParseLevel(o.LogLevel)),
+			scorecard.WithCommitDepth(o.CommitDepth),
+			scorecard.WithChecks(checks),
+			scorecard.WithOSSFuzzClient(ossFuzzClient),
+			scorecard.WithOpenSSFBestPraticesClient(ciiClient),
+			scorecard.WithVulnerabilitiesClient(vulnClient),
+		}
+		if _, ok := repo.(*githubrepo.Repo); ok {
+			opts = append(opts, scorecard.WithRepoClient(githubClient))
+		}
+		result, err := scorecard.Run(ctx, repo, opts...)
+		if err != nil {
+			return scorecard.Result{}, fmt.Errorf("scorecard.Run: %w", err)
+		}
+		return result, nil
+	}
+	newGithubClient := func() clients.RepoClient {
+		return githubrepo.CreateGithubRepoClientWithTransport(ctx, rt)
+	}
+
+	jsonOpts := &scorecard.AsJSON2ResultOption{
+		LogLevel: sclog.ParseLevel(o.LogLevel),
+		Details:  o.ShowDetails,
+	}
+	format := func(result *scorecard.Result, w io.Writer) error {
+		return result.AsJSON2(w, checkDocs, jsonOpts)
+	}
+	return batch(ctx, bo, logger, run, newGithubClient, format)
+}
+
+func batch(ctx context.Context, bo *batchOptions, logger *sclog.Logger, run runFunc,
+	newGithubClient func() clients.RepoClient, format func(*scorecard.Result, io.Writer) error,
+) error {
+	if bo.checkpoint != "" && bo.output == "" {
+		return errCheckpointWithoutOutput
+	}
+	input, err := os.Open(bo.input)
+	if err != nil {
+		return fmt.Errorf("opening input: %w", err)
+	}
+	defer input.Close()
+	iter, err := data.MakeIteratorFrom(input)
+	if err != nil {
+		return fmt.Errorf("data.MakeIteratorFrom: %w", err)
+	}
+
+	done := map[string]bool{}
+	var checkpoint *os.File
+	var recorded int
+	if bo.checkpoint != "" {
+		checkpoint, err = os.OpenFile(bo.checkpoint, os.O_CREATE|os.O_RDWR, 0o644)
+		if err != nil {
+			return fmt.Errorf("opening checkpoint: %w", err)
+		}
+		defer checkpoint.Close()
+		if done, recorded, err = readCheckpoint(checkpoint); err != nil {
+			return err
+		}
+	}
+
+	var output io.Writer = os.Stdout
+	if bo.output != "" {
+		flags := os.O_CREATE | os.O_RDWR | os.O_TRUNC
+		if checkpoint != nil {
+			// Resume: keep the results of the repositories in the checkpoint.
+			flags = os.O_CREATE | os.O_RDWR
+		}
+		f, err := os.OpenFile(bo.output, flags, 0o644)
+		if err != nil {
+			return fmt.Errorf("opening output: %w", err)
+		}
+		defer f.Close()
+		if checkpoint != nil {
+			if err := truncateLines(f, recorded); err != nil {
+				return err
+			}
+		}
+		output = f
+	}
+
+	w := &batchWriter{output: output, checkpoint: checkpoint}
+	repos := make(chan data.RepoFormat)
+	var failures atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < max(bo.parallelism, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			githubClient := newGithubClient()
+			for r := range repos {
+				logger.Info("Running Scorecard for repo: " + r.Repo)
+				result, err := run(ctx, r, githubClient)
+				if err == nil {
+					err = w.write(r.Repo, &result, format)
+				}
+				if err != nil {
+					logger.Error(err, "analyzing "+r.Repo)
+					failures.Add(1)
+				}
+			}
+		}()
+	}
+
+	for iter.HasNext() {
+		r, err := iter.Next()
+		if err != nil {
+			logger.Error(err, "reading input")
+			failures.Add(1)
+			continue
+		}
+		if done[r.Repo] {
+			continue
+		}
+		repos <- r
+	}
+	close(repos)
+	wg.Wait()
+
+	if n := failures.Load(); n > 0 {
+		return sce.WithMessage(errBatchFailures, fmt.Sprintf("%d repositories", n))
+	}
+	return nil
+}
+
+// batchWriter serializes writes of results from concurrent workers.
+type batchWriter struct {
+	output     io.Writer
+	checkpoint *os.File
+	mu         sync.Mutex
+}
+
+// write appends the result to the output, and then records the repository
+// in the checkpoint, so a crash never loses a recorded result. Both files are
+// written in the same order, so the output can be cut back to the recorded
+// results when resuming.
+func (w *batchWriter) write(repo string, result *scorecard.Result, format func(*scorecard.Result, io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := format(result, &buf); err != nil {
+		return fmt.Errorf("formatting results: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.output.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("writing results: %w", err)
+	}
+	if w.checkpoint == nil {
+		return nil
+	}
+	if f, ok := w.output.(*os.File); ok {
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("syncing results: %w", err)
+		}
+	}
+	if _, err := fmt.Fprintln(w.checkpoint, repo); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	if err := w.checkpoint.Sync(); err != nil {
+		return fmt.Errorf("syncing checkpoint: %w", err)
+	}
+	return nil
+}
+
+// readCheckpoint returns the repositories recorded in the checkpoint file,
+// and the number of records, and positions f at its end for appending.
+func readCheckpoint(f *os.File) (map[string]bool, int, error) {
+	if err := truncatePartialLine(f); err != nil {
+		return nil, 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, fmt.Errorf("seeking checkpoint: %w", err)
+	}
+	done := map[string]bool{}
+	var n int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n++
+		if line := scanner.Text(); line != "" {
+			done[line] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("reading checkpoint: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return nil, 0, fmt.Errorf("seeking checkpoint: %w", err)
+	}
+	return done, n, nil
+}
+
+// truncateLines keeps the first n complete lines of f, dropping results written
+// before a crash prevented recording them in the checkpoint, as they are analyzed
+// again, and positions f at its end for appending.
+func truncateLines(f *os.File, n int) error {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking output: %w", err)
+	}
+	r := bufio.NewReader(f)
+	var size int64
+	for i := 0; i < n; i++ {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading output: %w", err)
+		}
+		size += int64(len(line))
+	}
+	if err := f.Truncate(size); err != nil {
+		return fmt.Errorf("truncating output: %w", err)
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking output: %w", err)
+	}
+	return nil
+}
+
+// truncatePartialLine drops an incomplete last line, left behind by a crash
+// while a line was written, and positions f at its end for appending.
+func truncatePartialLine(f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat output: %w", err)
+	}
+	// Search backwards for the last newline, without reading the whole file.
+	const chunkSize = 64 * 1024
+	buf := make([]byte, chunkSize)
+	size := info.Size()
+	for size > 0 {
+		offset := max(size-chunkSize, 0)
+		n, err := f.ReadAt(buf[:size-offset], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading output: %w", err)
+		}
+		if i := bytes.LastIndexByte(buf[:n], '\n'); i >= 0 {
+			size = offset + int64(i) + 1
+			break
+		}
+		size = offset
+	}
+	if err := f.Truncate(size); err != nil {
+		return fmt.Errorf("truncating output: %w", err)
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking output: %w", err)
+	}
+	return nil
+}
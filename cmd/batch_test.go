@@ -0,0 +1,211 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/cron/data"
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var errFakeRun = errors.New("fake run error")
+
+func fakeFormat(result *scorecard.Result, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "{\"repo\":%q}\n", result.Repo.Name)
+	return err //nolint:wrapcheck
+}
+
+func sortedLines(t *testing.T, path string) []string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	sort.Strings(lines)
+	return lines
+}
+
+func Test_batch_resume(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	input := filepath.Join(dir, "repos.csv")
+	csv := "repo,metadata\ngithub.com/ossf/scorecard,\ngithub.com/ossf/scorecard-action,\ngithub.com/ossf/scorecard-webapp,\n"
+	if err := os.WriteFile(input, []byte(csv), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	bo := &batchOptions{
+		input:       input,
+		output:      filepath.Join(dir, "results.json"),
+		checkpoint:  filepath.Join(dir, "checkpoint"),
+		parallelism: 2,
+	}
+	logger := log.NewLogger(log.InfoLevel)
+	newGithubClient := func() clients.RepoClient { return nil }
+
+	var analyzed []string
+	run := func(fail string) runFunc {
+		return func(_ context.Context, r data.RepoFormat, _ clients.RepoClient) (scorecard.Result, error) {
+			if r.Repo == fail {
+				return scorecard.Result{}, errFakeRun
+			}
+			return scorecard.Result{Repo: scorecard.RepoInfo{Name: r.Repo}}, nil
+		}
+	}
+
+	// The first run fails for one repository.
+	err := batch(context.Background(), bo, logger, run("github.com/ossf/scorecard-action"), newGithubClient, fakeFormat)
+	if !errors.Is(err, errBatchFailures) {
+		t.Fatalf("batch: got %v, want %v", err, errBatchFailures)
+	}
+	// Simulate a crash after writing a result, but before recording it in the
+	// checkpoint, and another one while writing the next result and record.
+	appendString := func(path, s string) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			t.Fatalf("OpenFile: %v", err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString(s); err != nil {
+			t.Fatalf("WriteString: %v", err)
+		}
+	}
+	appendString(bo.output, "{\"repo\":\"github.com/ossf/scorecard-action\"}\n{\"repo\":\"partial")
+	appendString(bo.checkpoint, "github.com/ossf/scorecard-act")
+
+	// Resuming only analyzes the remaining repository.
+	resume := func(ctx context.Context, r data.RepoFormat, c clients.RepoClient) (scorecard.Result, error) {
+		analyzed = append(analyzed, r.Repo)
+		return run("")(ctx, r, c)
+	}
+	bo.parallelism = 1
+	if err := batch(context.Background(), bo, logger, resume, newGithubClient, fakeFormat); err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if diff := cmp.Diff([]string{"github.com/ossf/scorecard-action"}, analyzed); diff != "" {
+		t.Errorf("analyzed repos mismatch (-want +got):\n%s", diff)
+	}
+	wantResults := []string{
+		`{"repo":"github.com/ossf/scorecard"}`,
+		`{"repo":"github.com/ossf/scorecard-action"}`,
+		`{"repo":"github.com/ossf/scorecard-webapp"}`,
+	}
+	if diff := cmp.Diff(wantResults, sortedLines(t, bo.output)); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+	wantCheckpoint := []string{
+		"github.com/ossf/scorecard",
+		"github.com/ossf/scorecard-action",
+		"github.com/ossf/scorecard-webapp",
+	}
+	if diff := cmp.Diff(wantCheckpoint, sortedLines(t, bo.checkpoint)); diff != "" {
+		t.Errorf("checkpoint mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_batch_newCheckpoint(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	input := filepath.Join(dir, "repos.csv")
+	if err := os.WriteFile(input, []byte("repo,metadata\ngithub.com/ossf/scorecard,\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	bo := &batchOptions{
+		input:       input,
+		output:      filepath.Join(dir, "results.json"),
+		checkpoint:  filepath.Join(dir, "checkpoint"),
+		parallelism: 1,
+	}
+	// Results of a previous run, without a checkpoint, are overwritten.
+	if err := os.WriteFile(bo.output, []byte("{\"repo\":\"github.com/ossf/old\"}\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	run := func(_ context.Context, r data.RepoFormat, _ clients.RepoClient) (scorecard.Result, error) {
+		return scorecard.Result{Repo: scorecard.RepoInfo{Name: r.Repo}}, nil
+	}
+	err := batch(context.Background(), bo, log.NewLogger(log.InfoLevel), run,
+		func() clients.RepoClient { return nil }, fakeFormat)
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if diff := cmp.Diff([]string{`{"repo":"github.com/ossf/scorecard"}`}, sortedLines(t, bo.output)); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_batch_checkpointWithoutOutput(t *testing.T) {
+	t.Parallel()
+	bo := &batchOptions{input: "repos.csv", checkpoint: "checkpoint"}
+	err := batch(context.Background(), bo, log.NewLogger(log.InfoLevel), nil, nil, fakeFormat)
+	if !errors.Is(err, errCheckpointWithoutOutput) {
+		t.Fatalf("batch: got %v, want %v", err, errCheckpointWithoutOutput)
+	}
+}
+
+func Test_truncatePartialLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "empty", content: "", want: ""},
+		{name: "complete lines", content: "a\nb\n", want: "a\nb\n"},
+		{name: "partial line", content: "a\nb\nc", want: "a\nb\n"},
+		{name: "only partial line", content: "abc", want: ""},
+		{name: "long partial line", content: "a\n" + strings.Repeat("b", 100*1024), want: "a\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "results.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("WriteFile: %v", err)
+			}
+			f, err := os.OpenFile(path, os.O_RDWR, 0o600)
+			if err != nil {
+				t.Fatalf("OpenFile: %v", err)
+			}
+			defer f.Close()
+			if err := truncatePartialLine(f); err != nil {
+				t.Fatalf("truncatePartialLine: %v", err)
+			}
+			// Writes are appended after the truncated content.
+			if _, err := f.WriteString("x\n"); err != nil {
+				t.Fatalf("WriteString: %v", err)
+			}
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("ReadFile: %v", err)
+			}
+			if diff := cmp.Diff(tt.want+"x\n", string(got)); diff != "" {
+				t.Errorf("content mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
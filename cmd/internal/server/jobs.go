@@ -0,0 +1,275 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"container/list"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// Status is the state of a job.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Request is a scan job submission.
+type Request struct {
+	// Repo is the repository to analyze, on any supported forge.
+	Repo string `json:"repo"`
+	// Commit is the commit to analyze, HEAD if empty.
+	Commit string   `json:"commit,omitempty"`
+	Checks []string `json:"checks,omitempty"`
+	Probes []string `json:"probes,omitempty"`
+}
+
+// job is a submitted Request. Its fields are guarded by the jobStore mutex,
+// except for the immutable ones set at creation.
+type job struct {
+	createdAt  time.Time
+	startedAt  time.Time
+	finishedAt time.Time
+	repo       clients.Repo
+	result     *scorecard.Result
+	done       chan struct{}
+	id         string
+	key        string
+	status     Status
+	err        string
+	req        Request
+	cached     bool
+}
+
+// jobView is the JSON representation of a job.
+type jobView struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	ID         string     `json:"id"`
+	Status     Status     `json:"status"`
+	Repo       string     `json:"repo"`
+	Commit     string     `json:"commit"`
+	Error      string     `json:"error,omitempty"`
+	Checks     []string   `json:"checks,omitempty"`
+	Probes     []string   `json:"probes,omitempty"`
+	Cached     bool       `json:"cached"`
+}
+
+func (j *job) view() jobView {
+	v := jobView{
+		ID:        j.id,
+		Status:    j.status,
+		Repo:      j.repo.URI(),
+		Commit:    j.req.Commit,
+		Checks:    j.req.Checks,
+		Probes:    j.req.Probes,
+		Error:     j.err,
+		Cached:    j.cached,
+		CreatedAt: j.createdAt,
+	}
+	if j.result != nil {
+		v.Commit = j.result.Repo.CommitSHA
+	}
+	if !j.startedAt.IsZero() {
+		v.StartedAt = &j.startedAt
+	}
+	if !j.finishedAt.IsZero() {
+		v.FinishedAt = &j.finishedAt
+	}
+	return v
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating job id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// resultKey identifies the results of a request. Results only differ
+// between scorecard versions, so the version is part of the key.
+func resultKey(version, repo, commit string, checks, probes []string) string {
+	checks = slices.Sorted(slices.Values(checks))
+	probes = slices.Sorted(slices.Values(probes))
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%s", version, repo, strings.ToLower(commit),
+		strings.Join(checks, ","), strings.Join(probes, ","))
+}
+
+// jobStore holds the jobs, finished ones for the retention period only.
+type jobStore struct {
+	jobs      map[string]*job
+	inFlight  map[string]*job
+	mu        sync.Mutex
+	retention time.Duration
+}
+
+func newJobStore(retention time.Duration) *jobStore {
+	return &jobStore{
+		jobs:      make(map[string]*job),
+		inFlight:  make(map[string]*job),
+		retention: retention,
+	}
+}
+
+func (s *jobStore) get(id string) (*job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	return j, ok
+}
+
+// add stores j, unless an identical job is queued or running, which is
+// returned instead.
+func (s *jobStore) add(j *job) (*job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prune(j.createdAt)
+	if existing, ok := s.inFlight[j.key]; ok {
+		return existing, false
+	}
+	s.jobs[j.id] = j
+	if j.status == StatusQueued {
+		s.inFlight[j.key] = j
+	}
+	return j, true
+}
+
+// remove drops a job which couldn't be queued.
+func (s *jobStore) remove(j *job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, j.id)
+	if s.inFlight[j.key] == j {
+		delete(s.inFlight, j.key)
+	}
+}
+
+func (s *jobStore) start(j *job, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j.status = StatusRunning
+	j.startedAt = now
+}
+
+func (s *jobStore) finish(j *job, result *scorecard.Result, err error, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j.finishedAt = now
+	if err != nil {
+		j.status = StatusFailed
+		j.err = err.Error()
+	} else {
+		j.status = StatusSucceeded
+		j.result = result
+	}
+	if s.inFlight[j.key] == j {
+		delete(s.inFlight, j.key)
+	}
+	close(j.done)
+}
+
+func (s *jobStore) view(j *job) jobView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return j.view()
+}
+
+// state returns the status and result of j.
+func (s *jobStore) state(j *job) (Status, *scorecard.Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return j.status, j.result
+}
+
+// prune removes jobs finished before the retention period. s.mu must be held.
+func (s *jobStore) prune(now time.Time) {
+	for id, j := range s.jobs {
+		if !j.finishedAt.IsZero() && now.Sub(j.finishedAt) > s.retention {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+// resultCache is an LRU cache of results. Entries for HEAD expire, as the
+// commit they refer to changes.
+type resultCache struct {
+	entries map[string]*list.Element
+	order   *list.List
+	mu      sync.Mutex
+	size    int
+}
+
+type cacheEntry struct {
+	expires time.Time
+	result  *scorecard.Result
+	key     string
+}
+
+func newResultCache(size int) *resultCache {
+	return &resultCache{
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		size:    size,
+	}
+}
+
+func (c *resultCache) get(key string, now time.Time) (*scorecard.Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*cacheEntry) //nolint:errcheck,forcetypeassert // only *cacheEntry are stored
+	if !entry.expires.IsZero() && now.After(entry.expires) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return entry.result, true
+}
+
+// put stores result under key. A zero expires never expires.
+func (c *resultCache) put(key string, result *scorecard.Result, expires time.Time) {
+	if c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.Remove(e)
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, result: result, expires: expires})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key) //nolint:errcheck,forcetypeassert // see get
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// metrics are exposed in the Prometheus text format.
+type metrics struct {
+	submitted   atomic.Int64
+	rejected    atomic.Int64
+	cacheHits   atomic.Int64
+	succeeded   atomic.Int64
+	failed      atomic.Int64
+	queued      atomic.Int64
+	running     atomic.Int64
+	durationsMu sync.Mutex
+	// durationBuckets counts jobs by run time, cumulatively per upper bound.
+	durationBuckets []int64
+	durationSum     float64
+	durationCount   int64
+}
+
+// durationBounds are the upper bounds in seconds of the job duration histogram.
+var durationBounds = []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800}
+
+func newMetrics() *metrics {
+	return &metrics{durationBuckets: make([]int64, len(durationBounds))}
+}
+
+func (m *metrics) observeDuration(d time.Duration) {
+	m.durationsMu.Lock()
+	defer m.durationsMu.Unlock()
+	s := d.Seconds()
+	for i, bound := range durationBounds {
+		if s <= bound {
+			m.durationBuckets[i]++
+		}
+	}
+	m.durationSum += s
+	m.durationCount++
+}
+
+func (m *metrics) write(w io.Writer) error {
+	var err error
+	printf := func(format string, args ...any) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	counter := func(name, help string, v int64) {
+		printf("# HELP %s %s\n# TYPE %s counter\n%s %d\n", name, help, name, name, v)
+	}
+	gauge := func(name, help string, v int64) {
+		printf("# HELP %s %s\n# TYPE %s gauge\n%s %d\n", name, help, name, name, v)
+	}
+
+	counter("scorecard_serve_jobs_submitted_total", "Scan jobs submitted.", m.submitted.Load())
+	counter("scorecard_serve_jobs_rejected_total", "Scan jobs rejected because the queue was full.", m.rejected.Load())
+	counter("scorecard_serve_result_cache_hits_total", "Scan jobs answered from the result cache.", m.cacheHits.Load())
+	printf("# HELP scorecard_serve_jobs_finished_total Scan jobs finished, by status.\n")
+	printf("# TYPE scorecard_serve_jobs_finished_total counter\n")
+	printf("scorecard_serve_jobs_finished_total{status=%q} %d\n", StatusSucceeded, m.succeeded.Load())
+	printf("scorecard_serve_jobs_finished_total{status=%q} %d\n", StatusFailed, m.failed.Load())
+	gauge("scorecard_serve_jobs_queued", "Scan jobs waiting for a worker.", m.queued.Load())
+	gauge("scorecard_serve_jobs_running", "Scan jobs being run.", m.running.Load())
+
+	m.durationsMu.Lock()
+	defer m.durationsMu.Unlock()
+	const name = "scorecard_serve_job_duration_seconds"
+	printf("# HELP %s Time taken to run scan jobs.\n# TYPE %s histogram\n", name, name)
+	for i, bound := range durationBounds {
+		printf("%s_bucket{le=\"%g\"} %d\n", name, bound, m.durationBuckets[i])
+	}
+	printf("%s_bucket{le=\"%g\"} %d\n", name, math.Inf(1), m.durationCount)
+	printf("%s_sum %g\n%s_count %d\n", name, m.durationSum, name, m.durationCount)
+	return err
+}
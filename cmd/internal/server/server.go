@@ -0,0 +1,479 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server implements the scorecard HTTP API: scan jobs are submitted,
+// run by a bounded pool of workers, and their results fetched in any of the
+// output formats.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
+	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+const (
+	defaultWorkers      = 4
+	defaultQueueSize    = 100
+	defaultCacheSize    = 1000
+	defaultHeadTTL      = time.Hour
+	defaultJobRetention = 24 * time.Hour
+	maxRequestSize      = 64 << 10
+)
+
+var (
+	errQueueFull    = errors.New("job queue is full")
+	errMissingRepo  = errors.New("repo is required")
+	errUnknownProbe = errors.New("unknown probe")
+	errFormat       = errors.New("unsupported format")
+)
+
+// ScanFunc analyzes repo as described by req.
+type ScanFunc func(ctx context.Context, repo clients.Repo, req *Request) (*scorecard.Result, error)
+
+// Config configures a Server.
+type Config struct {
+	// MakeRepo parses the repository of a request, on any supported forge.
+	MakeRepo func(uri string) (clients.Repo, error)
+	// Scan runs the analysis.
+	Scan   ScanFunc
+	Logger *sclog.Logger
+	// Options are used to render results, e.g. whether to include details.
+	Options   *options.Options
+	CheckDocs docs.Doc
+	// Version is the scorecard version, results are only reused within a version.
+	Version string
+	// Workers is the number of jobs run concurrently.
+	Workers int
+	// QueueSize is the number of jobs waiting for a worker before new ones are rejected.
+	QueueSize int
+	// CacheSize is the number of results kept to answer identical requests.
+	CacheSize int
+	// HeadTTL is how long results for HEAD are reused.
+	HeadTTL time.Duration
+	// JobRetention is how long finished jobs can be fetched.
+	JobRetention time.Duration
+}
+
+// Server runs scan jobs submitted over HTTP.
+type Server struct {
+	cfg     Config
+	jobs    *jobStore
+	cache   *resultCache
+	metrics *metrics
+	queue   chan *job
+	now     func() time.Time
+	wg      sync.WaitGroup
+}
+
+// New returns a Server, applying defaults to unset fields of cfg.
+func New(cfg Config) *Server {
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultWorkers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	if cfg.CacheSize == 0 {
+		cfg.CacheSize = defaultCacheSize
+	}
+	if cfg.HeadTTL == 0 {
+		cfg.HeadTTL = defaultHeadTTL
+	}
+	if cfg.JobRetention == 0 {
+		cfg.JobRetention = defaultJobRetention
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = sclog.NewLogger(sclog.DefaultLevel)
+	}
+	if cfg.Options == nil {
+		cfg.Options = options.New()
+	}
+	return &Server{
+		cfg:     cfg,
+		jobs:    newJobStore(cfg.JobRetention),
+		cache:   newResultCache(cfg.CacheSize),
+		metrics: newMetrics(),
+		queue:   make(chan *job, cfg.QueueSize),
+		now:     time.Now,
+	}
+}
+
+// Start starts the workers. They stop once ctx is done, after finishing
+// the job they are running.
+func (s *Server) Start(ctx context.Context) {
+	for range s.cfg.Workers {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-s.queue:
+					s.run(ctx, j)
+				}
+			}
+		}()
+	}
+}
+
+// Wait waits for the workers to stop.
+func (s *Server) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Server) run(ctx context.Context, j *job) {
+	s.metrics.queued.Add(-1)
+	s.metrics.running.Add(1)
+	defer s.metrics.running.Add(-1)
+
+	start := s.now()
+	s.jobs.start(j, start)
+	result, err := s.cfg.Scan(ctx, j.repo, &j.req)
+	finished := s.now()
+	s.metrics.observeDuration(finished.Sub(start))
+	if err != nil {
+		s.cfg.Logger.Error(err, fmt.Sprintf("running job %s for %s", j.id, j.repo.URI()))
+		s.metrics.failed.Add(1)
+		s.jobs.finish(j, nil, err, finished)
+		return
+	}
+	s.metrics.succeeded.Add(1)
+	s.jobs.finish(j, result, nil, finished)
+
+	// Results for a commit never change, results for HEAD only until the next push.
+	s.cache.put(s.resultKey(j.repo, result.Repo.CommitSHA, &j.req), result, time.Time{})
+	if isHead(j.req.Commit) {
+		s.cache.put(j.key, result, finished.Add(s.cfg.HeadTTL))
+	}
+}
+
+func isHead(commit string) bool {
+	return strings.EqualFold(commit, clients.HeadSHA)
+}
+
+func (s *Server) resultKey(repo clients.Repo, commit string, req *Request) string {
+	return resultKey(s.cfg.Version, repo.URI(), commit, req.Checks, req.Probes)
+}
+
+// submit creates a job for req, answering it from the cache when possible.
+func (s *Server) submit(req *Request) (*job, error) {
+	if req.Repo == "" {
+		return nil, errMissingRepo
+	}
+	if req.Commit == "" {
+		req.Commit = clients.HeadSHA
+	}
+	repo, err := s.cfg.MakeRepo(req.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("parsing repo: %w", err)
+	}
+	if _, err := policy.GetEnabled(nil, req.Checks, nil); err != nil {
+		return nil, fmt.Errorf("checks: %w", err)
+	}
+	for _, p := range req.Probes {
+		if _, err := proberegistration.Get(p); err != nil {
+			return nil, fmt.Errorf("%w: %s", errUnknownProbe, p)
+		}
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	j := &job{
+		id:        id,
+		req:       *req,
+		repo:      repo,
+		key:       s.resultKey(repo, req.Commit, req),
+		status:    StatusQueued,
+		createdAt: now,
+		done:      make(chan struct{}),
+	}
+	s.metrics.submitted.Add(1)
+
+	if result, ok := s.cache.get(j.key, now); ok {
+		s.metrics.cacheHits.Add(1)
+		j.status = StatusSucceeded
+		j.result = result
+		j.cached = true
+		j.startedAt = now
+		j.finishedAt = now
+		close(j.done)
+		j, _ = s.jobs.add(j)
+		return j, nil
+	}
+
+	j, added := s.jobs.add(j)
+	if !added {
+		return j, nil
+	}
+	// Count the job as queued before a worker can pick it up.
+	s.metrics.queued.Add(1)
+	select {
+	case s.queue <- j:
+		return j, nil
+	default:
+		s.metrics.queued.Add(-1)
+		s.jobs.remove(j)
+		s.metrics.rejected.Add(1)
+		return nil, errQueueFull
+	}
+}
+
+// Handler returns the HTTP API:
+//
+//	POST /v1/jobs                 submit a Request, returns the job
+//	GET  /v1/jobs/{id}            job status
+//	GET  /v1/jobs/{id}/result     result, in the format given by the format query parameter
+//	GET  /metrics                 metrics in the Prometheus text format
+//	GET  /healthz                 liveness
+//	GET  /?repo=<repo>            synchronous scan, as HTML or, with a JSON Content-Type, JSON
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/jobs", s.handleSubmit)
+	mux.HandleFunc("GET /v1/jobs/{id}", s.handleStatus)
+	mux.HandleFunc("GET /v1/jobs/{id}/result", s.handleResult)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /{$}", s.handleScan)
+	return mux
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.cfg.Logger.Error(err, "writing response")
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	s.writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func (s *Server) writeSubmitError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errQueueFull) {
+		w.Header().Set("Retry-After", "60")
+		s.writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	s.writeError(w, http.StatusBadRequest, err)
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("decoding request: %w", err))
+		return
+	}
+	j, err := s.submit(&req)
+	if err != nil {
+		s.writeSubmitError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/v1/jobs/"+j.id)
+	view := s.jobs.view(j)
+	status := http.StatusAccepted
+	if view.Status == StatusSucceeded {
+		status = http.StatusOK
+	}
+	s.writeJSON(w, status, view)
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	j, ok := s.jobs.get(r.PathValue("id"))
+	if !ok {
+		s.writeError(w, http.StatusNotFound, fmt.Errorf("job %q not found", r.PathValue("id")))
+		return
+	}
+	s.writeJSON(w, http.StatusOK, s.jobs.view(j))
+}
+
+func (s *Server) handleResult(w http.ResponseWriter, r *http.Request) {
+	j, ok := s.jobs.get(r.PathValue("id"))
+	if !ok {
+		s.writeError(w, http.StatusNotFound, fmt.Errorf("job %q not found", r.PathValue("id")))
+		return
+	}
+	status, result := s.jobs.state(j)
+	switch status {
+	case StatusSucceeded:
+	case StatusFailed:
+		s.writeJSON(w, http.StatusConflict, s.jobs.view(j))
+		return
+	default:
+		w.Header().Set("Retry-After", "10")
+		s.writeJSON(w, http.StatusConflict, s.jobs.view(j))
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = options.FormatJSON
+	}
+	showDetails := s.cfg.Options.ShowDetails
+	if d := r.URL.Query().Get("details"); d != "" {
+		v, err := strconv.ParseBool(d)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, fmt.Errorf("details: %w", err))
+			return
+		}
+		showDetails = v
+	}
+	contentType, ok := contentTypes[format]
+	if !ok {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %s", errFormat, format))
+		return
+	}
+	// Render before writing the header, so errors can still be reported.
+	var buf strings.Builder
+	if err := s.writeResult(&buf, result, format, showDetails); err != nil {
+		s.cfg.Logger.Error(err, "formatting result")
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	if _, err := io.WriteString(w, buf.String()); err != nil {
+		s.cfg.Logger.Error(err, "writing result")
+	}
+}
+
+var contentTypes = map[string]string{
+	options.FormatJSON:   "application/json",
+	options.FormatSarif:  "application/sarif+json",
+	options.FormatProbe:  "application/json",
+	options.FormatInToto: "application/vnd.in-toto+json",
+}
+
+func (s *Server) writeResult(w io.Writer, result *scorecard.Result, format string, showDetails bool) error {
+	logLevel := sclog.ParseLevel(s.cfg.Options.LogLevel)
+	jsonOpts := scorecard.AsJSON2ResultOption{
+		Details:     showDetails,
+		Annotations: s.cfg.Options.ShowAnnotations,
+		LogLevel:    logLevel,
+	}
+	var err error
+	switch format {
+	case options.FormatJSON:
+		err = result.AsJSON2(w, s.cfg.CheckDocs, &jsonOpts)
+	case options.FormatSarif:
+		err = result.AsSARIF(showDetails, logLevel, w, s.cfg.CheckDocs, enforceAll(result), s.cfg.Options)
+	case options.FormatProbe:
+		err = result.AsProbe(w, nil)
+	case options.FormatInToto:
+		err = result.AsInToto(w, s.cfg.CheckDocs, &scorecard.AsInTotoResultOption{AsJSON2ResultOption: jsonOpts})
+	default:
+		err = fmt.Errorf("%w: %s", errFormat, format)
+	}
+	if err != nil {
+		return fmt.Errorf("formatting result as %s: %w", format, err)
+	}
+	return nil
+}
+
+// enforceAll is the SARIF policy reporting every check below the maximum score.
+func enforceAll(result *scorecard.Result) *policy.ScorecardPolicy {
+	p := &policy.ScorecardPolicy{
+		Version:  1,
+		Policies: make(map[string]*policy.CheckPolicy, len(result.Checks)),
+	}
+	for i := range result.Checks {
+		p.Policies[result.Checks[i].Name] = &policy.CheckPolicy{
+			Score: checker.MaxResultScore,
+			Mode:  policy.CheckPolicy_ENFORCED,
+		}
+	}
+	return p
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := s.metrics.write(w); err != nil {
+		s.cfg.Logger.Error(err, "writing metrics")
+	}
+}
+
+// handleScan serves the original synchronous endpoint on top of the job queue.
+func (s *Server) handleScan(w http.ResponseWriter, r *http.Request) {
+	j, err := s.submit(&Request{Repo: r.URL.Query().Get("repo")})
+	if err != nil {
+		s.writeSubmitError(w, err)
+		return
+	}
+	select {
+	case <-j.done:
+	case <-r.Context().Done():
+		return
+	}
+	status, result := s.jobs.state(j)
+	if status != StatusSucceeded {
+		s.writeJSON(w, http.StatusInternalServerError, s.jobs.view(j))
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.Header().Set("Content-Type", "application/json")
+		if err := result.AsJSON(s.cfg.Options.ShowDetails, sclog.ParseLevel(s.cfg.Options.LogLevel), w); err != nil {
+			s.cfg.Logger.Error(err, "formatting result")
+		}
+		return
+	}
+	if err := page.Execute(w, result); err != nil {
+		s.cfg.Logger.Error(err, "rendering result page")
+	}
+}
+
+var page = template.Must(template.New("webpage").Parse(`
+<!DOCTYPE html>
+<html>
+	<head>
+		<meta charset="UTF-8">
+		<title>Scorecard Results for: {{.Repo.Name}}</title>
+	</head>
+	<body>
+		{{range .Checks}}
+			<div>
+				<p>{{ .Name }}: {{ .Score }}</p>
+			</div>
+		{{end}}
+	</body>
+</html>`))
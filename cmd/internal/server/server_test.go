@@ -0,0 +1,334 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+const testCommit = "0123456789abcdef0123456789abcdef01234567"
+
+// fakeScanner counts scans, and blocks them until release is closed.
+type fakeScanner struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (f *fakeScanner) scan(ctx context.Context, repo clients.Repo, req *Request) (*scorecard.Result, error) {
+	f.calls.Add(1)
+	if f.release != nil {
+		<-f.release
+	}
+	commit := req.Commit
+	if strings.EqualFold(commit, clients.HeadSHA) {
+		commit = testCommit
+	}
+	return &scorecard.Result{
+		Repo: scorecard.RepoInfo{Name: repo.URI(), CommitSHA: commit},
+		Checks: []checker.CheckResult{
+			{Name: "Maintained", Score: 7, Reason: "some activity"},
+		},
+	}, nil
+}
+
+func newTestServer(t *testing.T, f *fakeScanner, cfg Config) *httptest.Server {
+	t.Helper()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	cfg.MakeRepo = func(uri string) (clients.Repo, error) {
+		return githubrepo.MakeGithubRepo(uri)
+	}
+	cfg.Scan = f.scan
+	cfg.CheckDocs = checkDocs
+	cfg.Version = "v5.0.0"
+	s := New(cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(func() {
+		ts.Close()
+		cancel()
+		s.Wait()
+	})
+	return ts
+}
+
+func submit(t *testing.T, ts *httptest.Server, body string) (int, jobView) {
+	t.Helper()
+	resp, err := http.Post(ts.URL+"/v1/jobs", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("submitting: %v", err)
+	}
+	defer resp.Body.Close()
+	var v jobView
+	if resp.StatusCode < 300 {
+		if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+			t.Fatalf("decoding job: %v", err)
+		}
+		if got, want := resp.Header.Get("Location"), "/v1/jobs/"+v.ID; got != want {
+			t.Errorf("Location = %q, want %q", got, want)
+		}
+	}
+	return resp.StatusCode, v
+}
+
+func get(t *testing.T, url string) (*http.Response, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading %s: %v", url, err)
+	}
+	return resp, string(body)
+}
+
+func wait(t *testing.T, ts *httptest.Server, id string) jobView {
+	t.Helper()
+	for range 100 {
+		_, body := get(t, ts.URL+"/v1/jobs/"+id)
+		var v jobView
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			t.Fatalf("decoding job: %v", err)
+		}
+		if v.Status == StatusSucceeded || v.Status == StatusFailed {
+			return v
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not finish", id)
+	return jobView{}
+}
+
+func TestServer_results(t *testing.T) {
+	t.Parallel()
+	ts := newTestServer(t, &fakeScanner{}, Config{})
+	status, v := submit(t, ts, `{"repo": "github.com/ossf/scorecard", "checks": ["Maintained"]}`)
+	if status != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d", status, http.StatusAccepted)
+	}
+	if v := wait(t, ts, v.ID); v.Commit != testCommit {
+		t.Errorf("commit = %q, want %q", v.Commit, testCommit)
+	}
+
+	tests := []struct {
+		name        string
+		format      string
+		contentType string
+		contains    string
+		wantStatus  int
+	}{
+		{
+			name:        "default",
+			wantStatus:  http.StatusOK,
+			contentType: "application/json",
+			contains:    `"name":"Maintained"`,
+		},
+		{
+			name:        "sarif",
+			format:      "sarif",
+			wantStatus:  http.StatusOK,
+			contentType: "application/sarif+json",
+			contains:    `"MaintainedID"`,
+		},
+		{
+			name:        "intoto",
+			format:      "intoto",
+			wantStatus:  http.StatusOK,
+			contentType: "application/vnd.in-toto+json",
+			contains:    `"predicate_type"`,
+		},
+		{
+			name:       "unsupported",
+			format:     "yaml",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp, body := get(t, ts.URL+"/v1/jobs/"+v.ID+"/result?format="+tt.format)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.contentType != "" && resp.Header.Get("Content-Type") != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", resp.Header.Get("Content-Type"), tt.contentType)
+			}
+			if !strings.Contains(body, tt.contains) {
+				t.Errorf("result does not contain %s: %s", tt.contains, body)
+			}
+		})
+	}
+}
+
+func TestServer_submit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "missing repo",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid repo",
+			body:       `{"repo": "not a repo"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown check",
+			body:       `{"repo": "github.com/ossf/scorecard", "checks": ["Nope"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown probe",
+			body:       `{"repo": "github.com/ossf/scorecard", "probes": ["nope"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown field",
+			body:       `{"repo": "github.com/ossf/scorecard", "branch": "main"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "valid",
+			body:       `{"repo": "github.com/ossf/scorecard", "commit": "` + testCommit + `"}`,
+			wantStatus: http.StatusAccepted,
+		},
+	}
+	ts := newTestServer(t, &fakeScanner{}, Config{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if status, _ := submit(t, ts, tt.body); status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestServer_unknownJob(t *testing.T) {
+	t.Parallel()
+	ts := newTestServer(t, &fakeScanner{}, Config{})
+	for _, path := range []string{"/v1/jobs/nope", "/v1/jobs/nope/result"} {
+		if resp, _ := get(t, ts.URL+path); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GET %s: status = %d, want %d", path, resp.StatusCode, http.StatusNotFound)
+		}
+	}
+}
+
+func TestServer_cache(t *testing.T) {
+	t.Parallel()
+	f := &fakeScanner{}
+	ts := newTestServer(t, f, Config{})
+	_, first := submit(t, ts, `{"repo": "github.com/ossf/scorecard", "checks": ["Maintained"]}`)
+	wait(t, ts, first.ID)
+
+	// The same request for HEAD, and for the commit HEAD resolved to, are both answered from the cache.
+	for _, body := range []string{
+		`{"repo": "github.com/ossf/scorecard", "checks": ["Maintained"]}`,
+		`{"repo": "github.com/ossf/scorecard", "checks": ["Maintained"], "commit": "` + testCommit + `"}`,
+	} {
+		status, v := submit(t, ts, body)
+		if status != http.StatusOK || !v.Cached || v.Status != StatusSucceeded {
+			t.Errorf("status = %d, job = %+v, want cached result", status, v)
+		}
+	}
+	// Different checks are a different result.
+	_, other := submit(t, ts, `{"repo": "github.com/ossf/scorecard", "checks": ["Maintained", "Fuzzing"]}`)
+	wait(t, ts, other.ID)
+	if got := f.calls.Load(); got != 2 {
+		t.Errorf("scans = %d, want 2", got)
+	}
+	_, metrics := get(t, ts.URL+"/metrics")
+	for _, want := range []string{
+		"scorecard_serve_jobs_submitted_total 4\n",
+		"scorecard_serve_result_cache_hits_total 2\n",
+		"scorecard_serve_jobs_finished_total{status=\"succeeded\"} 2\n",
+		"scorecard_serve_job_duration_seconds_count 2\n",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, metrics)
+		}
+	}
+}
+
+func TestServer_queue(t *testing.T) {
+	t.Parallel()
+	f := &fakeScanner{release: make(chan struct{})}
+	ts := newTestServer(t, f, Config{Workers: 1, QueueSize: 1})
+	var once sync.Once
+	release := func() { once.Do(func() { close(f.release) }) }
+	t.Cleanup(release)
+
+	_, running := submit(t, ts, `{"repo": "github.com/ossf/scorecard"}`)
+	for f.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	_, queued := submit(t, ts, `{"repo": "github.com/ossf/scorecard-action"}`)
+	// An identical request joins the queued job.
+	if _, dup := submit(t, ts, `{"repo": "github.com/ossf/scorecard-action"}`); dup.ID != queued.ID {
+		t.Errorf("duplicate job = %s, want %s", dup.ID, queued.ID)
+	}
+	if status, _ := submit(t, ts, `{"repo": "github.com/ossf/scorecard-webapp"}`); status != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", status, http.StatusServiceUnavailable)
+	}
+	if resp, _ := get(t, ts.URL+"/v1/jobs/"+running.ID+"/result"); resp.StatusCode != http.StatusConflict {
+		t.Errorf("result of running job: status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+
+	release()
+	got := []Status{wait(t, ts, running.ID).Status, wait(t, ts, queued.ID).Status}
+	if diff := cmp.Diff([]Status{StatusSucceeded, StatusSucceeded}, got); diff != "" {
+		t.Errorf("statuses (-want,+got): %s", diff)
+	}
+}
+
+func Test_resultKey(t *testing.T) {
+	t.Parallel()
+	a := resultKey("v5", "github.com/a/b", "HEAD", []string{"A", "B"}, nil)
+	b := resultKey("v5", "github.com/a/b", "head", []string{"B", "A"}, nil)
+	if a != b {
+		t.Errorf("keys differ for the same request: %q, %q", a, b)
+	}
+	if c := resultKey("v6", "github.com/a/b", "HEAD", []string{"A", "B"}, nil); a == c {
+		t.Errorf("keys equal across versions: %q", a)
+	}
+}
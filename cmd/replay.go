@@ -0,0 +1,77 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"sigs.k8s.io/release-utils/version"
+
+	"github.com/ossf/scorecard/v5/clients/replay"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// startRecordOrReplay starts recording or replaying the HTTP exchanges of a run,
+// as requested by o. It returns the options scorecard.Run needs to reproduce
+// the recorded run, and a function to call with the result once the run is done.
+func startRecordOrReplay(o *options.Options) ([]scorecard.Option, func(*scorecard.Result) error, error) {
+	if o.Record == "" && o.Replay == "" {
+		return nil, func(*scorecard.Result) error { return nil }, nil
+	}
+	scorecardVersion := version.GetVersionInfo().GitVersion
+
+	var opts []scorecard.Option
+	var finish func(*scorecard.Result) error
+	if o.Record != "" {
+		recorder, err := replay.StartRecording(o.Record, scorecardVersion)
+		if err != nil {
+			return nil, nil, fmt.Errorf("starting recording: %w", err)
+		}
+		finish = func(result *scorecard.Result) error {
+			recorder.SetDate(result.Date)
+			if err := recorder.Close(); err != nil {
+				return fmt.Errorf("finishing recording: %w", err)
+			}
+			return nil
+		}
+	} else {
+		player, err := replay.StartReplay(o.Replay)
+		if err != nil {
+			return nil, nil, fmt.Errorf("starting replay: %w", err)
+		}
+		if v := player.ScorecardVersion(); v != scorecardVersion {
+			fmt.Fprintf(os.Stderr, "Replaying a run recorded with scorecard %s, results may differ\n", v)
+		}
+		opts = append(opts, scorecard.WithDate(player.Date()))
+		finish = func(*scorecard.Result) error {
+			if err := player.Close(); err != nil {
+				return fmt.Errorf("finishing replay: %w", err)
+			}
+			return nil
+		}
+	}
+
+	// osv-scanner, and the OSS-Fuzz and OpenSSF Best Practices clients,
+	// send their requests with http.DefaultClient.
+	defaultTransport := http.DefaultClient.Transport
+	http.DefaultClient.Transport = replay.Wrap(http.DefaultTransport)
+	return opts, func(result *scorecard.Result) error {
+		http.DefaultClient.Transport = defaultTransport
+		return finish(result)
+	}, nil
+}
@@ -29,8 +29,11 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 	"github.com/ossf/scorecard/v5/clients/localdir"
 	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
@@ -54,6 +57,9 @@ func New(o *options.Options) *cobra.Command {
 		Use:   scorecardUse,
 		Short: scorecardShort,
 		Long:  scorecardLong,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			httpcache.Configure(o.HTTPCache())
+		},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			err := o.Validate()
 			if err != nil {
@@ -72,6 +78,7 @@ func New(o *options.Options) *cobra.Command {
 
 	// Add sub-commands.
 	cmd.AddCommand(serveCmd(o))
+	cmd.AddCommand(batchCmd(o))
 	cmd.AddCommand(version.Version())
 	return cmd
 }
@@ -118,12 +125,16 @@ func rootCmd(o *options.Options) error {
 	}
 
 	var requiredRequestTypes []checker.RequestType
-	// if local option not set add file based
-	if o.Local != "" {
-		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
+	// if local option set add file based, or git history based for git repositories
+	if localRepo, ok := repo.(*localdir.Repo); ok {
+		if localRepo.HasGitHistory() {
+			requiredRequestTypes = append(requiredRequestTypes, checker.GitHistoryBased)
+		} else {
+			requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
+		}
 	}
-	// if commit option set to anything other than HEAD add commit based
-	if !strings.EqualFold(o.Commit, clients.HeadSHA) {
+	// if commit option set to anything other than HEAD, or a base commit is set, add commit based
+	if !strings.EqualFold(o.Commit, clients.HeadSHA) || o.Base != "" {
 		requiredRequestTypes = append(requiredRequestTypes, checker.CommitBased)
 	}
 	// this call to policy is different from the one in scorecard.Run
@@ -154,11 +165,24 @@ func rootCmd(o *options.Options) error {
 		scorecard.WithProbes(enabledProbes),
 		scorecard.WithChecks(checks),
 	}
+	if o.Base != "" {
+		opts = append(opts, scorecard.WithBaseCommitSHA(o.Base))
+	}
 	if strings.EqualFold(o.FileMode, options.FileModeGit) {
 		opts = append(opts, scorecard.WithFileModeGit())
 	}
 
+	replayOpts, finishReplay, err := startRecordOrReplay(o)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, replayOpts...)
+
 	repoResult, err = scorecard.Run(ctx, repo, opts...)
+	// The recording is kept even if the run fails, to debug the failure.
+	if finishErr := finishReplay(&repoResult); finishErr != nil && err == nil {
+		err = finishErr
+	}
 	if err != nil {
 		return fmt.Errorf("scorecard.Run: %w", err)
 	}
@@ -223,11 +247,11 @@ func printCheckResults(enabledChecks checker.CheckNameToFnMap) {
 }
 
 // makeRepo helps turn a URI into the appropriate clients.Repo.
-// currently this is a decision between GitHub, GitLab, and Azure DevOps,
+// currently this is a decision between GitHub, GitLab, Bitbucket, Azure DevOps and Gitea,
 // but may expand in the future.
 func makeRepo(uri string) (clients.Repo, error) {
 	var repo clients.Repo
-	var errGitHub, errGitLab, errAzureDevOps error
+	var errGitHub, errGitLab, errAzureDevOps, errBitbucket, errGitea error
 	var compositeErr error
 
 	repo, errGitHub = githubrepo.MakeGithubRepo(uri)
@@ -244,12 +268,24 @@ func makeRepo(uri string) (clients.Repo, error) {
 
 	_, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL")
 	if experimental {
+		repo, errBitbucket = bitbucketrepo.MakeBitbucketRepo(uri)
+		if errBitbucket == nil {
+			return repo, nil
+		}
+		compositeErr = errors.Join(compositeErr, errBitbucket)
+
 		repo, errAzureDevOps = azuredevopsrepo.MakeAzureDevOpsRepo(uri)
 		if errAzureDevOps == nil {
 			return repo, nil
 		}
 		compositeErr = errors.Join(compositeErr, errAzureDevOps)
+
+		repo, errGitea = gitearepo.MakeGiteaRepo(uri)
+		if errGitea == nil {
+			return repo, nil
+		}
+		compositeErr = errors.Join(compositeErr, errGitea)
 	}
 
-	return nil, fmt.Errorf("unable to parse as github, gitlab, or azuredevops: %w", compositeErr)
+	return nil, fmt.Errorf("unable to parse as github, gitlab, azuredevops, bitbucket, or gitea: %w", compositeErr)
 }
@@ -0,0 +1,85 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
+)
+
+func Test_makeRepo(t *testing.T) {
+	t.Setenv("SCORECARD_EXPERIMENTAL", "1")
+	tests := []struct {
+		name string
+		uri  string
+		want func(clients.Repo) bool
+	}{
+		{
+			name: "bitbucket data center",
+			uri:  "bitbucket.example.com/projects/PROJ/repos/repo",
+			want: func(r clients.Repo) bool {
+				_, ok := r.(*bitbucketrepo.Repo)
+				return ok
+			},
+		},
+		{
+			name: "bitbucket data center under a context path",
+			uri:  "https://example.com/bitbucket/projects/PROJ/repos/repo",
+			want: func(r clients.Repo) bool {
+				_, ok := r.(*bitbucketrepo.Repo)
+				return ok
+			},
+		},
+		{
+			name: "azure devops",
+			uri:  "https://dev.azure.com/org/project/_git/repo",
+			want: func(r clients.Repo) bool {
+				_, ok := r.(*azuredevopsrepo.Repo)
+				return ok
+			},
+		},
+		{
+			name: "gitea",
+			uri:  "codeberg.org/owner/repo",
+			want: func(r clients.Repo) bool {
+				_, ok := r.(*gitearepo.Repo)
+				return ok
+			},
+		},
+		{
+			name: "gitea branch url",
+			uri:  "codeberg.org/owner/repo/src/branch/main",
+			want: func(r clients.Repo) bool {
+				g, ok := r.(*gitearepo.Repo)
+				return ok && g.URI() == "codeberg.org/owner/repo"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := makeRepo(tt.uri)
+			if err != nil {
+				t.Fatalf("makeRepo(%q): %v", tt.uri, err)
+			}
+			if !tt.want(repo) {
+				t.Errorf("makeRepo(%q) = %T", tt.uri, repo)
+			}
+		})
+	}
+}
@@ -15,107 +15,178 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"html/template"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/release-utils/version"
 
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
 	"github.com/ossf/scorecard/v5/clients/ossfuzz"
-	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/cmd/internal/server"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sclog "github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
 	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
 )
 
+const shutdownTimeout = 30 * time.Second
+
+type serveOptions struct {
+	workers      int
+	queueSize    int
+	cacheSize    int
+	headTTL      time.Duration
+	jobRetention time.Duration
+}
+
 // TODO(cmd): Determine if this should be exported.
 func serveCmd(o *options.Options) *cobra.Command {
-	return &cobra.Command{
+	so := serveOptions{
+		workers:      4,
+		queueSize:    100,
+		cacheSize:    1000,
+		headTTL:      time.Hour,
+		jobRetention: 24 * time.Hour,
+	}
+	cmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Serve the scorecard program over http",
-		Long:  ``,
-		Run: func(cmd *cobra.Command, args []string) {
-			logger := log.NewLogger(log.ParseLevel(o.LogLevel))
-
-			t, err := template.New("webpage").Parse(tpl)
-			if err != nil {
-				// TODO(log): Should this actually panic?
-				logger.Error(err, "parsing webpage template")
-				panic(err)
-			}
-
-			http.HandleFunc("/", func(rw http.ResponseWriter, r *http.Request) {
-				repoParam := r.URL.Query().Get("repo")
-				const length = 3
-				s := strings.SplitN(repoParam, "/", length)
-				if len(s) != length {
-					rw.WriteHeader(http.StatusBadRequest)
-				}
-				repo, err := githubrepo.MakeGithubRepo(repoParam)
-				if err != nil {
-					rw.WriteHeader(http.StatusBadRequest)
-				}
-				ctx := r.Context()
-				repoClient := githubrepo.CreateGithubRepoClient(ctx, logger)
-				ossFuzzRepoClient, err := ossfuzz.CreateOSSFuzzClientEager(ossfuzz.StatusURL)
-				if err != nil {
-					logger.Error(err, "initializing clients")
-					rw.WriteHeader(http.StatusInternalServerError)
-				}
-				defer ossFuzzRepoClient.Close()
-				repoResult, err := scorecard.Run(ctx, repo,
-					scorecard.WithCommitDepth(o.CommitDepth),
-					scorecard.WithRepoClient(repoClient),
-					scorecard.WithOSSFuzzClient(ossFuzzRepoClient),
-				)
-				if err != nil {
-					logger.Error(err, "running enabled scorecard checks on repo")
-					rw.WriteHeader(http.StatusInternalServerError)
-				}
-
-				if r.Header.Get("Content-Type") == "application/json" {
-					if err := repoResult.AsJSON(o.ShowDetails, log.ParseLevel(o.LogLevel), rw); err != nil {
-						// TODO(log): Improve error message
-						logger.Error(err, "")
-						rw.WriteHeader(http.StatusInternalServerError)
-					}
-					return
-				}
-				if err := t.Execute(rw, repoResult); err != nil {
-					// TODO(log): Improve error message
-					logger.Error(err, "")
-				}
-			})
-			port := os.Getenv("PORT")
-			if port == "" {
-				port = "8080"
-			}
-			logger.Info("Listening on localhost:" + port + "\n")
-			//nolint:gosec // unused.
-			err = http.ListenAndServe(fmt.Sprintf("0.0.0.0:%s", port), nil)
-			if err != nil {
-				// TODO(log): Should this actually panic?
-				logger.Error(err, "listening and serving")
-				panic(err)
-			}
+		Long: `Serve an HTTP API to analyze repositories on any supported forge.
+
+Scan jobs are submitted with POST /v1/jobs, polled with GET /v1/jobs/{id}, and
+their results fetched with GET /v1/jobs/{id}/result?format=json|sarif|probe|intoto.
+Results are reused for identical requests of the same commit. Metrics are
+served in the Prometheus text format on /metrics.
+
+The server listens on the port given by the PORT environment variable (8080 by default).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return runServe(ctx, o, &so)
 		},
 	}
+	cmd.Flags().IntVar(&so.workers, "workers", so.workers, "number of scan jobs run concurrently")
+	cmd.Flags().IntVar(&so.queueSize, "queue-size", so.queueSize,
+		"number of scan jobs waiting for a worker before new ones are rejected")
+	cmd.Flags().IntVar(&so.cacheSize, "cache-size", so.cacheSize,
+		"number of results kept to answer identical requests, negative to disable")
+	cmd.Flags().DurationVar(&so.headTTL, "head-ttl", so.headTTL, "how long results for HEAD are reused")
+	cmd.Flags().DurationVar(&so.jobRetention, "job-retention", so.jobRetention, "how long finished jobs can be fetched")
+	cmd.Flags().BoolVar(&o.ShowDetails, options.FlagShowDetails, o.ShowDetails, "show extra details about each check")
+	cmd.Flags().IntVar(&o.CommitDepth, options.FlagCommitDepth, o.CommitDepth,
+		"number of commits to check, commits begin backwards from the HEAD")
+	return cmd
 }
 
-const tpl = `
-<!DOCTYPE html>
-<html>
-	<head>
-		<meta charset="UTF-8">
-		<title>Scorecard Results for: {{.Repo}}</title>
-	</head>
-	<body>
-		{{range .Checks}}
-			<div>
-				<p>{{ .Name }}: {{ .Pass }}</p>
-			</div>
-		{{end}}
-	</body>
-</html>`
+func runServe(ctx context.Context, o *options.Options, so *serveOptions) error {
+	logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+
+	checkDocs, err := docs.Read()
+	if err != nil {
+		return fmt.Errorf("cannot read yaml file: %w", err)
+	}
+
+	// Clients which are safe to share between jobs are only created once.
+	// Each job gets its own GitHub client, but all of them share the token pool.
+	rt := roundtripper.NewTransport(ctx, logger)
+	// The OSS-Fuzz status is loaded by the first job needing it, so the
+	// server starts even when it is unavailable.
+	ossFuzzClient := ossfuzz.CreateOSSFuzzClient(ossfuzz.StatusURL)
+	defer ossFuzzClient.Close()
+	ciiClient := clients.DefaultCIIBestPracticesClient()
+	vulnClient := clients.DefaultVulnerabilitiesClient()
+
+	scan := func(ctx context.Context, repo clients.Repo, req *server.Request) (*scorecard.Result, error) {
+		var requestTypes []checker.RequestType
+		if !strings.EqualFold(req.Commit, clients.HeadSHA) {
+			requestTypes = append(requestTypes, checker.CommitBased)
+		}
+		enabledChecks, err := policy.GetEnabled(nil, req.Checks, requestTypes)
+		if err != nil {
+			return nil, fmt.Errorf("GetEnabled: %w", err)
+		}
+		checks := make([]string, 0, len(enabledChecks))
+		for c := range enabledChecks {
+			checks = append(checks, c)
+		}
+		opts := []scorecard.Option{
+			scorecard.WithLogLevel(sclog.ParseLevel(o.LogLevel)),
+			scorecard.WithCommitSHA(req.Commit),
+			scorecard.WithCommitDepth(o.CommitDepth),
+			scorecard.WithChecks(checks),
+			scorecard.WithProbes(req.Probes),
+			scorecard.WithOSSFuzzClient(ossFuzzClient),
+			scorecard.WithOpenSSFBestPraticesClient(ciiClient),
+			scorecard.WithVulnerabilitiesClient(vulnClient),
+		}
+		if _, ok := repo.(*githubrepo.Repo); ok {
+			opts = append(opts, scorecard.WithRepoClient(githubrepo.CreateGithubRepoClientWithTransport(ctx, rt)))
+		}
+		result, err := scorecard.Run(ctx, repo, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("scorecard.Run: %w", err)
+		}
+		return &result, nil
+	}
+
+	srv := server.New(server.Config{
+		MakeRepo:     makeRepo,
+		Scan:         scan,
+		Logger:       logger,
+		Options:      o,
+		CheckDocs:    checkDocs,
+		Version:      version.GetVersionInfo().GitVersion,
+		Workers:      so.workers,
+		QueueSize:    so.queueSize,
+		CacheSize:    so.cacheSize,
+		HeadTTL:      so.headTTL,
+		JobRetention: so.jobRetention,
+	})
+	workerCtx, cancelWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelWorkers()
+	srv.Start(workerCtx)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+	httpServer := &http.Server{
+		Addr:              "0.0.0.0:" + port,
+		Handler:           srv.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		logger.Info("Listening on localhost:" + port)
+		errs <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("listening and serving: %w", err)
+	case <-ctx.Done():
+	}
+	logger.Info("Shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("shutting down: %w", err)
+	}
+	// Running jobs are abandoned, their results could no longer be fetched anyway.
+	cancelWorkers()
+	srv.Wait()
+	return nil
+}
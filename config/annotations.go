@@ -14,6 +14,13 @@
 
 package config
 
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
 // Reason is the reason behind an annotation.
 type Reason string
 
@@ -39,20 +46,97 @@ const (
 	NotDetected Reason = "not-detected"
 )
 
-// ReasonGroup groups the annotation reason and, in the future, the related probe.
+// ReasonGroup groups the annotation reason and the related probe.
 // If there is a probe, the reason applies to the probe.
 // If there is not a probe, the reason applies to the check or checks in
 // the group.
 type ReasonGroup struct {
 	Reason Reason `yaml:"reason"`
+	Probe  string `yaml:"probe,omitempty"`
 }
 
 // Annotation defines a group of checks that are being annotated for various reasons.
 type Annotation struct {
-	Checks  []string      `yaml:"checks"`
+	// Expires is the date after which the annotation no longer applies, if set.
+	Expires time.Time `yaml:"expires,omitempty"`
+	// Owner is who is responsible for the annotation, e.g. a GitHub handle.
+	Owner  string   `yaml:"owner,omitempty"`
+	Checks []string `yaml:"checks"`
+	// Paths restricts the annotation to the problems found in matching files.
+	// Patterns use path.Match syntax, plus "**" to match any number of directories.
+	Paths   []string      `yaml:"paths,omitempty"`
 	Reasons []ReasonGroup `yaml:"reasons"`
 }
 
+// Expired reports whether the annotation expired before now.
+// Annotations expire at the end of their expiration day.
+func (a *Annotation) Expired(now time.Time) bool {
+	return !a.Expires.IsZero() && now.After(a.Expires.AddDate(0, 0, 1))
+}
+
+// MatchesPath reports whether the annotation applies to the file at p.
+func (a *Annotation) MatchesPath(p string) bool {
+	if len(a.Paths) == 0 {
+		return true
+	}
+	for _, pattern := range a.Paths {
+		if matchGlob(pattern, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe returns the explanation of the reason, followed by the scope,
+// owner and expiration of the annotation, if any.
+func (a *Annotation) Describe(rg *ReasonGroup) string {
+	var scope []string
+	if rg.Probe != "" {
+		scope = append(scope, "probe: "+rg.Probe)
+	}
+	if len(a.Paths) > 0 {
+		scope = append(scope, "paths: "+strings.Join(a.Paths, ", "))
+	}
+	if a.Owner != "" {
+		scope = append(scope, "owner: "+a.Owner)
+	}
+	if !a.Expires.IsZero() {
+		scope = append(scope, "expires: "+a.Expires.Format(time.DateOnly))
+	}
+	doc := rg.Reason.Doc()
+	if len(scope) == 0 {
+		return doc
+	}
+	return fmt.Sprintf("%s (%s)", doc, strings.Join(scope, "; "))
+}
+
+// matchGlob reports whether name matches pattern, where a "**" element
+// matches zero or more path elements.
+func matchGlob(pattern, name string) bool {
+	return matchElems(strings.Split(path.Clean(pattern), "/"), strings.Split(path.Clean(name), "/"))
+}
+
+func matchElems(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElems(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
 // Doc maps a reason to its human-readable explanation.
 func (r *Reason) Doc() string {
 	switch *r {
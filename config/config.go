@@ -18,7 +18,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
+	"slices"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -29,11 +32,55 @@ import (
 var (
 	errInvalidCheck  = errors.New("check is not valid")
 	errInvalidReason = errors.New("reason is not valid")
+	errInvalidPath   = errors.New("path is not valid")
+	errNoTarget      = errors.New("annotation has no checks or probes")
 )
 
 // Config contains configurations defined by maintainers.
 type Config struct {
 	Annotations []Annotation `yaml:"annotations"`
+	// Warnings are the problems with the annotations which didn't prevent
+	// using the others, e.g. expired annotations.
+	Warnings []string `yaml:"-"`
+}
+
+// Probes returns the probes named by the annotations.
+func (c *Config) Probes() []string {
+	var probes []string
+	for i := range c.Annotations {
+		for _, rg := range c.Annotations[i].Reasons {
+			if rg.Probe != "" {
+				probes = append(probes, rg.Probe)
+			}
+		}
+	}
+	return probes
+}
+
+// RemoveExpired drops the annotations which expired before now,
+// recording a warning for each of them.
+func (c *Config) RemoveExpired(now time.Time) {
+	active := c.Annotations[:0]
+	for i := range c.Annotations {
+		a := &c.Annotations[i]
+		if !a.Expired(now) {
+			active = append(active, *a)
+			continue
+		}
+		targets := slices.Clone(a.Checks)
+		for _, rg := range a.Reasons {
+			if rg.Probe != "" {
+				targets = append(targets, rg.Probe)
+			}
+		}
+		warning := fmt.Sprintf("annotation of %s expired on %s", strings.Join(targets, ", "),
+			a.Expires.Format(time.DateOnly))
+		if a.Owner != "" {
+			warning += ", owner: " + a.Owner
+		}
+		c.Warnings = append(c.Warnings, warning)
+	}
+	c.Annotations = active
 }
 
 // parseFile takes the scorecard.yml file content and returns a `Config`.
@@ -57,6 +104,7 @@ func isValidCheck(check string) bool {
 
 func validate(c Config) error {
 	for _, annotation := range c.Annotations {
+		hasTarget := len(annotation.Checks) > 0
 		for _, check := range annotation.Checks {
 			if !isValidCheck(check) {
 				return fmt.Errorf("%w: %s", errInvalidCheck, check)
@@ -66,6 +114,15 @@ func validate(c Config) error {
 			if !isValidReason(reasonGroup.Reason) {
 				return fmt.Errorf("%w: %s", errInvalidReason, reasonGroup.Reason)
 			}
+			hasTarget = hasTarget || reasonGroup.Probe != ""
+		}
+		if !hasTarget {
+			return errNoTarget
+		}
+		for _, p := range annotation.Paths {
+			if _, err := path.Match(p, ""); p == "" || err != nil {
+				return fmt.Errorf("%w: %q", errInvalidPath, p)
+			}
 		}
 	}
 	return nil
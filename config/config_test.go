@@ -17,6 +17,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -106,6 +107,34 @@ func Test_Parse_Checks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "Scoped annotations",
+			configPath: "testdata/scoped_annotations.yml",
+			want: Config{
+				Annotations: []Annotation{
+					{
+						Checks:  []string{"binary-artifacts"},
+						Paths:   []string{"testdata/**"},
+						Owner:   "@maintainer",
+						Expires: time.Date(2030, time.January, 31, 0, 0, 0, 0, time.UTC),
+						Reasons: []ReasonGroup{{Reason: "test-data"}},
+					},
+					{
+						Reasons: []ReasonGroup{{Reason: "remediated", Probe: "hasDangerousWorkflowUntrustedCheckout"}},
+					},
+				},
+			},
+		},
+		{
+			name:       "Invalid path",
+			configPath: "testdata/invalid_path.yml",
+			wantErr:    true,
+		},
+		{
+			name:       "No checks or probes",
+			configPath: "testdata/no_target.yml",
+			wantErr:    true,
+		},
 		{
 			name:       "Invalid check",
 			configPath: "testdata/invalid_check.yml",
@@ -135,3 +164,71 @@ func Test_Parse_Checks(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_RemoveExpired(t *testing.T) {
+	t.Parallel()
+	c := Config{
+		Annotations: []Annotation{
+			{
+				Checks:  []string{"binary-artifacts"},
+				Owner:   "@maintainer",
+				Expires: time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+				Reasons: []ReasonGroup{{Reason: TestData}},
+			},
+			{
+				Expires: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+				Reasons: []ReasonGroup{{Reason: Remediated, Probe: "hasDangerousWorkflowUntrustedCheckout"}},
+			},
+			{
+				Checks:  []string{"pinned-dependencies"},
+				Reasons: []ReasonGroup{{Reason: NotApplicable}},
+			},
+		},
+	}
+	c.RemoveExpired(time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC))
+	// Annotations expire at the end of their expiration day.
+	want := Config{
+		Annotations: []Annotation{
+			{
+				Expires: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+				Reasons: []ReasonGroup{{Reason: Remediated, Probe: "hasDangerousWorkflowUntrustedCheckout"}},
+			},
+			{
+				Checks:  []string{"pinned-dependencies"},
+				Reasons: []ReasonGroup{{Reason: NotApplicable}},
+			},
+		},
+		Warnings: []string{
+			"annotation of binary-artifacts expired on 2025-01-31, owner: @maintainer",
+		},
+	}
+	if diff := cmp.Diff(want, c); diff != "" {
+		t.Errorf("RemoveExpired() (-want,+got): %s", diff)
+	}
+}
+
+func Test_matchGlob(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "testdata/**", name: "testdata/a.exe", want: true},
+		{pattern: "testdata/**", name: "testdata/nested/a.exe", want: true},
+		{pattern: "testdata/**", name: "src/testdata/a.exe", want: false},
+		{pattern: "**/testdata/**", name: "src/testdata/a.exe", want: true},
+		{pattern: "**/*.jar", name: "gradle/wrapper/gradle-wrapper.jar", want: true},
+		{pattern: "*.jar", name: "gradle/wrapper/gradle-wrapper.jar", want: false},
+		{pattern: "bin/tool", name: "bin/tool", want: true},
+		{pattern: "./bin/tool", name: "bin/tool", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := matchGlob(tt.pattern, tt.name); got != tt.want {
+				t.Errorf("matchGlob(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+			}
+		})
+	}
+}
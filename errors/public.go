@@ -39,6 +39,8 @@ var (
 	ErrUnsupportedCheck = errors.New("check is not supported for this request")
 	// ErrCheckRuntime indicates an individual check had a runtime error.
 	ErrCheckRuntime = errors.New("check runtime error")
+	// ErrPolicyViolation indicates the results don't meet the policy.
+	ErrPolicyViolation = errors.New("policy violation")
 )
 
 // WithMessage wraps any of the errors listed above.
@@ -61,6 +63,8 @@ func GetName(err error) string {
 		return "ErrRepoUnreachable"
 	case errors.Is(err, ErrShellParsing):
 		return "ErrShellParsing"
+	case errors.Is(err, ErrPolicyViolation):
+		return "ErrPolicyViolation"
 	default:
 		return "ErrUnknown"
 	}
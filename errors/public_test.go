@@ -89,6 +89,13 @@ func TestGetName(t *testing.T) {
 			},
 			want: "ErrShellParsing",
 		},
+		{
+			name: "ErrPolicyViolation",
+			args: args{
+				err: WithMessage(ErrPolicyViolation, "2 violations"),
+			},
+			want: "ErrPolicyViolation",
+		},
 		{
 			name: "unknown error",
 			args: args{
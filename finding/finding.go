@@ -176,6 +176,12 @@ func (f *Finding) Anonymize() *AnonymousFinding {
 	return &AnonymousFinding{Finding: *f}
 }
 
+// NeedsRemediation reports whether the finding has the outcome
+// its probe recommends a remediation for.
+func (f *Finding) NeedsRemediation() bool {
+	return f.badOutcome != "" && f.Outcome == f.badOutcome
+}
+
 // WithMessage adds a message to an existing finding.
 // No copy is made.
 func (f *Finding) WithMessage(text string) *Finding {
@@ -27,9 +27,20 @@ type PropertyGroup struct {
 	AllowUnsafeBlocks bool     `xml:"AllowUnsafeBlocks"`
 }
 
+type ItemGroup struct {
+	XMLName           xml.Name           `xml:"ItemGroup"`
+	PackageReferences []PackageReference `xml:"PackageReference"`
+}
+
+type PackageReference struct {
+	XMLName xml.Name `xml:"PackageReference"`
+	Include string   `xml:"Include,attr"`
+}
+
 type Project struct {
 	XMLName        xml.Name        `xml:"Project"`
 	PropertyGroups []PropertyGroup `xml:"PropertyGroup"`
+	ItemGroups     []ItemGroup     `xml:"ItemGroup"`
 }
 
 func IsRestoreLockedModeEnabled(content []byte) (bool, error) {
@@ -44,6 +55,23 @@ func IsAllowUnsafeBlocksEnabled(content []byte) (bool, error) {
 	})
 }
 
+func HasPackageReferences(content []byte) (bool, error) {
+	var project Project
+
+	err := xml.Unmarshal(content, &project)
+	if err != nil {
+		return false, errInvalidCsProjFile
+	}
+
+	for _, itemGroup := range project.ItemGroups {
+		if len(itemGroup.PackageReferences) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func isCsProjFilePropertyGroupEnabled(content []byte, predicate func(*PropertyGroup) bool) (bool, error) {
 	var project Project
 
@@ -0,0 +1,58 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockfile
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var errInvalidLockFile = errors.New("error parsing packages.lock.json file")
+
+type LockFile struct {
+	// Dependencies maps target frameworks to the packages restored for them.
+	Dependencies map[string]map[string]LockedPackage `json:"dependencies"`
+	Version      int                                 `json:"version"`
+}
+
+type LockedPackage struct {
+	Type        string `json:"type"`
+	Resolved    string `json:"resolved"`
+	ContentHash string `json:"contentHash"`
+}
+
+// CountHashedPackages returns the number of packages of a packages.lock.json file,
+// and how many of them have a content hash. Project references aren't packages.
+func CountHashedPackages(content []byte) (packages, hashed int, err error) {
+	var lockFile LockFile
+
+	if err := json.Unmarshal(content, &lockFile); err != nil {
+		return 0, 0, errInvalidLockFile
+	}
+
+	for _, framework := range lockFile.Dependencies {
+		for _, p := range framework {
+			if p.Type == "Project" {
+				continue
+			}
+			packages++
+			if p.ContentHash != "" {
+				hashed++
+			}
+		}
+	}
+
+	return packages, hashed, nil
+}
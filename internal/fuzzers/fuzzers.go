@@ -25,11 +25,20 @@ const (
 	PropertyBasedGleam      = "GleamPropertyBasedTesting"
 	PropertyBasedJavaScript = "JavaScriptPropertyBasedTesting"
 	PropertyBasedTypeScript = "TypeScriptPropertyBasedTesting"
+	PropertyBasedPython     = "PythonPropertyBasedTesting"
+	PropertyBasedRust       = "RustPropertyBasedTesting"
+	PropertyBasedJava       = "JavaPropertyBasedTesting"
+	PropertyBasedDotNet     = "DotNetPropertyBasedTesting"
 	PythonAtheris           = "PythonAtherisFuzzer"
 	CLibFuzzer              = "CLibFuzzer"
 	CppLibFuzzer            = "CppLibFuzzer"
 	SwiftLibFuzzer          = "SwiftLibFuzzer"
 	RustCargoFuzz           = "RustCargoFuzzer"
 	JavaJazzerFuzzer        = "JavaJazzerFuzzer"
+	JavaScriptJazzerFuzzer  = "JavaScriptJazzerFuzzer"
+	AFLPlusPlus             = "AFLPlusPlusFuzzer"
+	Honggfuzz               = "HonggfuzzFuzzer"
+	Mayhem                  = "Mayhem"
+	CIFuzz                  = "CIFuzz"
 	// TODO: add more fuzzing check supports.
 )
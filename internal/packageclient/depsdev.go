@@ -22,6 +22,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 // This interface lets Scorecard look up package manager metadata for a project.
@@ -54,7 +56,7 @@ type ProjectPackageVersions struct {
 
 func CreateDepsDevClient() ProjectPackageClient {
 	return depsDevClient{
-		client: &http.Client{},
+		client: &http.Client{Transport: clients.WrapTransport(http.DefaultTransport)},
 	}
 }
 
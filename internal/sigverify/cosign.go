@@ -0,0 +1,177 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+)
+
+// CosignSignature is a signature made by cosign sign-blob.
+type CosignSignature struct {
+	// Signature is the base64 encoded signature written by --output-signature.
+	Signature []byte
+	// Certificate is the base64 encoded PEM certificate of a keyless signature
+	// written by --output-certificate.
+	Certificate []byte
+	// Bundle is the bundle written by --bundle, holding the signature, the
+	// certificate and the transparency log entry.
+	Bundle []byte
+}
+
+type cosignBundleJSON struct {
+	RekorBundle *struct {
+		Payload struct {
+			Body           string  `json:"body"`
+			LogID          string  `json:"logID"`
+			IntegratedTime jsonInt `json:"integratedTime"`
+			LogIndex       jsonInt `json:"logIndex"`
+		} `json:"Payload"`
+		SignedEntryTimestamp []byte `json:"SignedEntryTimestamp"`
+	} `json:"rekorBundle"`
+	Base64Signature string `json:"base64Signature"`
+	Cert            string `json:"cert"`
+}
+
+// VerifyCosign verifies a cosign signature of the artifact with the given digest. Keyless
+// signatures are verified against the trusted root, which requires their transparency log
+// entry, and the others against keys.
+func VerifyCosign(root *TrustedRoot, s *CosignSignature, keys []crypto.PublicKey, artifact Digest) (*Signer, error) {
+	sigB64, cert := s.Signature, s.Certificate
+	var entries []tlogEntryJSON
+	if len(s.Bundle) > 0 {
+		var b cosignBundleJSON
+		if err := json.Unmarshal(s.Bundle, &b); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidBundle, err)
+		}
+		sigB64, cert = []byte(b.Base64Signature), []byte(b.Cert)
+		if rb := b.RekorBundle; rb != nil {
+			body, err := base64.StdEncoding.DecodeString(rb.Payload.Body)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rekor body: %w", errInvalidBundle, err)
+			}
+			logID, err := hex.DecodeString(rb.Payload.LogID)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rekor log ID: %w", errInvalidBundle, err)
+			}
+			entry := tlogEntryJSON{
+				CanonicalizedBody: body,
+				LogIndex:          rb.Payload.LogIndex,
+				IntegratedTime:    rb.Payload.IntegratedTime,
+			}
+			entry.LogID.KeyID = logID
+			entry.InclusionPromise = &struct {
+				SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+			}{SignedEntryTimestamp: rb.SignedEntryTimestamp}
+			entries = append(entries, entry)
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sigB64)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+
+	if len(cert) > 0 {
+		der, err := decodeCertificatePEM(cert)
+		if err != nil {
+			return nil, err
+		}
+		leaf, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate: %w", errInvalidSignature, err)
+		}
+		// Fulcio certificates expire minutes after they're issued, so only the
+		// transparency log tells whether one was valid when the artifact was signed.
+		integrated, err := verifyTlogEntries(root, entries, func(body *rekorBody) error {
+			return body.matchHashedRekord(leaf, sig, artifact.SHA256)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyCertificate(root, leaf, integrated); err != nil {
+			return nil, err
+		}
+		if err := verifyDigestSignature(leaf.PublicKey, artifact.SHA256, sig); err != nil {
+			return nil, err
+		}
+		return certificateSigner(leaf), nil
+	}
+
+	if len(keys) == 0 {
+		return nil, errNoKey
+	}
+	for _, key := range keys {
+		if verifyDigestSignature(key, artifact.SHA256, sig) == nil {
+			return &Signer{KeyID: keyID(key)}, nil
+		}
+	}
+	return nil, errInvalidSignature
+}
+
+// ParsePublicKeys parses the PEM encoded public keys in content, e.g. a cosign.pub file.
+func ParsePublicKeys(content []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, content = pem.Decode(content)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKey, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// keyID returns the hex encoded SHA-256 digest of the DER encoding of a public key.
+func keyID(key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return ""
+	}
+	d := sha256.Sum256(der)
+	return hex.EncodeToString(d[:])
+}
+
+// decodeCertificatePEM returns the DER encoding of a PEM certificate, which may itself be
+// base64 encoded as cosign writes it.
+func decodeCertificatePEM(content []byte) ([]byte, error) {
+	content = bytes.TrimSpace(content)
+	if !bytes.HasPrefix(content, []byte("-----BEGIN")) {
+		decoded, err := base64.StdEncoding.DecodeString(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: certificate: %w", errInvalidSignature, err)
+		}
+		content = decoded
+	}
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%w: no PEM certificate", errInvalidSignature)
+	}
+	return block.Bytes, nil
+}
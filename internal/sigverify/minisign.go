@@ -0,0 +1,95 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// MinisignKey is a minisign public key.
+type MinisignKey struct {
+	key ed25519.PublicKey
+	id  [8]byte
+}
+
+// ParseMinisignKeys parses the minisign public keys in content, e.g. a minisign.pub file.
+// https://jedisct1.github.io/minisign/#public-key-format
+func ParseMinisignKeys(content []byte) ([]MinisignKey, error) {
+	var keys []MinisignKey
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "untrusted comment:") {
+			continue
+		}
+		raw, err := base64.StdEncoding.DecodeString(line)
+		if err != nil || len(raw) != 2+8+ed25519.PublicKeySize || string(raw[:2]) != "Ed" {
+			return nil, fmt.Errorf("%w: malformed minisign public key", errInvalidKey)
+		}
+		k := MinisignKey{key: ed25519.PublicKey(raw[10:])}
+		copy(k.id[:], raw[2:10])
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// VerifyMinisign verifies a minisign signature of the artifact with the given digest.
+// https://jedisct1.github.io/minisign/#signature-format
+func VerifyMinisign(content []byte, keys []MinisignKey, artifact Digest) (*Signer, error) {
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[2], "trusted comment: ") {
+		return nil, fmt.Errorf("%w: malformed minisign signature", errInvalidSignature)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(sig) != 2+8+ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: malformed minisign signature", errInvalidSignature)
+	}
+	global, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(global) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: malformed minisign global signature", errInvalidSignature)
+	}
+	// Signatures made before minisign 0.8 sign the artifact itself rather than its
+	// BLAKE2b-512 digest, which would require holding it in memory.
+	if string(sig[:2]) != "ED" {
+		return nil, fmt.Errorf("%w: legacy minisign signature", errUnsupported)
+	}
+	for _, k := range keys {
+		if !bytes.Equal(k.id[:], sig[2:10]) {
+			continue
+		}
+		if !ed25519.Verify(k.key, artifact.BLAKE2b512, sig[10:]) {
+			return nil, errInvalidSignature
+		}
+		trusted := strings.TrimSuffix(strings.TrimPrefix(lines[2], "trusted comment: "), "\r")
+		if !ed25519.Verify(k.key, append(sig[10:], trusted...), global) {
+			return nil, fmt.Errorf("%w: trusted comment", errInvalidSignature)
+		}
+		return &Signer{KeyID: k.String()}, nil
+	}
+	return nil, errNoKey
+}
+
+// String returns the key ID as minisign displays it.
+func (k MinisignKey) String() string {
+	// minisign displays the key ID as a little endian integer.
+	var id [8]byte
+	for i := range id {
+		id[i] = k.id[7-i]
+	}
+	return fmt.Sprintf("%X", id[:])
+}
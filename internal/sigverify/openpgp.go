@@ -0,0 +1,119 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+const armoredKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// OpenPGPKeys are OpenPGP public keys.
+type OpenPGPKeys struct {
+	entities openpgp.EntityList
+}
+
+// Len returns the number of keys.
+func (k *OpenPGPKeys) Len() int {
+	if k == nil {
+		return 0
+	}
+	return len(k.entities)
+}
+
+// ParseOpenPGPKeys parses the OpenPGP public keys in content, e.g. a KEYS file, which
+// may hold several armored key blocks between text, or a binary keyring.
+func ParseOpenPGPKeys(content []byte) (*OpenPGPKeys, error) {
+	keys := &OpenPGPKeys{}
+	text := string(content)
+	if !strings.Contains(text, armoredKeyHeader) {
+		entities, err := openpgp.ReadKeyRing(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKey, err)
+		}
+		keys.entities = entities
+		return keys, nil
+	}
+	for {
+		i := strings.Index(text, armoredKeyHeader)
+		if i < 0 {
+			break
+		}
+		text = text[i:]
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(text))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKey, err)
+		}
+		keys.entities = append(keys.entities, entities...)
+		text = text[len(armoredKeyHeader):]
+	}
+	return keys, nil
+}
+
+// VerifyOpenPGP verifies an armored or binary OpenPGP detached signature of artifact.
+func VerifyOpenPGP(content []byte, keys *OpenPGPKeys, artifact io.Reader) (*Signer, error) {
+	if keys.Len() == 0 {
+		return nil, errNoKey
+	}
+	if bytes.Contains(content, []byte("-----BEGIN PGP SIGNATURE-----")) {
+		block, err := armor.Decode(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+		}
+		content, err = io.ReadAll(block.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+		}
+	}
+	p, err := packet.Read(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return nil, fmt.Errorf("%w: not a signature packet", errInvalidSignature)
+	}
+	// Keys and signatures are checked for expiry when the artifact was signed, since
+	// release signing keys are often rotated after they expire.
+	created := sig.CreationTime
+	config := &packet.Config{Time: func() time.Time { return created }}
+	_, entity, err := openpgp.VerifyDetachedSignature(keys.entities, artifact, bytes.NewReader(content), config)
+	if err != nil {
+		if errors.Is(err, pgperrors.ErrUnknownIssuer) {
+			return nil, errNoKey
+		}
+		return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+	s := &Signer{KeyID: fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)}
+	if id := entity.PrimaryIdentity(); id != nil {
+		s.Identity = id.Name
+	}
+	return s, nil
+}
+
+// Append adds keys to k.
+func (k *OpenPGPKeys) Append(keys *OpenPGPKeys) {
+	k.entities = append(k.entities, keys.entities...)
+}
@@ -0,0 +1,316 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/blake2b"
+)
+
+func mustDigest(t *testing.T, content string) Digest {
+	t.Helper()
+	d, err := DigestOf(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestVerifyMinisign(t *testing.T) {
+	t.Parallel()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	pubFile := "untrusted comment: minisign public key 0807060504030201\n" +
+		base64.StdEncoding.EncodeToString(append(append([]byte("Ed"), id...), pub...)) + "\n"
+	keys, err := ParseMinisignKeys([]byte(pubFile))
+	if err != nil {
+		t.Fatalf("ParseMinisignKeys: %v", err)
+	}
+	sign := func(alg, artifact, trusted string) []byte {
+		h := blake2b.Sum512([]byte(artifact))
+		sig := ed25519.Sign(priv, h[:])
+		global := ed25519.Sign(priv, append(append([]byte{}, sig...), trusted...))
+		return []byte("untrusted comment: signature from minisign secret key\n" +
+			base64.StdEncoding.EncodeToString(append(append([]byte(alg), id...), sig...)) + "\n" +
+			"trusted comment: " + trusted + "\n" +
+			base64.StdEncoding.EncodeToString(global) + "\n")
+	}
+	valid := sign("ED", "artifact", "timestamp:1738411200\tfile:artifact")
+
+	tests := []struct {
+		want    *Signer
+		name    string
+		sig     []byte
+		digest  Digest
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			sig:    valid,
+			digest: mustDigest(t, "artifact"),
+			want:   &Signer{KeyID: "0807060504030201"},
+		},
+		{
+			name:    "another artifact",
+			sig:     valid,
+			digest:  mustDigest(t, "other"),
+			wantErr: true,
+		},
+		{
+			name:    "tampered trusted comment",
+			sig:     bytes.Replace(valid, []byte("file:artifact"), []byte("file:other"), 1),
+			digest:  mustDigest(t, "artifact"),
+			wantErr: true,
+		},
+		{
+			name:    "legacy signature",
+			sig:     sign("Ed", "artifact", "timestamp:1738411200"),
+			digest:  mustDigest(t, "artifact"),
+			wantErr: true,
+		},
+		{
+			name:    "malformed",
+			sig:     []byte("untrusted comment: x\n"),
+			digest:  mustDigest(t, "artifact"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := VerifyMinisign(tt.sig, keys, tt.digest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyMinisign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyCosign(t *testing.T) {
+	t.Parallel()
+	artifact := "release artifact"
+	digest := mustDigest(t, artifact)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ParsePublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil || len(keys) != 1 {
+		t.Fatalf("ParsePublicKeys: %v, %d keys", err, len(keys))
+	}
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySig := []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+
+	s := newTestSigstore(t)
+	certPEM, keylessSig, entry := s.sign(t, []byte(artifact))
+	body, ok := entry["body"].([]byte)
+	if !ok {
+		t.Fatal("no body")
+	}
+	bundle, err := json.Marshal(map[string]any{
+		"base64Signature": base64.StdEncoding.EncodeToString(keylessSig),
+		"cert":            base64.StdEncoding.EncodeToString(certPEM),
+		"rekorBundle": map[string]any{
+			"SignedEntryTimestamp": entry["set"],
+			"Payload": map[string]any{
+				"body":           base64.StdEncoding.EncodeToString(body),
+				"integratedTime": entry["integratedTime"],
+				"logIndex":       entry["logIndex"],
+				"logID":          hex.EncodeToString(s.logID),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		want    *Signer
+		sig     *CosignSignature
+		name    string
+		keys    []crypto.PublicKey
+		digest  Digest
+		wantErr bool
+	}{
+		{
+			name:   "key",
+			sig:    &CosignSignature{Signature: keySig},
+			keys:   keys,
+			digest: digest,
+			want:   &Signer{KeyID: keyID(&key.PublicKey)},
+		},
+		{
+			name:    "key of another artifact",
+			sig:     &CosignSignature{Signature: keySig},
+			keys:    keys,
+			digest:  mustDigest(t, "other"),
+			wantErr: true,
+		},
+		{
+			name:    "no key",
+			sig:     &CosignSignature{Signature: keySig},
+			digest:  digest,
+			wantErr: true,
+		},
+		{
+			name:   "keyless bundle",
+			sig:    &CosignSignature{Bundle: bundle},
+			digest: digest,
+			want: &Signer{
+				Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:           "https://token.actions.githubusercontent.com",
+				SourceRepository: "https://github.com/owner/repo",
+			},
+		},
+		{
+			name: "keyless without transparency log entry",
+			sig: &CosignSignature{
+				Signature:   []byte(base64.StdEncoding.EncodeToString(keylessSig)),
+				Certificate: []byte(base64.StdEncoding.EncodeToString(certPEM)),
+			},
+			digest:  digest,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := VerifyCosign(s.root, tt.sig, tt.keys, tt.digest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyCosign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyOpenPGP(t *testing.T) {
+	t.Parallel()
+	signer, err := openpgp.NewEntity("Release Manager", "", "release@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := openpgp.NewEntity("Someone Else", "", "someone@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// KEYS files list armored keys after a description of each.
+	var keysFile bytes.Buffer
+	for _, e := range []*openpgp.Entity{other, signer} {
+		keysFile.WriteString("pub   " + e.PrimaryIdentity().Name + "\n\n")
+		w, err := armor.Encode(&keysFile, openpgp.PublicKeyType, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Serialize(w); err != nil {
+			t.Fatal(err)
+		}
+		w.Close()
+		keysFile.WriteString("\n")
+	}
+	keys, err := ParseOpenPGPKeys(keysFile.Bytes())
+	if err != nil || keys.Len() != 2 {
+		t.Fatalf("ParseOpenPGPKeys: %v, %d keys", err, keys.Len())
+	}
+	onlyOther, err := ParseOpenPGPKeys(serialize(t, other))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	artifact := "release artifact"
+	var armored, binary bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&armored, signer, strings.NewReader(artifact), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := openpgp.DetachSign(&binary, signer, strings.NewReader(artifact), nil); err != nil {
+		t.Fatal(err)
+	}
+	want := &Signer{
+		Identity: "Release Manager <release@example.com>",
+		KeyID:    strings.ToUpper(hex.EncodeToString(signer.PrimaryKey.Fingerprint)),
+	}
+
+	tests := []struct {
+		keys     *OpenPGPKeys
+		want     *Signer
+		name     string
+		artifact string
+		sig      []byte
+		wantErr  bool
+	}{
+		{name: "armored", keys: keys, sig: armored.Bytes(), artifact: artifact, want: want},
+		{name: "binary", keys: keys, sig: binary.Bytes(), artifact: artifact, want: want},
+		{name: "another artifact", keys: keys, sig: armored.Bytes(), artifact: "other", wantErr: true},
+		{name: "unknown key", keys: onlyOther, sig: armored.Bytes(), artifact: artifact, wantErr: true},
+		{name: "no keys", sig: armored.Bytes(), artifact: artifact, wantErr: true},
+		{name: "empty signature", keys: keys, artifact: artifact, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := VerifyOpenPGP(tt.sig, tt.keys, strings.NewReader(tt.artifact))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyOpenPGP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func serialize(t *testing.T, e *openpgp.Entity) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	if err := e.Serialize(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
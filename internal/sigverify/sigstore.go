@@ -0,0 +1,409 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// oidIssuer and oidIssuerV2 are the Fulcio extensions holding the OIDC issuer.
+	// https://github.com/sigstore/fulcio/blob/main/docs/oid-info.md
+	oidIssuer   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	// oidSourceRepositoryURI is the Fulcio extension holding the repository of the CI
+	// workflow which requested the certificate.
+	oidSourceRepositoryURI = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 12}
+)
+
+// Attestation is the verified content of a DSSE envelope.
+type Attestation struct {
+	Signer      Signer
+	PayloadType string
+	Payload     []byte
+	// Logged is whether the signature was verified to be in the transparency log.
+	Logged bool
+}
+
+// jsonInt is an integer which protobuf JSON encodes as a string.
+type jsonInt int64
+
+func (i *jsonInt) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseInt(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return fmt.Errorf("strconv.ParseInt: %w", err)
+	}
+	*i = jsonInt(n)
+	return nil
+}
+
+type rawBytesJSON struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+type bundleJSON struct {
+	MessageSignature *struct {
+		MessageDigest *struct {
+			Algorithm string `json:"algorithm"`
+			Digest    []byte `json:"digest"`
+		} `json:"messageDigest"`
+		Signature []byte `json:"signature"`
+	} `json:"messageSignature"`
+	DSSEEnvelope         *envelopeJSON `json:"dsseEnvelope"`
+	MediaType            string        `json:"mediaType"`
+	VerificationMaterial struct {
+		Certificate          *rawBytesJSON `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []rawBytesJSON `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		PublicKey   json.RawMessage `json:"publicKey"`
+		TlogEntries []tlogEntryJSON `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+}
+
+type envelopeJSON struct {
+	PayloadType string `json:"payloadType"`
+	Payload     []byte `json:"payload"`
+	Signatures  []struct {
+		Sig []byte `json:"sig"`
+		// Cert is the PEM encoded certificate of envelopes outside of a bundle.
+		Cert string `json:"cert"`
+	} `json:"signatures"`
+}
+
+// VerifyBundle verifies a Sigstore bundle signing the artifact with the given digest,
+// either with a message signature or with an in-toto statement whose subject is the artifact.
+func VerifyBundle(root *TrustedRoot, content []byte, artifact Digest) (*Signer, error) {
+	var b bundleJSON
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidBundle, err)
+	}
+	if b.DSSEEnvelope != nil {
+		att, err := verifyBundleEnvelope(root, &b)
+		if err != nil {
+			return nil, err
+		}
+		if err := matchStatementSubject(att, artifact); err != nil {
+			return nil, err
+		}
+		return &att.Signer, nil
+	}
+	if b.MessageSignature == nil {
+		return nil, fmt.Errorf("%w: no message signature or DSSE envelope", errInvalidBundle)
+	}
+	if d := b.MessageSignature.MessageDigest; d != nil && !bytes.Equal(d.Digest, artifact.SHA256) {
+		return nil, errDigestMismatch
+	}
+	leaf, err := bundleCertificate(&b)
+	if err != nil {
+		return nil, err
+	}
+	sig := b.MessageSignature.Signature
+	integrated, err := verifyTlogEntries(root, b.VerificationMaterial.TlogEntries, func(body *rekorBody) error {
+		return body.matchHashedRekord(leaf, sig, artifact.SHA256)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyCertificate(root, leaf, integrated); err != nil {
+		return nil, err
+	}
+	if err := verifyDigestSignature(leaf.PublicKey, artifact.SHA256, sig); err != nil {
+		return nil, err
+	}
+	return certificateSigner(leaf), nil
+}
+
+// VerifyAttestationBundle verifies a Sigstore bundle holding a DSSE envelope and returns
+// its payload.
+func VerifyAttestationBundle(root *TrustedRoot, content []byte) (*Attestation, error) {
+	var b bundleJSON
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidBundle, err)
+	}
+	if b.DSSEEnvelope == nil {
+		return nil, fmt.Errorf("%w: no DSSE envelope", errInvalidBundle)
+	}
+	return verifyBundleEnvelope(root, &b)
+}
+
+func verifyBundleEnvelope(root *TrustedRoot, b *bundleJSON) (*Attestation, error) {
+	leaf, err := bundleCertificate(b)
+	if err != nil {
+		return nil, err
+	}
+	env := b.DSSEEnvelope
+	if len(env.Signatures) != 1 {
+		return nil, fmt.Errorf("%w: %d DSSE signatures", errInvalidBundle, len(env.Signatures))
+	}
+	sig := env.Signatures[0].Sig
+	integrated, err := verifyTlogEntries(root, b.VerificationMaterial.TlogEntries, func(body *rekorBody) error {
+		return body.matchDSSE(leaf, sig, env.Payload)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyCertificate(root, leaf, integrated); err != nil {
+		return nil, err
+	}
+	if err := verifyMessageSignature(leaf.PublicKey, pae(env.PayloadType, env.Payload), sig); err != nil {
+		return nil, err
+	}
+	return &Attestation{
+		Signer:      *certificateSigner(leaf),
+		PayloadType: env.PayloadType,
+		Payload:     env.Payload,
+		Logged:      true,
+	}, nil
+}
+
+// VerifyAttestationEnvelope verifies a DSSE envelope carrying the Fulcio certificate of
+// its signature, as slsa-github-generator writes provenance. The envelope holds no
+// transparency log entry, so the certificate is verified as of its issuance.
+func VerifyAttestationEnvelope(root *TrustedRoot, content []byte) (*Attestation, error) {
+	var env envelopeJSON
+	if err := json.Unmarshal(content, &env); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidBundle, err)
+	}
+	if len(env.Signatures) != 1 {
+		return nil, fmt.Errorf("%w: %d DSSE signatures", errInvalidBundle, len(env.Signatures))
+	}
+	sig := env.Signatures[0]
+	if sig.Cert == "" {
+		return nil, fmt.Errorf("%w: DSSE envelope signed with a public key", errUnsupported)
+	}
+	der, err := decodeCertificatePEM([]byte(sig.Cert))
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: certificate: %w", errInvalidBundle, err)
+	}
+	if err := verifyCertificate(root, leaf, leaf.NotBefore); err != nil {
+		return nil, err
+	}
+	if err := verifyMessageSignature(leaf.PublicKey, pae(env.PayloadType, env.Payload), sig.Sig); err != nil {
+		return nil, err
+	}
+	return &Attestation{
+		Signer:      *certificateSigner(leaf),
+		PayloadType: env.PayloadType,
+		Payload:     env.Payload,
+	}, nil
+}
+
+// pae is the DSSE pre-authentication encoding of a payload.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// matchStatementSubject checks that the artifact is a subject of the in-toto statement
+// of an attestation.
+func matchStatementSubject(att *Attestation, artifact Digest) error {
+	var statement struct {
+		Subject []struct {
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+	}
+	if err := json.Unmarshal(att.Payload, &statement); err != nil {
+		return fmt.Errorf("%w: in-toto statement: %w", errInvalidBundle, err)
+	}
+	digests := map[string][]byte{"sha256": artifact.SHA256, "sha512": artifact.SHA512}
+	for _, s := range statement.Subject {
+		for alg, d := range digests {
+			if v, ok := s.Digest[alg]; ok && len(d) > 0 && v == hex.EncodeToString(d) {
+				return nil
+			}
+		}
+	}
+	return errDigestMismatch
+}
+
+func bundleCertificate(b *bundleJSON) (*x509.Certificate, error) {
+	var der []byte
+	switch vm := &b.VerificationMaterial; {
+	case vm.Certificate != nil:
+		der = vm.Certificate.RawBytes
+	case vm.X509CertificateChain != nil && len(vm.X509CertificateChain.Certificates) > 0:
+		der = vm.X509CertificateChain.Certificates[0].RawBytes
+	case vm.PublicKey != nil:
+		return nil, fmt.Errorf("%w: bundle signed with a public key", errUnsupported)
+	default:
+		return nil, fmt.Errorf("%w: no verification material", errInvalidBundle)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: certificate: %w", errInvalidBundle, err)
+	}
+	return cert, nil
+}
+
+// verifyCertificate checks that a Fulcio certificate chains to a certificate authority
+// of the trusted root, and was valid when its signature was logged.
+func verifyCertificate(root *TrustedRoot, leaf *x509.Certificate, at time.Time) error {
+	for i := range root.authorities {
+		ca := &root.authorities[i]
+		if !ca.validFor.contains(leaf.NotBefore) {
+			continue
+		}
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.root)
+		intermediates := x509.NewCertPool()
+		for _, c := range ca.intermediates {
+			intermediates.AddCert(c)
+		}
+		_, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			CurrentTime:   at,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		})
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: certificate doesn't chain to a trusted certificate authority at %s",
+		errUntrusted, at.UTC().Format(time.RFC3339))
+}
+
+func certificateSigner(cert *x509.Certificate) *Signer {
+	s := &Signer{}
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		s.Identity = cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		s.Identity = cert.URIs[0].String()
+	}
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				s.Issuer = issuer
+			}
+		case ext.Id.Equal(oidIssuer) && s.Issuer == "":
+			s.Issuer = string(ext.Value)
+		case ext.Id.Equal(oidSourceRepositoryURI):
+			var uri string
+			if _, err := asn1.Unmarshal(ext.Value, &uri); err == nil {
+				s.SourceRepository = uri
+			}
+		}
+	}
+	return s
+}
+
+// rekorBody is the canonicalized body of a Rekor entry, for the kinds signatures
+// of artifacts and attestations are logged as.
+type rekorBody struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		// hashedrekord
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+		// intoto
+		Content struct {
+			Envelope struct {
+				Signatures []intotoSignature `json:"signatures"`
+			} `json:"envelope"`
+			PayloadHash rekorHash `json:"payloadHash"`
+		} `json:"content"`
+		// dsse
+		PayloadHash rekorHash `json:"payloadHash"`
+		Signatures  []struct {
+			Signature []byte `json:"signature"`
+			Verifier  []byte `json:"verifier"`
+		} `json:"signatures"`
+	} `json:"spec"`
+}
+
+type intotoSignature struct {
+	PublicKey []byte `json:"publicKey"`
+	// Sig is base64 encoded, on top of the JSON encoding.
+	Sig []byte `json:"sig"`
+}
+
+type rekorHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+func (b *rekorBody) matchHashedRekord(leaf *x509.Certificate, sig, digest []byte) error {
+	if b.Kind != "hashedrekord" {
+		return fmt.Errorf("%w: tlog entry of kind %q for a message signature", errInvalidBundle, b.Kind)
+	}
+	if b.Spec.Data.Hash.Algorithm != "sha256" || b.Spec.Data.Hash.Value != hex.EncodeToString(digest) {
+		return fmt.Errorf("%w: tlog entry is for another artifact", errInvalidBundle)
+	}
+	if !bytes.Equal(b.Spec.Signature.Content, sig) || !pemMatches(b.Spec.Signature.PublicKey.Content, leaf) {
+		return fmt.Errorf("%w: tlog entry is for another signature", errInvalidBundle)
+	}
+	return nil
+}
+
+func (b *rekorBody) matchDSSE(leaf *x509.Certificate, sig, payload []byte) error {
+	payloadHash := sha256.Sum256(payload)
+	var hash rekorHash
+	matched := false
+	switch b.Kind {
+	case "intoto":
+		hash = b.Spec.Content.PayloadHash
+		matched = slices.ContainsFunc(b.Spec.Content.Envelope.Signatures, func(s intotoSignature) bool {
+			return string(s.Sig) == base64.StdEncoding.EncodeToString(sig) && pemMatches(s.PublicKey, leaf)
+		})
+	case "dsse":
+		hash = b.Spec.PayloadHash
+		for _, s := range b.Spec.Signatures {
+			matched = matched || (bytes.Equal(s.Signature, sig) && pemMatches(s.Verifier, leaf))
+		}
+	default:
+		return fmt.Errorf("%w: tlog entry of kind %q for a DSSE envelope", errInvalidBundle, b.Kind)
+	}
+	if hash.Algorithm != "sha256" || hash.Value != hex.EncodeToString(payloadHash[:]) {
+		return fmt.Errorf("%w: tlog entry is for another payload", errInvalidBundle)
+	}
+	if !matched {
+		return fmt.Errorf("%w: tlog entry is for another signature", errInvalidBundle)
+	}
+	return nil
+}
+
+// pemMatches returns whether a PEM encoded certificate is cert.
+func pemMatches(content []byte, cert *x509.Certificate) bool {
+	der, _ := decodeCertificatePEM(content)
+	return bytes.Equal(der, cert.Raw)
+}
@@ -0,0 +1,512 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVerifyAttestationBundle(t *testing.T) {
+	t.Parallel()
+	root, err := PublicGoodTrustedRoot()
+	if err != nil {
+		t.Fatalf("PublicGoodTrustedRoot: %v", err)
+	}
+	content, err := os.ReadFile("testdata/sigstore-js-2.0.0.sigstore.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	att, err := VerifyAttestationBundle(root, content)
+	if err != nil {
+		t.Fatalf("VerifyAttestationBundle: %v", err)
+	}
+	want := Signer{
+		Identity:         "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/main",
+		Issuer:           "https://token.actions.githubusercontent.com",
+		SourceRepository: "https://github.com/sigstore/sigstore-js",
+	}
+	if diff := cmp.Diff(want, att.Signer); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if att.PayloadType != "application/vnd.in-toto+json" || !att.Logged {
+		t.Errorf("PayloadType = %q, Logged = %v", att.PayloadType, att.Logged)
+	}
+
+	// The statement's subject is the npm tarball of sigstore 2.0.0.
+	sha512, err := hex.DecodeString("46d4e2f74c4877316640000a6fdf8a8b59f1e0847667973e9859f774dd31b8f1" +
+		"e0937813b777fb66a2ac67d50540fe34640966eee9fc2ccca387082b4c85cd3c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyBundle(root, content, Digest{SHA512: sha512}); err != nil {
+		t.Errorf("VerifyBundle: %v", err)
+	}
+	sha512[0] ^= 1
+	if _, err := VerifyBundle(root, content, Digest{SHA512: sha512}); !errors.Is(err, errDigestMismatch) {
+		t.Errorf("VerifyBundle of another artifact: %v, want %v", err, errDigestMismatch)
+	}
+
+	// Changing the payload invalidates the tlog entry and the signature.
+	var b map[string]any
+	if err := json.Unmarshal(content, &b); err != nil {
+		t.Fatal(err)
+	}
+	env, ok := b["dsseEnvelope"].(map[string]any)
+	if !ok {
+		t.Fatal("no dsseEnvelope")
+	}
+	env["payload"] = base64.StdEncoding.EncodeToString([]byte(`{"subject":[]}`))
+	tampered, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyAttestationBundle(root, tampered); err == nil {
+		t.Error("VerifyAttestationBundle of a tampered payload succeeded")
+	}
+}
+
+// testSigstore is a certificate authority and a transparency log to sign artifacts with.
+type testSigstore struct {
+	root      *TrustedRoot
+	caKey     *ecdsa.PrivateKey
+	caCert    *x509.Certificate
+	rekorKey  *ecdsa.PrivateKey
+	logID     []byte
+	integrate time.Time
+}
+
+func newTestSigstore(t *testing.T) *testSigstore {
+	t.Helper()
+	s := &testSigstore{integrate: time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC)}
+	var err error
+	if s.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &s.caKey.PublicKey, s.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.caCert, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+	if s.rekorKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&s.rekorKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logID := sha256.Sum256(pub)
+	s.logID = logID[:]
+	s.root = &TrustedRoot{
+		tlogs: map[string]*transparencyLog{
+			hex.EncodeToString(s.logID): {key: &s.rekorKey.PublicKey},
+		},
+		authorities: []certificateAuthority{{root: s.caCert}},
+	}
+	return s
+}
+
+// sign returns the certificate, signature and hashedrekord tlog entry of a keyless
+// signature of artifact, logged at s.integrate.
+func (s *testSigstore) sign(t *testing.T, artifact []byte) (certPEM, sig []byte, entry map[string]any) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := asn1.MarshalWithParams("https://token.actions.githubusercontent.com", "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := url.Parse("https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository, err := asn1.MarshalWithParams("https://github.com/owner/repo", "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    s.integrate.Add(-time.Minute),
+		NotAfter:     s.integrate.Add(9 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:         []*url.URL{identity},
+		ExtraExtensions: []pkix.Extension{
+			{Id: oidIssuerV2, Value: issuer},
+			{Id: oidSourceRepositoryURI, Value: repository},
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, s.caCert, &key.PublicKey, s.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	digest := sha256.Sum256(artifact)
+	if sig, err = ecdsa.SignASN1(rand.Reader, key, digest[:]); err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{"hash": map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(digest[:])}},
+			"signature": map[string]any{
+				"content":   sig,
+				"publicKey": map[string]any{"content": certPEM},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(map[string]any{
+		"body":           base64.StdEncoding.EncodeToString(body),
+		"integratedTime": s.integrate.Unix(),
+		"logID":          hex.EncodeToString(s.logID),
+		"logIndex":       42,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloadDigest := sha256.Sum256(payload)
+	set, err := ecdsa.SignASN1(rand.Reader, s.rekorKey, payloadDigest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry = map[string]any{
+		"body":           body,
+		"integratedTime": s.integrate.Unix(),
+		"logIndex":       42,
+		"set":            set,
+	}
+	return certPEM, sig, entry
+}
+
+func (s *testSigstore) bundle(t *testing.T, artifact []byte) []byte {
+	t.Helper()
+	certPEM, sig, entry := s.sign(t, artifact)
+	block, _ := pem.Decode(certPEM)
+	digest := sha256.Sum256(artifact)
+	b, err := json.Marshal(map[string]any{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": map[string]any{
+			"certificate": map[string]any{"rawBytes": block.Bytes},
+			"tlogEntries": []any{map[string]any{
+				"logIndex":          "42",
+				"logId":             map[string]any{"keyId": s.logID},
+				"kindVersion":       map[string]string{"kind": "hashedrekord", "version": "0.0.1"},
+				"integratedTime":    "1738411200",
+				"inclusionPromise":  map[string]any{"signedEntryTimestamp": entry["set"]},
+				"canonicalizedBody": entry["body"],
+			}},
+		},
+		"messageSignature": map[string]any{
+			"messageDigest": map[string]any{"algorithm": "SHA2_256", "digest": digest[:]},
+			"signature":     sig,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// proofOnlyBundle returns a bundle whose tlog entry has a valid inclusion proof,
+// but no inclusion promise signing its integrated time, which is forged.
+func (s *testSigstore) proofOnlyBundle(t *testing.T, artifact []byte) []byte {
+	t.Helper()
+	var b map[string]any
+	if err := json.Unmarshal(s.bundle(t, artifact), &b); err != nil {
+		t.Fatal(err)
+	}
+	entry, ok := b["verificationMaterial"].(map[string]any)["tlogEntries"].([]any)[0].(map[string]any)
+	if !ok {
+		t.Fatal("malformed bundle")
+	}
+	body, err := base64.StdEncoding.DecodeString(entry["canonicalizedBody"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The entry is the only leaf of the tree, so the root hash is its hash.
+	root := sha256.Sum256(append([]byte{0}, body...))
+	text := fmt.Sprintf("rekor.test\n1\n%s\n", base64.StdEncoding.EncodeToString(root[:]))
+	textDigest := sha256.Sum256([]byte(text))
+	sig, err := ecdsa.SignASN1(rand.Reader, s.rekorKey, textDigest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(entry, "inclusionPromise")
+	entry["integratedTime"] = strconv.FormatInt(s.integrate.Add(5*time.Minute).Unix(), 10)
+	entry["inclusionProof"] = map[string]any{
+		"logIndex": "0",
+		"treeSize": "1",
+		"rootHash": root[:],
+		"checkpoint": map[string]any{
+			"envelope": text + "\n— rekor.test " + base64.StdEncoding.EncodeToString(append(make([]byte, 4), sig...)) + "\n",
+		},
+	}
+	bundle, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bundle
+}
+
+func TestVerifyBundle(t *testing.T) {
+	t.Parallel()
+	s := newTestSigstore(t)
+	artifact := []byte("release artifact")
+	bundle := s.bundle(t, artifact)
+	digest, err := DigestOf(bytes.NewReader(artifact))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := newTestSigstore(t)
+	expired := newTestSigstore(t)
+	expired.root.authorities[0].validFor = validity{start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		root    *TrustedRoot
+		want    *Signer
+		name    string
+		bundle  []byte
+		digest  Digest
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			root:   s.root,
+			bundle: bundle,
+			digest: digest,
+			want: &Signer{
+				Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				Issuer:           "https://token.actions.githubusercontent.com",
+				SourceRepository: "https://github.com/owner/repo",
+			},
+		},
+		{
+			name:    "another artifact",
+			root:    s.root,
+			bundle:  bundle,
+			digest:  Digest{SHA256: make([]byte, sha256.Size)},
+			wantErr: true,
+		},
+		{
+			name:    "untrusted",
+			root:    other.root,
+			bundle:  bundle,
+			digest:  digest,
+			wantErr: true,
+		},
+		{
+			name:    "certificate authority not valid when signed",
+			root:    expired.root,
+			bundle:  expired.bundle(t, artifact),
+			digest:  digest,
+			wantErr: true,
+		},
+		{
+			name:    "inclusion proof without promise",
+			root:    s.root,
+			bundle:  s.proofOnlyBundle(t, artifact),
+			digest:  digest,
+			wantErr: true,
+		},
+		{
+			name:    "malformed",
+			root:    s.root,
+			bundle:  []byte("{"),
+			digest:  digest,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := VerifyBundle(tt.root, tt.bundle, tt.digest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyBundle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// envelope returns a DSSE envelope of payload carrying the certificate of its signature.
+func (s *testSigstore) envelope(t *testing.T, payload []byte) []byte {
+	t.Helper()
+	const payloadType = "application/vnd.in-toto+json"
+	certPEM, sig, _ := s.sign(t, pae(payloadType, payload))
+	env, err := json.Marshal(map[string]any{
+		"payloadType": payloadType,
+		"payload":     payload,
+		"signatures":  []any{map[string]any{"keyid": "", "sig": sig, "cert": string(certPEM)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return env
+}
+
+func TestVerifyAttestationEnvelope(t *testing.T) {
+	t.Parallel()
+	s := newTestSigstore(t)
+	payload := []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+	env := s.envelope(t, payload)
+	var unsigned map[string]any
+	if err := json.Unmarshal(env, &unsigned); err != nil {
+		t.Fatal(err)
+	}
+	tampered, err := json.Marshal(map[string]any{
+		"payloadType": unsigned["payloadType"],
+		"payload":     []byte(`{"_type":"https://in-toto.io/Statement/v0.1"}`),
+		"signatures":  unsigned["signatures"],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned["signatures"] = []any{map[string]any{"keyid": "key", "sig": "c2ln"}}
+	keyBased, err := json.Marshal(unsigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		root     *TrustedRoot
+		want     *Attestation
+		name     string
+		envelope []byte
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			root:     s.root,
+			envelope: env,
+			want: &Attestation{
+				Signer: Signer{
+					Identity:         "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+					Issuer:           "https://token.actions.githubusercontent.com",
+					SourceRepository: "https://github.com/owner/repo",
+				},
+				PayloadType: "application/vnd.in-toto+json",
+				Payload:     payload,
+			},
+		},
+		{
+			name:     "tampered payload",
+			root:     s.root,
+			envelope: tampered,
+			wantErr:  true,
+		},
+		{
+			name:     "untrusted",
+			root:     newTestSigstore(t).root,
+			envelope: env,
+			wantErr:  true,
+		},
+		{
+			name:     "signed with a key",
+			root:     s.root,
+			envelope: keyBased,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := VerifyAttestationEnvelope(tt.root, tt.envelope)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyAttestationEnvelope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	t.Parallel()
+	leaf := func(i byte) []byte {
+		h := sha256.Sum256([]byte{0, i})
+		return h[:]
+	}
+	// A tree of 5 leaves: ((0 1) (2 3)) 4.
+	h01 := hashChildren(leaf(0), leaf(1))
+	h23 := hashChildren(leaf(2), leaf(3))
+	h0123 := hashChildren(h01, h23)
+	root := hashChildren(h0123, leaf(4))
+
+	tests := []struct {
+		name    string
+		proof   [][]byte
+		index   uint64
+		wantErr bool
+	}{
+		{name: "first leaf", index: 0, proof: [][]byte{leaf(1), h23, leaf(4)}},
+		{name: "third leaf", index: 2, proof: [][]byte{leaf(3), h01, leaf(4)}},
+		{name: "last leaf", index: 4, proof: [][]byte{h0123}},
+		{name: "wrong index", index: 1, proof: [][]byte{leaf(1), h23, leaf(4)}, wantErr: true},
+		{name: "short proof", index: 0, proof: [][]byte{leaf(1), h23}, wantErr: true},
+		{name: "index beyond size", index: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := verifyInclusion(tt.index, 5, leaf(byte(tt.index)), tt.proof, root)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyInclusion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sigverify verifies the signatures of release artifacts offline: Sigstore
+// bundles against a pinned trusted root, cosign, minisign and OpenPGP signatures
+// against keys published with the source.
+package sigverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+var (
+	errInvalidTrustedRoot = errors.New("invalid trusted root")
+	errInvalidSignature   = errors.New("invalid signature")
+	errInvalidBundle      = errors.New("invalid bundle")
+	errInvalidKey         = errors.New("invalid key")
+	errUnsupported        = errors.New("unsupported")
+	errUntrusted          = errors.New("untrusted")
+	errDigestMismatch     = errors.New("artifact digest mismatch")
+	errNoKey              = errors.New("no matching key")
+)
+
+// Signer identifies the signer of a verified signature.
+type Signer struct {
+	// Identity is the subject of a Fulcio certificate, e.g. an email or a
+	// workflow URI, or the primary user ID of an OpenPGP key.
+	Identity string
+	// Issuer is the OIDC issuer which authenticated the subject of a Fulcio certificate.
+	Issuer string
+	// SourceRepository is the URI of the repository whose CI workflow requested a
+	// Fulcio certificate, e.g. "https://github.com/owner/repo".
+	SourceRepository string
+	// KeyID identifies the key of a key-based signature, e.g. an OpenPGP fingerprint.
+	KeyID string
+}
+
+// Digest holds the digests of an artifact.
+type Digest struct {
+	SHA256     []byte
+	SHA512     []byte
+	BLAKE2b512 []byte
+}
+
+// DigestOf returns the digests of the content of r.
+func DigestOf(r io.Reader) (Digest, error) {
+	s256, s512 := sha256.New(), sha512.New()
+	b2, err := blake2b.New512(nil)
+	if err != nil {
+		return Digest{}, fmt.Errorf("blake2b.New512: %w", err)
+	}
+	if _, err := io.Copy(io.MultiWriter(s256, s512, b2), r); err != nil {
+		return Digest{}, fmt.Errorf("io.Copy: %w", err)
+	}
+	return Digest{SHA256: s256.Sum(nil), SHA512: s512.Sum(nil), BLAKE2b512: b2.Sum(nil)}, nil
+}
+
+// verifyDigestSignature verifies a signature over the SHA-256 digest of a message.
+func verifyDigestSignature(key crypto.PublicKey, digest, sig []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().BitSize != 256 {
+			return fmt.Errorf("%w: ECDSA curve %s for a SHA-256 digest", errUnsupported, k.Curve.Params().Name)
+		}
+		if !ecdsa.VerifyASN1(k, digest, sig) {
+			return errInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest, sig); err != nil {
+			return fmt.Errorf("%w: %w", errInvalidSignature, err)
+		}
+	default:
+		return fmt.Errorf("%w: key type %T", errUnsupported, key)
+	}
+	return nil
+}
+
+// verifyMessageSignature verifies a signature over a message, hashed as the key requires.
+func verifyMessageSignature(key crypto.PublicKey, message, sig []byte) error {
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, message, sig) {
+			return errInvalidSignature
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		var digest []byte
+		switch k.Curve.Params().BitSize {
+		case 384:
+			d := sha512.Sum384(message)
+			digest = d[:]
+		case 521:
+			d := sha512.Sum512(message)
+			digest = d[:]
+		default:
+			d := sha256.Sum256(message)
+			digest = d[:]
+		}
+		if !ecdsa.VerifyASN1(k, digest, sig) {
+			return errInvalidSignature
+		}
+		return nil
+	}
+	d := sha256.Sum256(message)
+	return verifyDigestSignature(key, d[:], sig)
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errTlog = errors.New("invalid transparency log entry")
+
+type tlogEntryJSON struct {
+	InclusionPromise *struct {
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	} `json:"inclusionPromise"`
+	InclusionProof *struct {
+		Checkpoint struct {
+			Envelope string `json:"envelope"`
+		} `json:"checkpoint"`
+		RootHash []byte   `json:"rootHash"`
+		Hashes   [][]byte `json:"hashes"`
+		LogIndex jsonInt  `json:"logIndex"`
+		TreeSize jsonInt  `json:"treeSize"`
+	} `json:"inclusionProof"`
+	LogID struct {
+		KeyID []byte `json:"keyId"`
+	} `json:"logId"`
+	CanonicalizedBody []byte  `json:"canonicalizedBody"`
+	LogIndex          jsonInt `json:"logIndex"`
+	IntegratedTime    jsonInt `json:"integratedTime"`
+}
+
+// verifyTlogEntries verifies that a signature was logged by a transparency log of the
+// trusted root, with an entry matching the signature, and returns when it was logged.
+func verifyTlogEntries(root *TrustedRoot, entries []tlogEntryJSON, match func(*rekorBody) error) (time.Time, error) {
+	if len(entries) == 0 {
+		return time.Time{}, fmt.Errorf("%w: no transparency log entry", errUntrusted)
+	}
+	var errs []error
+	for i := range entries {
+		integrated, err := verifyTlogEntry(root, &entries[i], match)
+		if err == nil {
+			return integrated, nil
+		}
+		errs = append(errs, err)
+	}
+	return time.Time{}, errors.Join(errs...)
+}
+
+func verifyTlogEntry(root *TrustedRoot, e *tlogEntryJSON, match func(*rekorBody) error) (time.Time, error) {
+	logID := hex.EncodeToString(e.LogID.KeyID)
+	tlog, ok := root.tlogs[logID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: unknown log %s", errUntrusted, logID)
+	}
+	integrated := time.Unix(int64(e.IntegratedTime), 0)
+	if !tlog.validFor.contains(integrated) {
+		return time.Time{}, fmt.Errorf("%w: log key not valid at %s", errUntrusted, integrated.UTC().Format(time.RFC3339))
+	}
+	var body rekorBody
+	if err := json.Unmarshal(e.CanonicalizedBody, &body); err != nil {
+		return time.Time{}, fmt.Errorf("%w: body: %w", errTlog, err)
+	}
+	if err := match(&body); err != nil {
+		return time.Time{}, err
+	}
+	// Only the inclusion promise signs the integrated time, which an inclusion
+	// proof alone doesn't vouch for.
+	if e.InclusionPromise == nil {
+		return time.Time{}, fmt.Errorf("%w: no inclusion promise", errTlog)
+	}
+	// The signed entry timestamp signs the canonical JSON of the entry, whose
+	// keys are sorted.
+	payload, err := json.Marshal(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{
+		Body:           base64.StdEncoding.EncodeToString(e.CanonicalizedBody),
+		IntegratedTime: int64(e.IntegratedTime),
+		LogID:          logID,
+		LogIndex:       int64(e.LogIndex),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("json.Marshal: %w", err)
+	}
+	if err := verifyMessageSignature(tlog.key, payload, e.InclusionPromise.SignedEntryTimestamp); err != nil {
+		return time.Time{}, fmt.Errorf("%w: signed entry timestamp: %w", errTlog, err)
+	}
+	if p := e.InclusionProof; p != nil {
+		leaf := sha256.Sum256(append([]byte{0}, e.CanonicalizedBody...))
+		if err := verifyInclusion(uint64(p.LogIndex), uint64(p.TreeSize), leaf[:], p.Hashes, p.RootHash); err != nil {
+			return time.Time{}, err
+		}
+		if err := verifyCheckpoint(tlog, p.Checkpoint.Envelope, uint64(p.TreeSize), p.RootHash); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return integrated, nil
+}
+
+// verifyInclusion verifies a Merkle inclusion proof, as specified by RFC 9162, section 2.1.3.2.
+func verifyInclusion(index, size uint64, leaf []byte, proof [][]byte, root []byte) error {
+	if index >= size {
+		return fmt.Errorf("%w: leaf index %d beyond tree size %d", errTlog, index, size)
+	}
+	fn, sn := index, size-1
+	r := leaf
+	for _, p := range proof {
+		if sn == 0 {
+			return fmt.Errorf("%w: inclusion proof too long", errTlog)
+		}
+		if fn&1 == 1 || fn == sn {
+			r = hashChildren(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = hashChildren(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(r, root) {
+		return fmt.Errorf("%w: inclusion proof doesn't match the root hash", errTlog)
+	}
+	return nil
+}
+
+func hashChildren(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// verifyCheckpoint verifies that a checkpoint, a signed note with the origin, size and root
+// hash of the log, is signed by the log and commits to the tree the inclusion proof is for.
+// https://github.com/transparency-dev/formats/blob/main/log/README.md
+func verifyCheckpoint(tlog *transparencyLog, envelope string, size uint64, root []byte) error {
+	text, sigs, ok := strings.Cut(envelope, "\n\n")
+	if !ok {
+		return fmt.Errorf("%w: malformed checkpoint", errTlog)
+	}
+	text += "\n"
+	lines := strings.Split(text, "\n")
+	if len(lines) < 3 {
+		return fmt.Errorf("%w: malformed checkpoint", errTlog)
+	}
+	if n, err := strconv.ParseUint(lines[1], 10, 64); err != nil || n != size {
+		return fmt.Errorf("%w: checkpoint is for another tree size", errTlog)
+	}
+	if h, err := base64.StdEncoding.DecodeString(lines[2]); err != nil || !bytes.Equal(h, root) {
+		return fmt.Errorf("%w: checkpoint is for another root hash", errTlog)
+	}
+	for _, line := range strings.Split(sigs, "\n") {
+		// — <name> <base64 of the key hint and signature>
+		fields := strings.Fields(strings.TrimPrefix(line, "— "))
+		if len(fields) != 2 {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(sig) < 5 {
+			continue
+		}
+		if verifyMessageSignature(tlog.key, []byte(text), sig[4:]) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: checkpoint isn't signed by the log", errTlog)
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"crypto"
+	"crypto/x509"
+	_ "embed"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// publicGoodTrustedRoot is the trusted root of the Sigstore public-good instance, from
+// https://github.com/sigstore/root-signing. It's pinned so that bundles are verified
+// offline, and needs to be updated when Fulcio or Rekor rotate their keys.
+//
+//go:embed trusted_root.json
+var publicGoodTrustedRoot []byte
+
+// TrustedRoot holds the certificate authorities and transparency logs signatures are
+// verified against.
+type TrustedRoot struct {
+	// tlogs maps the hex encoded log IDs to the transparency logs.
+	tlogs       map[string]*transparencyLog
+	authorities []certificateAuthority
+}
+
+type validity struct {
+	start time.Time
+	end   time.Time
+}
+
+func (v *validity) contains(t time.Time) bool {
+	return !t.Before(v.start) && (v.end.IsZero() || !t.After(v.end))
+}
+
+type certificateAuthority struct {
+	root          *x509.Certificate
+	intermediates []*x509.Certificate
+	validFor      validity
+}
+
+type transparencyLog struct {
+	key      crypto.PublicKey
+	validFor validity
+}
+
+type trustedRootJSON struct {
+	Tlogs []struct {
+		PublicKey struct {
+			ValidFor *validityJSON `json:"validFor"`
+			RawBytes string        `json:"rawBytes"`
+		} `json:"publicKey"`
+		LogID struct {
+			KeyID string `json:"keyId"`
+		} `json:"logId"`
+	} `json:"tlogs"`
+	CertificateAuthorities []struct {
+		ValidFor  *validityJSON `json:"validFor"`
+		CertChain struct {
+			Certificates []struct {
+				RawBytes string `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"certChain"`
+	} `json:"certificateAuthorities"`
+}
+
+type validityJSON struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+func (v *validityJSON) validity() validity {
+	if v == nil {
+		return validity{}
+	}
+	return validity{start: v.Start, end: v.End}
+}
+
+var publicGood = sync.OnceValues(func() (*TrustedRoot, error) {
+	return ParseTrustedRoot(publicGoodTrustedRoot)
+})
+
+// PublicGoodTrustedRoot returns the pinned trusted root of the Sigstore public-good instance.
+func PublicGoodTrustedRoot() (*TrustedRoot, error) {
+	return publicGood()
+}
+
+// ParseTrustedRoot parses a trusted root in the format of the Sigstore trusted_root.json.
+func ParseTrustedRoot(content []byte) (*TrustedRoot, error) {
+	var tr trustedRootJSON
+	if err := json.Unmarshal(content, &tr); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTrustedRoot, err)
+	}
+	root := &TrustedRoot{tlogs: map[string]*transparencyLog{}}
+	for _, tlog := range tr.Tlogs {
+		der, err := base64.StdEncoding.DecodeString(tlog.PublicKey.RawBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tlog key: %w", errInvalidTrustedRoot, err)
+		}
+		key, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tlog key: %w", errInvalidTrustedRoot, err)
+		}
+		logID, err := base64.StdEncoding.DecodeString(tlog.LogID.KeyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tlog ID: %w", errInvalidTrustedRoot, err)
+		}
+		root.tlogs[hex.EncodeToString(logID)] = &transparencyLog{
+			key:      key,
+			validFor: tlog.PublicKey.ValidFor.validity(),
+		}
+	}
+	for _, ca := range tr.CertificateAuthorities {
+		var certs []*x509.Certificate
+		for _, c := range ca.CertChain.Certificates {
+			der, err := base64.StdEncoding.DecodeString(c.RawBytes)
+			if err != nil {
+				return nil, fmt.Errorf("%w: certificate: %w", errInvalidTrustedRoot, err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("%w: certificate: %w", errInvalidTrustedRoot, err)
+			}
+			certs = append(certs, cert)
+		}
+		if len(certs) == 0 {
+			return nil, fmt.Errorf("%w: empty certificate chain", errInvalidTrustedRoot)
+		}
+		// Chains are ordered from the intermediates to the root.
+		root.authorities = append(root.authorities, certificateAuthority{
+			root:          certs[len(certs)-1],
+			intermediates: certs[:len(certs)-1],
+			validFor:      ca.ValidFor.validity(),
+		})
+	}
+	return root, nil
+}
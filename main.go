@@ -16,15 +16,26 @@
 package main
 
 import (
+	"errors"
 	"log"
+	"os"
 
 	"github.com/ossf/scorecard/v5/cmd"
+	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/options"
 )
 
+// exitPolicyViolation is the exit code when the results don't meet the policy,
+// distinct from failures to run (1) and panics (2).
+const exitPolicyViolation = 3
+
 func main() {
 	opts := options.New()
 	if err := cmd.New(opts).Execute(); err != nil {
+		if errors.Is(err, sce.ErrPolicyViolation) {
+			log.Printf("error during command execution: %v", err)
+			os.Exit(exitPolicyViolation)
+		}
 		log.Fatalf("error during command execution: %v", err)
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ossf/scorecard/v5/checks"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 )
 
 const (
@@ -33,6 +34,9 @@ const (
 	// FlagCommit is the flag name for specifying a commit.
 	FlagCommit = "commit"
 
+	// FlagBase is the flag name for specifying a base commit to compare with.
+	FlagBase = "base"
+
 	// FlagLogLevel is the flag name for specifying the log level.
 	FlagLogLevel = "verbosity"
 
@@ -78,6 +82,21 @@ const (
 	FlagCommitDepth = "commit-depth"
 
 	FlagProbes = "probes"
+
+	// FlagRecord is the flag name for specifying the archive to record a run to.
+	FlagRecord = "record"
+
+	// FlagReplay is the flag name for specifying the archive to replay a run from.
+	FlagReplay = "replay"
+
+	// FlagCacheDir is the flag name for specifying the HTTP cache directory.
+	FlagCacheDir = "cache-dir"
+
+	// FlagCacheMaxSize is the flag name for specifying the HTTP cache size limit.
+	FlagCacheMaxSize = "cache-max-size"
+
+	// FlagCacheTTL is the flag name for specifying how long cached responses are used without revalidation.
+	FlagCacheTTL = "cache-ttl"
 )
 
 // Command is an interface for handling options for command-line utilities.
@@ -109,6 +128,13 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"commit to analyze",
 	)
 
+	cmd.Flags().StringVar(
+		&o.Base,
+		FlagBase,
+		o.Base,
+		"base commit to compare the analyzed commit with, to only report the findings it introduces",
+	)
+
 	cmd.Flags().StringVar(
 		&o.LogLevel,
 		FlagLogLevel,
@@ -198,14 +224,14 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		FormatInToto,
 	}
 
-	if o.isSarifEnabled() {
-		cmd.Flags().StringVar(
-			&o.PolicyFile,
-			FlagPolicyFile,
-			o.PolicyFile,
-			"policy to enforce",
-		)
+	cmd.Flags().StringVar(
+		&o.PolicyFile,
+		FlagPolicyFile,
+		o.PolicyFile,
+		"policy to enforce",
+	)
 
+	if o.isSarifEnabled() {
 		allowedFormats = append(allowedFormats, FormatSarif)
 	}
 
@@ -227,6 +253,42 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"output file",
 	)
 
+	cmd.Flags().StringVar(
+		&o.Record,
+		FlagRecord,
+		o.Record,
+		"record every HTTP exchange of the run to an archive file, for replaying it with --replay",
+	)
+
+	cmd.Flags().StringVar(
+		&o.Replay,
+		FlagReplay,
+		o.Replay,
+		"replay a run offline from an archive file created with --record",
+	)
+
+	// The cache applies to every subcommand talking to a forge.
+	cmd.PersistentFlags().StringVar(
+		&o.CacheDir,
+		FlagCacheDir,
+		o.CacheDir,
+		"directory to cache forge API responses in, revalidated with conditional requests (disabled if empty)",
+	)
+
+	cmd.PersistentFlags().IntVar(
+		&o.CacheMaxSize,
+		FlagCacheMaxSize,
+		o.CacheMaxSize,
+		fmt.Sprintf("size limit of the cache directory in MiB (default %d)", httpcache.DefaultMaxSize>>20),
+	)
+
+	cmd.PersistentFlags().DurationVar(
+		&o.CacheTTL,
+		FlagCacheTTL,
+		o.CacheTTL,
+		"how long cached responses are used without revalidating them",
+	)
+
 	allowedModes := []string{FileModeArchive, FileModeGit}
 	cmd.Flags().StringVar(
 		&o.FileMode,
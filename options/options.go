@@ -21,10 +21,12 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 	sclog "github.com/ossf/scorecard/v5/log"
 )
 
@@ -33,6 +35,7 @@ type Options struct {
 	Repo            string
 	Local           string
 	Commit          string
+	Base            string
 	LogLevel        string
 	Format          string
 	NPM             string
@@ -48,6 +51,13 @@ type Options struct {
 	CommitDepth     int
 	ShowDetails     bool
 	ShowAnnotations bool
+	// HTTP cache for forge API clients, disabled when CacheDir is empty.
+	CacheDir     string        `env:"SCORECARD_CACHE_DIR"`
+	CacheMaxSize int           `env:"SCORECARD_CACHE_MAX_SIZE"`
+	CacheTTL     time.Duration `env:"SCORECARD_CACHE_TTL"`
+	// Archive of the HTTP exchanges of a run, to record or to replay offline.
+	Record string
+	Replay string
 	// Feature flags.
 	EnableSarif                 bool `env:"ENABLE_SARIF"`
 	EnableScorecardV6           bool `env:"SCORECARD_V6"`
@@ -108,18 +118,30 @@ var (
 	// DefaultLogLevel retrieves the default log level.
 	DefaultLogLevel = sclog.DefaultLevel.String()
 
-	errCommitIsEmpty          = errors.New("commit should be non-empty")
-	errFormatNotSupported     = errors.New("unsupported format")
-	errFileModeNotSupported   = errors.New("unsupported file mode")
-	errPolicyFileNotSupported = errors.New("policy file is not supported yet")
-	errRawOptionNotSupported  = errors.New("raw option is not supported yet")
-	errRepoOptionMustBeSet    = errors.New(
+	errBaseFormat            = errors.New("comparing with a base commit requires the probe or sarif format")
+	errBaseLocal             = errors.New("local directories can't be compared with a base commit")
+	errCommitIsEmpty         = errors.New("commit should be non-empty")
+	errFormatNotSupported    = errors.New("unsupported format")
+	errFileModeNotSupported  = errors.New("unsupported file mode")
+	errRawOptionNotSupported = errors.New("raw option is not supported yet")
+	errRecordAndReplay       = errors.New("only one of --record and --replay can be set")
+	errRecordFileModeGit     = errors.New("recording and replaying require the archive file mode")
+	errRepoOptionMustBeSet   = errors.New(
 		"exactly one of `repo`, `npm`, `pypi`, `rubygems`, `nuget` or `local` must be set",
 	)
 	errSARIFNotSupported = errors.New("SARIF format is not supported yet")
 	errValidate          = errors.New("some options could not be validated")
 )
 
+// HTTPCache returns the configuration of the forge API response cache.
+func (o *Options) HTTPCache() httpcache.Options {
+	return httpcache.Options{
+		Dir:     o.CacheDir,
+		MaxSize: int64(o.CacheMaxSize) << 20,
+		TTL:     o.CacheTTL,
+	}
+}
+
 // Validate validates scorecard configuration options.
 // TODO(options): Cleanup error messages.
 func (o *Options) Validate() error {
@@ -146,12 +168,6 @@ func (o *Options) Validate() error {
 				errSARIFNotSupported,
 			)
 		}
-		if o.PolicyFile != "" {
-			errs = append(
-				errs,
-				errPolicyFileNotSupported,
-			)
-		}
 	}
 
 	// Validate V6 features are flag-guarded.
@@ -180,6 +196,20 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	// Validate only the introduced findings, not the checks, are output.
+	if o.Base != "" && o.Format != FormatProbe && o.Format != FormatSarif {
+		errs = append(
+			errs,
+			errBaseFormat,
+		)
+	}
+	if o.Base != "" && o.Local != "" {
+		errs = append(
+			errs,
+			errBaseLocal,
+		)
+	}
+
 	if !validateFileMode(o.FileMode) {
 		errs = append(
 			errs,
@@ -187,6 +217,21 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	// Validate a run is either recorded or replayed. Git clones don't go
+	// through the recorded HTTP clients.
+	if o.Record != "" && o.Replay != "" {
+		errs = append(
+			errs,
+			errRecordAndReplay,
+		)
+	}
+	if (o.Record != "" || o.Replay != "") && strings.EqualFold(o.FileMode, FileModeGit) {
+		errs = append(
+			errs,
+			errRecordFileModeGit,
+		)
+	}
+
 	if len(errs) != 0 {
 		return fmt.Errorf(
 			"%w: %+v",
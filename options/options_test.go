@@ -24,6 +24,7 @@ func TestOptions_Validate(t *testing.T) {
 		Repo              string
 		Local             string
 		Commit            string
+		Base              string
 		LogLevel          string
 		Format            string
 		NPM               string
@@ -33,6 +34,8 @@ func TestOptions_Validate(t *testing.T) {
 		PolicyFile        string
 		ResultsFile       string
 		FileMode          string
+		Record            string
+		Replay            string
 		ChecksToRun       []string
 		Metadata          []string
 		ShowDetails       bool
@@ -68,13 +71,14 @@ func TestOptions_Validate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "format sarif and the disabled but the policy file is set",
+			name: "policy file is supported in every format",
 			fields: fields{
 				Repo:       "github.com/ossf/scorecard",
 				Commit:     "HEAD",
+				Format:     "json",
 				PolicyFile: "testdata/policy.yaml",
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
 			name: "format raw is not supported when V6 is not enabled",
@@ -105,6 +109,68 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "record is valid",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				Record: "run.zip",
+			},
+			wantErr: false,
+		},
+		{
+			name: "record and replay are exclusive",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				Record: "run.zip",
+				Replay: "run.zip",
+			},
+			wantErr: true,
+		},
+		{
+			name: "replay requires archive filemode",
+			fields: fields{
+				Repo:     "github.com/ossf/scorecard",
+				Commit:   "HEAD",
+				Format:   "default",
+				FileMode: FileModeGit,
+				Replay:   "run.zip",
+			},
+			wantErr: true,
+		},
+		{
+			name: "base is valid with the probe format",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Base:   "1234567890abcdef",
+				Format: "probe",
+			},
+			wantErr: false,
+		},
+		{
+			name: "base requires the probe or sarif format",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Base:   "1234567890abcdef",
+				Format: "json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "base is not supported for local directories",
+			fields: fields{
+				Local:  ".",
+				Commit: "HEAD",
+				Base:   "1234567890abcdef",
+				Format: "probe",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -116,9 +182,12 @@ func TestOptions_Validate(t *testing.T) {
 				Repo:              tt.fields.Repo,
 				Local:             tt.fields.Local,
 				Commit:            tt.fields.Commit,
+				Base:              tt.fields.Base,
 				LogLevel:          tt.fields.LogLevel,
 				Format:            tt.fields.Format,
 				FileMode:          tt.fields.FileMode,
+				Record:            tt.fields.Record,
+				Replay:            tt.fields.Replay,
 				NPM:               tt.fields.NPM,
 				PyPI:              tt.fields.PyPI,
 				RubyGems:          tt.fields.RubyGems,
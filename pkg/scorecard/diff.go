@@ -0,0 +1,64 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// findingKey identifies a finding across commits. Line numbers are left out,
+// as unrelated changes above a finding move it.
+type findingKey struct {
+	probe   string
+	path    string
+	snippet string
+	outcome finding.Outcome
+}
+
+func keyOf(f *finding.Finding) findingKey {
+	k := findingKey{probe: f.Probe, outcome: f.Outcome}
+	if f.Location != nil {
+		k.path = f.Location.Path
+		if f.Location.Snippet != nil {
+			k.snippet = *f.Location.Snippet
+		}
+	}
+	return k
+}
+
+// introducedFindings returns the findings of head which need a remediation and
+// which base didn't have: those at a new location, or whose outcome regressed.
+// Identical findings are counted, so a second copy of an unpinned dependency
+// in a file is reported.
+func introducedFindings(base, head []finding.Finding) []finding.Finding {
+	seen := make(map[findingKey]int, len(base))
+	for i := range base {
+		seen[keyOf(&base[i])]++
+	}
+
+	introduced := []finding.Finding{}
+	for i := range head {
+		f := &head[i]
+		k := keyOf(f)
+		if seen[k] > 0 {
+			seen[k]--
+			continue
+		}
+		if f.NeedsRemediation() {
+			introduced = append(introduced, *f)
+		}
+	}
+	return introduced
+}
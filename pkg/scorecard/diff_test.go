@@ -0,0 +1,114 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const testProbeDef = `id: testProbe
+lifecycle: stable
+short: short description
+motivation: motivation
+implementation: implementation
+remediation:
+  onOutcome: True
+  effort: Low
+  text:
+    - step1
+  markdown:
+    - step1
+`
+
+func testFinding(t *testing.T, path, snippet string, line uint, o finding.Outcome) finding.Finding {
+	t.Helper()
+	f, err := finding.FromBytes([]byte(testProbeDef), "testProbe")
+	if err != nil {
+		t.Fatalf("finding.FromBytes: %v", err)
+	}
+	var loc *finding.Location
+	if path != "" {
+		loc = &finding.Location{Path: path, Snippet: &snippet, LineStart: &line}
+	}
+	return *f.WithOutcome(o).WithLocation(loc)
+}
+
+func Test_introducedFindings(t *testing.T) {
+	t.Parallel()
+	// For the test probe, a true outcome needs a remediation.
+	unpinned := testFinding(t, ".github/workflows/ci.yml", "uses: actions/checkout@v4", 10, finding.OutcomeTrue)
+	unpinnedMoved := testFinding(t, ".github/workflows/ci.yml", "uses: actions/checkout@v4", 25, finding.OutcomeTrue)
+	unpinnedElsewhere := testFinding(t, ".github/workflows/release.yml", "uses: actions/checkout@v4", 10, finding.OutcomeTrue)
+	pinned := testFinding(t, ".github/workflows/ci.yml", "uses: actions/checkout@v4", 10, finding.OutcomeFalse)
+	repoGood := testFinding(t, "", "", 0, finding.OutcomeFalse)
+	repoBad := testFinding(t, "", "", 0, finding.OutcomeTrue)
+
+	tests := []struct {
+		name       string
+		base, head []finding.Finding
+		want       []finding.Finding
+	}{
+		{
+			name: "unchanged",
+			base: []finding.Finding{unpinned, repoBad},
+			head: []finding.Finding{unpinned, repoBad},
+			want: []finding.Finding{},
+		},
+		{
+			name: "moved by unrelated lines",
+			base: []finding.Finding{unpinned},
+			head: []finding.Finding{unpinnedMoved},
+			want: []finding.Finding{},
+		},
+		{
+			name: "new location",
+			base: []finding.Finding{unpinned},
+			head: []finding.Finding{unpinned, unpinnedElsewhere},
+			want: []finding.Finding{unpinnedElsewhere},
+		},
+		{
+			name: "second copy in the same file",
+			base: []finding.Finding{unpinned},
+			head: []finding.Finding{unpinned, unpinnedMoved},
+			want: []finding.Finding{unpinnedMoved},
+		},
+		{
+			name: "regressed outcome",
+			base: []finding.Finding{pinned, repoGood},
+			head: []finding.Finding{unpinned, repoBad},
+			want: []finding.Finding{unpinned, repoBad},
+		},
+		{
+			name: "improvements are not reported",
+			base: []finding.Finding{unpinned, repoBad},
+			head: []finding.Finding{pinned, repoGood},
+			want: []finding.Finding{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := introducedFindings(tt.base, tt.head)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(finding.Finding{})); diff != "" {
+				t.Errorf("introducedFindings() mismatch (-want +got): %s", diff)
+			}
+		})
+	}
+}
@@ -60,6 +60,8 @@ type jsonCheckResultV2 struct {
 type jsonRepoV2 struct {
 	Name   string `json:"name"`
 	Commit string `json:"commit"`
+	// Base is only set for the probe format, when comparing commits.
+	Base string `json:"base,omitempty"`
 }
 
 type jsonScorecardV2 struct {
@@ -86,6 +88,9 @@ type JSONScorecardResultV2 struct {
 	AggregateScore jsonFloatScore      `json:"score"`
 	Checks         []jsonCheckResultV2 `json:"checks"`
 	Metadata       []string            `json:"metadata"`
+	// AnnotationWarnings reports problems with the maintainer annotations,
+	// e.g. expired ones.
+	AnnotationWarnings []string `json:"annotationWarnings,omitempty"`
 }
 
 // AsJSON2ResultOption provides configuration options for JSON2 Scorecard results.
@@ -189,6 +194,9 @@ func (r *Result) resultsToJSON2(checkDocs docs.Doc, opt *AsJSON2ResultOption) (J
 		}
 		out.Checks = append(out.Checks, tmpResult)
 	}
+	if opt.Annotations {
+		out.AnnotationWarnings = r.Config.Warnings
+	}
 	return out, nil
 }
 
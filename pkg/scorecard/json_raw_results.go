@@ -42,6 +42,8 @@ type jsonScorecardRawResult struct {
 type jsonFile struct {
 	Snippet   *string `json:"snippet,omitempty"`
 	Path      string  `json:"path"`
+	Format    string  `json:"format,omitempty"`
+	Arch      string  `json:"arch,omitempty"`
 	Offset    uint    `json:"offset,omitempty"`
 	EndOffset uint    `json:"endOffset,omitempty"`
 }
@@ -630,7 +632,9 @@ func (r *jsonScorecardRawResult) addBinaryArtifactRawResults(ba *checker.BinaryA
 	r.Results.Binaries = []jsonFile{}
 	for _, v := range ba.Files {
 		r.Results.Binaries = append(r.Results.Binaries, jsonFile{
-			Path: v.Path,
+			Path:   v.Path,
+			Format: string(v.Format),
+			Arch:   v.Arch,
 		})
 	}
 	return nil
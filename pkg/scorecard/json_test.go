@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xeipuuv/gojsonschema"
 
 	"github.com/ossf/scorecard/v5/checker"
@@ -588,3 +589,37 @@ func TestExperimentalFromJSON2_time(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONOutput_annotationWarnings(t *testing.T) {
+	t.Parallel()
+	r := &Result{
+		Checks: []checker.CheckResult{{Name: "Check-Name", Score: 5}},
+		Config: config.Config{Warnings: []string{"annotation of check-name expired on 2025-01-31"}},
+	}
+	tests := []struct {
+		name        string
+		want        []string
+		annotations bool
+	}{
+		{
+			name:        "with annotations",
+			annotations: true,
+			want:        []string{"annotation of check-name expired on 2025-01-31"},
+		},
+		{
+			name: "without annotations",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			out, err := r.resultsToJSON2(jsonMockDocRead(), &AsJSON2ResultOption{Annotations: tt.annotations})
+			if err != nil {
+				t.Fatalf("resultsToJSON2: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, out.AnnotationWarnings); diff != "" {
+				t.Errorf("annotation warnings (-want,+got): %s", diff)
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ func (r *Result) AsProbe(writer io.Writer, o *ProbeResultOption) error {
 		Repo: jsonRepoV2{
 			Name:   r.Repo.Name,
 			Commit: r.Repo.CommitSHA,
+			Base:   r.Repo.BaseCommitSHA,
 		},
 		Scorecard: jsonScorecardV2{
 			Version: r.Scorecard.Version,
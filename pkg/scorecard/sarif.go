@@ -140,9 +140,21 @@ type automationDetails struct {
 	ID string `json:"id"`
 }
 
+type notification struct {
+	Level   string `json:"level"`
+	Message text   `json:"message"`
+}
+
+type invocation struct {
+	ToolConfigurationNotifications []notification `json:"toolConfigurationNotifications"`
+	ExecutionSuccessful            bool           `json:"executionSuccessful"`
+}
+
 type run struct {
 	AutomationDetails automationDetails `json:"automationDetails"`
 	Tool              tool              `json:"tool"`
+	// Reports problems with the maintainer annotations.
+	Invocations []invocation `json:"invocations,omitempty"`
 	// For generated files during analysis. We leave this blank.
 	// See https://github.com/microsoft/sarif-tutorials/blob/main/docs/1-Introduction.md#simple-example.
 	Artifacts string `json:"artifacts,omitempty"`
@@ -509,6 +521,10 @@ func createSARIFCheckResult(pos int, checkID, message string, loc *location) res
 }
 
 func getCheckPolicyInfo(policy *spol.ScorecardPolicy, name string) (minScore int, enabled bool, err error) {
+	// Checks only run for probe policies have no minimum score.
+	if spol.IsProbeOnlyCheck(policy, name) {
+		return 0, false, nil
+	}
 	policies := policy.GetPolicies()
 	if _, exists := policies[name]; !exists {
 		return 0, false,
@@ -622,6 +638,10 @@ func (r *Result) AsSARIF(showDetails bool, logLevel log.Level,
 	// see https://docs.github.com/en/code-security/secure-coding/integrating-with-code-scanning/sarif-support-for-code-scanning#supported-sarif-output-file-properties,
 	// https://github.com/microsoft/sarif-tutorials.
 	sarif := createSARIFHeader()
+	if r.Repo.BaseCommitSHA != "" {
+		sarif.Runs = []run{r.createIntroducedFindingsRun(policy, opts)}
+		return r.writeSARIF(writer, &sarif)
+	}
 	runs := make(map[string]*run)
 
 	for _, check := range r.Checks {
@@ -712,6 +732,95 @@ func (r *Result) AsSARIF(showDetails bool, logLevel log.Level,
 
 	// Set the sarif's runs.
 	sarif.Runs = createSARIFRuns(runs)
+	return r.writeSARIF(writer, &sarif)
+}
+
+// createIntroducedFindingsRun returns a run with a result for each finding
+// introduced since the base commit, and a rule for each of their probes.
+func (r *Result) createIntroducedFindingsRun(policy *spol.ScorecardPolicy, opts *options.Options) run {
+	run := createSARIFRun("https://github.com/ossf/scorecard", toolName(opts),
+		r.Scorecard.Version, r.Scorecard.CommitSHA, r.Date, "supply-chain", "introduced")
+	ruleIndexes := map[string]int{}
+	for i := range r.Findings {
+		f := &r.Findings[i]
+		ruleIndex, exists := ruleIndexes[f.Probe]
+		if !exists {
+			ruleIndex = len(run.Tool.Driver.Rules)
+			ruleIndexes[f.Probe] = ruleIndex
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules,
+				createSARIFProbeRule(f.Probe, probeRisk(policy, f.Probe)))
+		}
+
+		// Findings are located like the details of checks.
+		d := checker.CheckDetail{Type: checker.DetailWarn, Msg: checker.LogMessage{Finding: f}}
+		var loc location
+		if getPath(&d) == "" || getLocationType(&d) == finding.FileTypeURL {
+			loc = addDefaultLocation(nil, "no file associated with this alert")[0]
+			loc.Message = getText(&d)
+		} else {
+			loc = location{
+				PhysicalLocation: physicalLocation{
+					ArtifactLocation: artifactLocation{
+						URI:       getPath(&d),
+						URIBaseID: "%SRCROOT%",
+					},
+					Region: detailToRegion(&d),
+				},
+				Message: getText(&d),
+			}
+		}
+		setRemediation(&loc, &d)
+		run.Results = append(run.Results, createSARIFCheckResult(ruleIndex, f.Probe, loc.Message.Text, &loc))
+	}
+	return run
+}
+
+// probeRisk returns the risk of the findings of a probe: the severity of its
+// policy, if any.
+func probeRisk(policy *spol.ScorecardPolicy, probe string) string {
+	pp, exists := policy.GetProbes()[probe]
+	if !exists {
+		return "Medium"
+	}
+	return cases.Title(language.English).String(pp.GetSeverity().String())
+}
+
+func createSARIFProbeRule(probe, risk string) rule {
+	desc := fmt.Sprintf("Finding of the %s probe introduced since the base commit", probe)
+	return rule{
+		ID:        probe,
+		Name:      probe,
+		ShortDesc: text{Text: probe},
+		FullDesc:  text{Text: desc},
+		HelpURI:   "https://github.com/ossf/scorecard/blob/main/docs/probes.md#" + strings.ToLower(probe),
+		Help: help{
+			Text: desc,
+		},
+		DefaultConfig: defaultConfig{
+			Level: generateDefaultConfig(risk),
+		},
+		Properties: properties{
+			Tags:            []string{"supply-chain", "security"},
+			Precision:       "high",
+			ProblemSeverity: generateProblemSeverity(risk),
+			SeverityLevel:   calculateSeverityLevel(risk),
+		},
+	}
+}
+
+func (r *Result) writeSARIF(writer io.Writer, sarif *sarif210) error {
+	if len(r.Config.Warnings) > 0 {
+		inv := invocation{ExecutionSuccessful: true}
+		for _, warning := range r.Config.Warnings {
+			inv.ToolConfigurationNotifications = append(inv.ToolConfigurationNotifications, notification{
+				Level:   "warning",
+				Message: text{Text: "maintainer annotations: " + warning},
+			})
+		}
+		for i := range sarif.Runs {
+			sarif.Runs[i].Invocations = []invocation{inv}
+		}
+	}
 
 	encoder := json.NewEncoder(writer)
 	encoder.SetIndent("", "   ")
@@ -16,6 +16,7 @@ package scorecard
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -987,3 +988,105 @@ func Test_createSARIFRuns(t *testing.T) {
 		})
 	}
 }
+
+func TestSARIFOutput_annotationWarnings(t *testing.T) {
+	t.Parallel()
+	r := &Result{
+		Checks: []checker.CheckResult{{Name: "Check-Name", Score: 5}},
+		Config: config.Config{Warnings: []string{"annotation of check-name expired on 2025-01-31"}},
+	}
+	policy := &spol.ScorecardPolicy{
+		Version: 1,
+		Policies: map[string]*spol.CheckPolicy{
+			"Check-Name": {Score: checker.MaxResultScore, Mode: spol.CheckPolicy_ENFORCED},
+		},
+	}
+	var buf bytes.Buffer
+	if err := r.AsSARIF(false, log.DefaultLevel, &buf, sarifMockDocRead(), policy, &options.Options{}); err != nil {
+		t.Fatalf("AsSARIF: %v", err)
+	}
+	var got sarif210
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	want := []invocation{{
+		ExecutionSuccessful: true,
+		ToolConfigurationNotifications: []notification{{
+			Level:   "warning",
+			Message: text{Text: "maintainer annotations: annotation of check-name expired on 2025-01-31"},
+		}},
+	}}
+	if len(got.Runs) != 1 {
+		t.Fatalf("runs = %d, want 1", len(got.Runs))
+	}
+	if diff := cmp.Diff(want, got.Runs[0].Invocations); diff != "" {
+		t.Errorf("invocations (-want,+got): %s", diff)
+	}
+}
+
+func TestSARIFOutput_introducedFindings(t *testing.T) {
+	t.Parallel()
+	line := uint(12)
+	snippet := "uses: actions/checkout@v4"
+	r := &Result{
+		Repo: RepoInfo{
+			Name:          "github.com/foo/bar",
+			CommitSHA:     "68bc59901773ab4c051dfcea0cc4201a1567ab32",
+			BaseCommitSHA: "0b5ba4cd4b7e8b8f23b8b3c2a7e45a0f8cb1c5d6",
+		},
+		// The checks of the repository are not reported.
+		Checks: []checker.CheckResult{{Name: "Check-Name", Score: 0}},
+		Findings: []finding.Finding{
+			{
+				Probe:   "pinsDependencies",
+				Outcome: finding.OutcomeFalse,
+				Message: "GitHub-owned GitHubAction not pinned by hash",
+				Location: &finding.Location{
+					Path:      ".github/workflows/ci.yml",
+					Type:      finding.FileTypeSource,
+					LineStart: &line,
+					Snippet:   &snippet,
+				},
+			},
+			{
+				Probe:   "fuzzed",
+				Outcome: finding.OutcomeFalse,
+				Message: "no fuzzer integrations found",
+			},
+		},
+	}
+	policy := &spol.ScorecardPolicy{
+		Version: 2,
+		Probes: map[string]*spol.ProbePolicy{
+			"pinsDependencies": {ForbiddenOutcomes: []string{"False"}, Severity: spol.ProbePolicy_CRITICAL},
+		},
+	}
+	var buf bytes.Buffer
+	if err := r.AsSARIF(true, log.DefaultLevel, &buf, sarifMockDocRead(), policy, &options.Options{}); err != nil {
+		t.Fatalf("AsSARIF: %v", err)
+	}
+	var got sarif210
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(got.Runs) != 1 {
+		t.Fatalf("runs = %d, want 1", len(got.Runs))
+	}
+	var rules, severities, uris []string
+	for _, rule := range got.Runs[0].Tool.Driver.Rules {
+		rules = append(rules, rule.ID)
+		severities = append(severities, rule.Properties.SeverityLevel)
+	}
+	for _, res := range got.Runs[0].Results {
+		uris = append(uris, res.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	}
+	if diff := cmp.Diff([]string{"pinsDependencies", "fuzzed"}, rules); diff != "" {
+		t.Errorf("rules (-want,+got): %s", diff)
+	}
+	if diff := cmp.Diff([]string{"9.0", "4.0"}, severities); diff != "" {
+		t.Errorf("severities (-want,+got): %s", diff)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml", "no file associated with this alert"}, uris); diff != "" {
+		t.Errorf("locations (-want,+got): %s", diff)
+	}
+}
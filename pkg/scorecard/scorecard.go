@@ -29,6 +29,8 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -43,7 +45,11 @@ import (
 )
 
 // errEmptyRepository indicates the repository is empty.
-var errEmptyRepository = errors.New("repository empty")
+var (
+	errEmptyRepository = errors.New("repository empty")
+	errUnknownProbe    = errors.New("probe is not valid")
+	errBaseLocalDir    = errors.New("local directories can't be compared with a base commit")
+)
 
 func runEnabledChecks(ctx context.Context,
 	repo clients.Repo,
@@ -98,6 +104,7 @@ func runScorecard(ctx context.Context,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
 	projectClient packageclient.ProjectPackageClient,
+	date time.Time,
 ) (Result, error) {
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
@@ -116,7 +123,7 @@ func runScorecard(ctx context.Context,
 			Version:   versionInfo.GitVersion,
 			CommitSHA: versionInfo.GitCommit,
 		},
-		Date: time.Now(),
+		Date: date,
 	}
 
 	commitSHA, err := getRepoCommitHash(repoClient)
@@ -146,6 +153,7 @@ func runScorecard(ctx context.Context,
 
 	// Set metadata for all checks to use. This is necessary
 	// to create remediations from the probe yaml files.
+	ret.RawResults.Metadata.Date = date
 	ret.RawResults.Metadata.Metadata = map[string]string{
 		"repository.host":          repo.Host(),
 		"repository.name":          strings.TrimPrefix(repo.URI(), repo.Host()+"/"),
@@ -186,8 +194,16 @@ func runScorecard(ctx context.Context,
 		defer r.Close()
 		logger.Info(fmt.Sprintf("using maintainer annotations: %s", path))
 		c, err := config.Parse(r)
+		if err == nil {
+			err = validateAnnotatedProbes(&c)
+		}
 		if err != nil {
 			logger.Info(fmt.Sprintf("couldn't parse maintainer annotations: %v", err))
+			c = config.Config{Warnings: []string{fmt.Sprintf("%s ignored: %v", path, err)}}
+		}
+		c.RemoveExpired(date)
+		for _, warning := range c.Warnings {
+			logger.Info("maintainer annotations: " + warning)
 		}
 		ret.Config = c
 	}
@@ -199,6 +215,17 @@ func runScorecard(ctx context.Context,
 	return ret, nil
 }
 
+// validateAnnotatedProbes checks the probes named by the annotations exist.
+// The config package can't, as the probes depend on it.
+func validateAnnotatedProbes(c *config.Config) error {
+	for _, probe := range c.Probes() {
+		if _, err := proberegistration.Get(probe); err != nil {
+			return fmt.Errorf("configuration file is not valid: %w: %s", errUnknownProbe, probe)
+		}
+	}
+	return nil
+}
+
 func findConfigFile(rc clients.RepoClient) (io.ReadCloser, string) {
 	// Look for a config file. Return first one regardless of validity
 	locs := []string{"scorecard.yml", ".scorecard.yml", ".github/scorecard.yml"}
@@ -253,9 +280,11 @@ type runConfig struct {
 	projectClient packageclient.ProjectPackageClient
 	ossfuzzClient clients.RepoClient
 	commit        string
+	base          string
 	logLevel      sclog.Level
 	checks        []string
 	probes        []string
+	date          time.Time
 	commitDepth   int
 	gitMode       bool
 }
@@ -287,6 +316,16 @@ func WithCommitSHA(sha string) Option {
 	}
 }
 
+// WithBaseCommitSHA compares the analyzed commit with the base commit sha, such
+// as the target of a pull request. Only the findings which need a remediation
+// and which are new, or whose outcome regressed, are kept in the result.
+func WithBaseCommitSHA(sha string) Option {
+	return func(c *runConfig) error {
+		c.base = sha
+		return nil
+	}
+}
+
 // WithChecks specifies checks which should be run during the analysis
 // of a project. If this option is not used, all checks are run.
 func WithChecks(checks []string) Option {
@@ -341,6 +380,16 @@ func WithOpenSSFBestPraticesClient(client clients.CIIBestPracticesClient) Option
 	}
 }
 
+// WithDate sets the time the analysis is performed at, which time-based probes
+// such as commit recency are evaluated against. If this option is not used,
+// the current time is used. This is mainly useful to reproduce earlier runs.
+func WithDate(date time.Time) Option {
+	return func(c *runConfig) error {
+		c.date = date
+		return nil
+	}
+}
+
 // WithFileModeGit will configure supporting repository clients to download files
 // using git. This is useful for repositories which "export-ignore" files in its
 // .gitattributes file.
@@ -353,6 +402,15 @@ func WithFileModeGit() Option {
 	}
 }
 
+// localRequestType returns the request type supported by a local directory:
+// checks which need the history are enabled for git repositories.
+func localRequestType(repo *localdir.Repo) checker.RequestType {
+	if repo.HasGitHistory() {
+		return checker.GitHistoryBased
+	}
+	return checker.FileBased
+}
+
 // Run analyzes a given repository and returns the result. You can modify the
 // run behavior by passing in [Option] arguments. In the absence of a particular
 // option a default is used. Refer to the various Options for details.
@@ -366,6 +424,9 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 			return Result{}, err
 		}
 	}
+	if c.date.IsZero() {
+		c.date = time.Now()
+	}
 	logger := sclog.NewLogger(c.logLevel)
 	if c.ciiClient == nil {
 		c.ciiClient = clients.DefaultCIIBestPracticesClient()
@@ -382,9 +443,12 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 
 	var requiredRequestTypes []checker.RequestType
 	var err error
-	switch repo.(type) {
+	switch r := repo.(type) {
 	case *localdir.Repo:
-		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
+		if c.base != "" {
+			return Result{}, errBaseLocalDir
+		}
+		requiredRequestTypes = append(requiredRequestTypes, localRequestType(r))
 		if c.client == nil {
 			c.client = localdir.CreateLocalDirClient(ctx, logger)
 		}
@@ -414,9 +478,24 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 				return Result{}, fmt.Errorf("creating azure devops client: %w", err)
 			}
 		}
+	case *bitbucketrepo.Repo:
+		if c.client == nil {
+			c.client, err = bitbucketrepo.CreateBitbucketClient(ctx, repo)
+			if err != nil {
+				return Result{}, fmt.Errorf("creating bitbucket client: %w", err)
+			}
+		}
+	case *gitearepo.Repo:
+		if c.client == nil {
+			c.client, err = gitearepo.CreateGiteaClient(ctx, repo.Host())
+			if err != nil {
+				return Result{}, fmt.Errorf("creating gitea client: %w", err)
+			}
+		}
 	}
 
-	if !strings.EqualFold(c.commit, clients.HeadSHA) {
+	// Both commits of a comparison are analyzed by the same checks.
+	if !strings.EqualFold(c.commit, clients.HeadSHA) || c.base != "" {
 		requiredRequestTypes = append(requiredRequestTypes, checker.CommitBased)
 	}
 
@@ -425,6 +504,22 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 		return Result{}, fmt.Errorf("getting enabled checks: %w", err)
 	}
 
-	return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
-		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient)
+	if c.base == "" {
+		return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
+			c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, c.date)
+	}
+
+	base, err := runScorecard(ctx, repo, c.base, c.commitDepth, checksToRun, c.probes,
+		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, c.date)
+	if err != nil {
+		return Result{}, fmt.Errorf("analyzing base commit %s: %w", c.base, err)
+	}
+	ret, err := runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
+		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, c.date)
+	if err != nil {
+		return Result{}, err
+	}
+	ret.Repo.BaseCommitSHA = base.Repo.CommitSHA
+	ret.Findings = introducedFindings(base.Findings, ret.Findings)
+	return ret, nil
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -50,6 +51,8 @@ type ScorecardInfo struct {
 type RepoInfo struct {
 	Name      string
 	CommitSHA string
+	// BaseCommitSHA is the commit the findings are compared with, if any.
+	BaseCommitSHA string
 }
 
 // Result struct is returned on a successful Scorecard run.
@@ -184,9 +187,79 @@ func FormatResults(
 		return fmt.Errorf("failed to output results: %w", err)
 	}
 
+	// The policy is evaluated after the results are written, so they can
+	// be inspected when it fails.
+	var violations []spol.Violation
+	if results.Repo.BaseCommitSHA != "" {
+		violations = spol.EvaluateIntroduced(policy, results.Findings)
+	} else {
+		violations = spol.Evaluate(policy, results.Checks, results.policyFindings(policy))
+		writeNotEvaluated(os.Stderr, spol.NotEvaluated(policy, results.Checks))
+	}
+	if len(violations) > 0 {
+		writeViolations(os.Stderr, violations)
+		msg := fmt.Sprintf("%d violations", len(violations))
+		if len(violations) == 1 {
+			msg = "1 violation"
+		}
+		return sce.WithMessage(sce.ErrPolicyViolation, msg)
+	}
+
 	return nil
 }
 
+// policyFindings returns the findings of the run, and those of the probes of
+// policy which no check ran, computed from the raw results of the checks.
+func (r *Result) policyFindings(policy *spol.ScorecardPolicy) []finding.Finding {
+	ran := map[string]bool{}
+	for i := range r.Findings {
+		ran[r.Findings[i].Probe] = true
+	}
+	checksRun := map[string]bool{}
+	for i := range r.Checks {
+		checksRun[r.Checks[i].Name] = true
+	}
+
+	findings := r.Findings
+	for probeName := range policy.GetProbes() {
+		if ran[probeName] {
+			continue
+		}
+		p, err := proberegistration.Get(probeName)
+		if err != nil || p.Implementation == nil {
+			continue
+		}
+		// Without the raw results, the probe would not see what it looks for.
+		missingRawData := false
+		for _, checkName := range p.RequiredRawData {
+			missingRawData = missingRawData || !checksRun[checkName]
+		}
+		if missingRawData {
+			continue
+		}
+		probeFindings, _, err := p.Implementation(&r.RawResults)
+		if err != nil {
+			continue
+		}
+		findings = append(slices.Clip(findings), probeFindings...)
+	}
+	return findings
+}
+
+func writeViolations(w io.Writer, violations []spol.Violation) {
+	fmt.Fprintf(w, "\nPolicy violations: %d\n", len(violations))
+	for i := range violations {
+		fmt.Fprintf(w, "  - %s\n", violations[i].String())
+	}
+}
+
+func writeNotEvaluated(w io.Writer, checks []string) {
+	if len(checks) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nPolicy not evaluated for inconclusive checks: %s\n", strings.Join(checks, ", "))
+}
+
 // AsString returns ScorecardResult in string format.
 func (r *Result) AsString(writer io.Writer, checkDocs docChecks.Doc, opt *AsStringResultOption) error {
 	if opt == nil {
@@ -367,6 +440,12 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
 		ret.RawResults.SBOMResults = rawData
+	case checks.CheckSecrets:
+		rawData, err := raw.Secrets(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.SecretsResults = rawData
 	case checks.CheckSecurityPolicy:
 		rawData, err := raw.SecurityPolicy(request)
 		if err != nil {
@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/config"
 	"github.com/ossf/scorecard/v5/docs/checks"
@@ -155,6 +157,28 @@ func Test_formatResults_outputToFile(t *testing.T) {
 				err:  false,
 			},
 		},
+		{
+			name: "output file with format json and failed policy",
+			args: args{
+				opts: &options.Options{
+					Format:      options.FormatJSON,
+					ShowDetails: true,
+					LogLevel:    log.DebugLevel.String(),
+				},
+				results: scorecardResults,
+				doc:     checkDocs,
+				policy: &spol.ScorecardPolicy{
+					Version: 2,
+					Policies: map[string]*spol.CheckPolicy{
+						"Check-Name": {Score: 6, Mode: spol.CheckPolicy_ENFORCED},
+					},
+				},
+			},
+			want: want{
+				path: "check1.json",
+				err:  true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -205,3 +229,42 @@ func Test_formatResults_outputToFile(t *testing.T) {
 		})
 	}
 }
+
+func TestResult_policyFindings(t *testing.T) {
+	t.Parallel()
+	policy := &spol.ScorecardPolicy{
+		Version: 2,
+		Probes: map[string]*spol.ProbePolicy{
+			"hasBinaryArtifacts":    {ForbiddenOutcomes: []string{"True"}},
+			"hasRecentCommits":      {RequiredOutcome: "True"},
+			"hasOSVVulnerabilities": {ForbiddenOutcomes: []string{"True"}},
+		},
+	}
+	r := &Result{
+		Checks: []checker.CheckResult{{Name: "Binary-Artifacts"}, {Name: "Vulnerabilities"}},
+		Findings: []finding.Finding{
+			{Probe: "hasOSVVulnerabilities", Outcome: finding.OutcomeFalse},
+		},
+		RawResults: checker.RawResults{
+			BinaryArtifactResults: checker.BinaryArtifactData{
+				Files: []checker.File{{Path: "bin/tool", Type: finding.FileTypeBinary}},
+			},
+		},
+	}
+	got := map[string][]finding.Outcome{}
+	for _, f := range r.policyFindings(policy) {
+		got[f.Probe] = append(got[f.Probe], f.Outcome)
+	}
+	// hasBinaryArtifacts runs on the raw results of Binary-Artifacts,
+	// hasRecentCommits can't as Maintained didn't run.
+	want := map[string][]finding.Outcome{
+		"hasBinaryArtifacts":    {finding.OutcomeTrue},
+		"hasOSVVulnerabilities": {finding.OutcomeFalse},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("policyFindings() (-want,+got): %s", diff)
+	}
+	if len(r.Findings) != 1 {
+		t.Errorf("policyFindings() modified the findings of the result")
+	}
+}
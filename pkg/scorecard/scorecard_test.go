@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -196,7 +197,7 @@ func TestRun(t *testing.T) {
 				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			ignoreDate := cmpopts.IgnoreFields(Result{}, "Date")
+			ignoreDate := cmpopts.IgnoreFields(Result{}, "Date", "RawResults.Metadata.Date")
 			if !cmp.Equal(got, tt.want, ignoreDate) {
 				t.Errorf("expected %v, got %v", got, cmp.Diff(tt.want, got, ignoreDate))
 			}
@@ -204,6 +205,29 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestRun_WithDate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+	mockRepoClient.EXPECT().InitRepo(repo, clients.HeadSHA, 0).Return(nil)
+	mockRepoClient.EXPECT().Close().Return(nil)
+	mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
+
+	date := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	got, err := Run(context.Background(), repo,
+		WithRepoClient(mockRepoClient),
+		WithDate(date),
+	)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !got.Date.Equal(date) {
+		t.Errorf("Run() date = %v, want %v", got.Date, date)
+	}
+}
+
 func TestRun_WithProbes(t *testing.T) {
 	t.Parallel()
 	// These values depend on the environment,
@@ -339,7 +363,7 @@ func TestRun_WithProbes(t *testing.T) {
 				return
 			}
 			ignoreRemediationText := cmpopts.IgnoreFields(finding.Remediation{}, "Text", "Markdown")
-			ignoreDate := cmpopts.IgnoreFields(Result{}, "Date")
+			ignoreDate := cmpopts.IgnoreFields(Result{}, "Date", "RawResults.Metadata.Date")
 			ignoreUnexported := cmpopts.IgnoreUnexported(finding.Finding{})
 			if !cmp.Equal(got, tt.want, ignoreDate, ignoreRemediationText, ignoreUnexported) {
 				t.Errorf("expected %v, got %v", got, cmp.Diff(tt.want, got, ignoreDate,
@@ -419,3 +443,92 @@ func Test_findConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func TestRun_WithBaseCommitSHA(t *testing.T) {
+	t.Parallel()
+	const (
+		base = "0b5ba4cd4b7e8b8f23b8b3c2a7e45a0f8cb1c5d6"
+		head = "1a17bb812fb2ac23e9d09e86e122f8b67563aed7"
+	)
+	tests := []struct {
+		name         string
+		fuzzedAtBase bool
+		fuzzedAtHead bool
+		wantProbes   []string
+	}{
+		{
+			name:         "fuzzing removed",
+			fuzzedAtBase: true,
+			fuzzedAtHead: false,
+			wantProbes:   []string{fuzzed.Probe},
+		},
+		{
+			name:         "never fuzzed",
+			fuzzedAtBase: false,
+			fuzzedAtHead: false,
+		},
+		{
+			name:         "fuzzing added",
+			fuzzedAtBase: false,
+			fuzzedAtHead: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			repo := mockrepo.NewMockRepo(ctrl)
+			repo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+			repo.EXPECT().Host().Return("github.com").AnyTimes()
+
+			// Each commit is analyzed in turn, after initializing the client with it.
+			var commit string
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().InitRepo(repo, gomock.Any(), 0).DoAndReturn(
+				func(_ clients.Repo, sha string, _ int) error {
+					commit = sha
+					return nil
+				}).Times(2)
+			mockRepoClient.EXPECT().Close().Return(nil).Times(2)
+			mockRepoClient.EXPECT().ListCommits().DoAndReturn(func() ([]clients.Commit, error) {
+				return []clients.Commit{{SHA: commit}}, nil
+			}).Times(2)
+			mockRepoClient.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+			mockRepoClient.EXPECT().LocalPath().Return("test_path", nil).AnyTimes()
+			mockRepoClient.EXPECT().GetDefaultBranchName().Return("main", nil).AnyTimes()
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+			mockRepoClient.EXPECT().ListProgrammingLanguages().Return(nil, nil).AnyTimes()
+
+			mockOSSFuzzClient := mockrepo.NewMockRepoClient(ctrl)
+			mockOSSFuzzClient.EXPECT().Search(gomock.Any()).DoAndReturn(
+				func(clients.SearchRequest) (clients.SearchResponse, error) {
+					if (commit == base && tt.fuzzedAtBase) || (commit == head && tt.fuzzedAtHead) {
+						return clients.SearchResponse{Hits: 1}, nil
+					}
+					return clients.SearchResponse{}, nil
+				}).AnyTimes()
+
+			got, err := Run(context.Background(), repo,
+				WithRepoClient(mockRepoClient),
+				WithOSSFuzzClient(mockOSSFuzzClient),
+				WithCommitSHA(head),
+				WithBaseCommitSHA(base),
+				WithProbes([]string{fuzzed.Probe}),
+			)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			if got.Repo.CommitSHA != head || got.Repo.BaseCommitSHA != base {
+				t.Errorf("compared %s with %s, want %s with %s", got.Repo.CommitSHA, got.Repo.BaseCommitSHA, head, base)
+			}
+			var gotProbes []string
+			for i := range got.Findings {
+				gotProbes = append(gotProbes, got.Findings[i].Probe)
+			}
+			if diff := cmp.Diff(tt.wantProbes, gotProbes); diff != "" {
+				t.Errorf("introduced findings (-want +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Violation is a requirement of a policy a result doesn't meet.
+type Violation struct {
+	// Check is the name of the check, for check policies.
+	Check string
+	// Probe is the name of the probe, for probe policies.
+	Probe string
+	// Path is the location of the finding, if any.
+	Path   string
+	Reason string
+	// Severity is only set for probe policies.
+	Severity string
+}
+
+// String returns a one line description of v.
+func (v *Violation) String() string {
+	var b strings.Builder
+	if v.Severity != "" {
+		fmt.Fprintf(&b, "[%s] ", v.Severity)
+	}
+	if v.Probe != "" {
+		b.WriteString(v.Probe)
+	} else {
+		b.WriteString(v.Check)
+	}
+	if v.Path != "" {
+		fmt.Fprintf(&b, " (%s)", v.Path)
+	}
+	fmt.Fprintf(&b, ": %s", v.Reason)
+	return b.String()
+}
+
+// Gates reports whether results failing sp should fail the run.
+// Version 1 policies only annotate SARIF results.
+func Gates(sp *ScorecardPolicy) bool {
+	return sp.GetVersion() >= 2
+}
+
+// Evaluate returns the violations of sp by the check results and probe findings
+// of a run, most severe first. Policies which don't gate have no violations.
+func Evaluate(sp *ScorecardPolicy, checkResults []checker.CheckResult, findings []finding.Finding) []Violation {
+	if !Gates(sp) {
+		return nil
+	}
+	var violations []Violation
+	for i := range checkResults {
+		violations = append(violations, evaluateCheck(sp, &checkResults[i])...)
+	}
+
+	for _, name := range probeNames(sp) {
+		violations = append(violations, evaluateProbe(name, sp.GetProbes()[name], findings)...)
+	}
+	sortBySeverity(violations)
+	return violations
+}
+
+// EvaluateIntroduced returns the violations of sp by the findings a change
+// introduced, most severe first. Only forbidden outcomes are violations, as
+// check scores and required outcomes are about the whole repository.
+func EvaluateIntroduced(sp *ScorecardPolicy, findings []finding.Finding) []Violation {
+	if !Gates(sp) {
+		return nil
+	}
+	var violations []Violation
+	for _, name := range probeNames(sp) {
+		forbidden, _, _ := evaluateOutcomes(name, sp.GetProbes()[name], findings)
+		violations = append(violations, forbidden...)
+	}
+	sortBySeverity(violations)
+	return violations
+}
+
+func probeNames(sp *ScorecardPolicy) []string {
+	names := make([]string, 0, len(sp.GetProbes()))
+	for name := range sp.GetProbes() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortBySeverity(violations []Violation) {
+	slices.SortStableFunc(violations, func(a, b Violation) int {
+		return severityRank(b.Severity) - severityRank(a.Severity)
+	})
+}
+
+func evaluateCheck(sp *ScorecardPolicy, result *checker.CheckResult) []Violation {
+	cp, exists := sp.GetPolicies()[result.Name]
+	if !exists || cp.GetMode() != CheckPolicy_ENFORCED {
+		return nil
+	}
+	// Inconclusive checks aren't evaluated, see NotEvaluated.
+	if result.Score == checker.InconclusiveResultScore || result.Score >= int(cp.GetScore()) {
+		return nil
+	}
+	return []Violation{{
+		Check:  result.Name,
+		Reason: fmt.Sprintf("score %d is below the minimum of %d", result.Score, cp.GetScore()),
+	}}
+}
+
+// NotEvaluated returns the names of the checks enforced by sp which Evaluate
+// couldn't evaluate, because their score is inconclusive.
+func NotEvaluated(sp *ScorecardPolicy, checkResults []checker.CheckResult) []string {
+	if !Gates(sp) {
+		return nil
+	}
+	var names []string
+	for i := range checkResults {
+		cp, exists := sp.GetPolicies()[checkResults[i].Name]
+		if exists && cp.GetMode() == CheckPolicy_ENFORCED &&
+			checkResults[i].Score == checker.InconclusiveResultScore {
+			names = append(names, checkResults[i].Name)
+		}
+	}
+	return names
+}
+
+func evaluateProbe(name string, pp *ProbePolicy, findings []finding.Finding) []Violation {
+	severity := strings.ToLower(pp.GetSeverity().String())
+	violations, ran, satisfied := evaluateOutcomes(name, pp, findings)
+
+	// A probe which didn't run can't show a policy is met.
+	if !ran {
+		return []Violation{{Probe: name, Reason: "probe did not run", Severity: severity}}
+	}
+	if pp.GetRequiredOutcome() != "" && !satisfied {
+		violations = append(violations, Violation{
+			Probe:    name,
+			Reason:   fmt.Sprintf("no finding has the required outcome %s", pp.GetRequiredOutcome()),
+			Severity: severity,
+		})
+	}
+	return violations
+}
+
+// evaluateOutcomes returns the violations of the forbidden outcomes of the
+// probe, whether it ran, and whether a finding has the required outcome.
+func evaluateOutcomes(name string, pp *ProbePolicy, findings []finding.Finding) (violations []Violation,
+	ran, satisfied bool,
+) {
+	severity := strings.ToLower(pp.GetSeverity().String())
+	for i := range findings {
+		f := &findings[i]
+		if f.Probe != name {
+			continue
+		}
+		ran = true
+		var findingPath string
+		if f.Location != nil {
+			findingPath = f.Location.Path
+			if isExempt(pp.GetExemptions(), findingPath) {
+				continue
+			}
+		}
+		if string(f.Outcome) == pp.GetRequiredOutcome() {
+			satisfied = true
+		}
+		if slices.Contains(pp.GetForbiddenOutcomes(), string(f.Outcome)) {
+			reason := fmt.Sprintf("outcome %s is forbidden", f.Outcome)
+			if f.Message != "" {
+				reason += ": " + f.Message
+			}
+			violations = append(violations, Violation{
+				Probe:    name,
+				Path:     findingPath,
+				Reason:   reason,
+				Severity: severity,
+			})
+		}
+	}
+	return violations, ran, satisfied
+}
+
+// isExempt reports whether p, or one of its parent directories, matches an exemption.
+func isExempt(exemptions []*ProbePolicy_Exemption, p string) bool {
+	p = path.Clean(p)
+	for _, e := range exemptions {
+		pattern := path.Clean(e.GetPath())
+		for dir := p; dir != "." && dir != "/"; dir = path.Dir(dir) {
+			if matched, err := path.Match(pattern, dir); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// severityRank orders violations of check policies, which have no severity,
+// before those of probe policies.
+func severityRank(severity string) int {
+	if severity == "" {
+		return len(ProbePolicy_Severity_value)
+	}
+	return int(ProbePolicy_Severity_value[strings.ToUpper(severity)])
+}
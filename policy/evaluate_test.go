@@ -0,0 +1,247 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	at := func(probe, p string, outcome finding.Outcome) finding.Finding {
+		f := finding.Finding{Probe: probe, Outcome: outcome}
+		if p != "" {
+			f.Location = &finding.Location{Path: p}
+		}
+		return f
+	}
+	tests := []struct {
+		name     string
+		policy   *ScorecardPolicy
+		checks   []checker.CheckResult
+		findings []finding.Finding
+		want     []Violation
+	}{
+		{
+			name: "version 1 does not gate",
+			policy: &ScorecardPolicy{
+				Version: 1,
+				Policies: map[string]*CheckPolicy{
+					"Maintained": {Score: 10, Mode: CheckPolicy_ENFORCED},
+				},
+			},
+			checks: []checker.CheckResult{{Name: "Maintained", Score: 0}},
+		},
+		{
+			name: "check scores",
+			policy: &ScorecardPolicy{
+				Version: 2,
+				Policies: map[string]*CheckPolicy{
+					"Maintained":      {Score: 5, Mode: CheckPolicy_ENFORCED},
+					"Fuzzing":         {Score: 5, Mode: CheckPolicy_DISABLED},
+					"License":         {Score: 5, Mode: CheckPolicy_ENFORCED},
+					"Security-Policy": {Score: 5, Mode: CheckPolicy_ENFORCED},
+				},
+			},
+			checks: []checker.CheckResult{
+				{Name: "Fuzzing", Score: 0},
+				{Name: "License", Score: checker.InconclusiveResultScore},
+				{Name: "Maintained", Score: 4},
+				{Name: "Security-Policy", Score: 5},
+			},
+			want: []Violation{
+				{Check: "Maintained", Reason: "score 4 is below the minimum of 5"},
+			},
+		},
+		{
+			name: "forbidden outcomes",
+			policy: &ScorecardPolicy{
+				Version: 2,
+				Probes: map[string]*ProbePolicy{
+					"hasDangerousWorkflowUntrustedCheckout": {
+						ForbiddenOutcomes: []string{"True"},
+						Severity:          ProbePolicy_CRITICAL,
+						Exemptions: []*ProbePolicy_Exemption{
+							{Path: ".github/workflows/labeler.yml"},
+							{Path: "vendor/"},
+						},
+					},
+				},
+			},
+			findings: []finding.Finding{
+				at("hasDangerousWorkflowUntrustedCheckout", ".github/workflows/ci.yml", finding.OutcomeTrue),
+				at("hasDangerousWorkflowUntrustedCheckout", ".github/workflows/labeler.yml", finding.OutcomeTrue),
+				at("hasDangerousWorkflowUntrustedCheckout", "vendor/a/.github/workflows/ci.yml", finding.OutcomeTrue),
+				at("hasDangerousWorkflowUntrustedCheckout", ".github/workflows/release.yml", finding.OutcomeFalse),
+			},
+			want: []Violation{
+				{
+					Probe:    "hasDangerousWorkflowUntrustedCheckout",
+					Path:     ".github/workflows/ci.yml",
+					Reason:   "outcome True is forbidden",
+					Severity: "critical",
+				},
+			},
+		},
+		{
+			name: "required outcome",
+			policy: &ScorecardPolicy{
+				Version: 2,
+				Probes: map[string]*ProbePolicy{
+					"releasesAreSigned":      {RequiredOutcome: "True", Severity: ProbePolicy_MEDIUM},
+					"releasesHaveProvenance": {RequiredOutcome: "True", Severity: ProbePolicy_LOW},
+				},
+			},
+			findings: []finding.Finding{
+				at("releasesAreSigned", "", finding.OutcomeFalse),
+				at("releasesAreSigned", "", finding.OutcomeTrue),
+				at("releasesHaveProvenance", "", finding.OutcomeFalse),
+			},
+			want: []Violation{
+				{
+					Probe:    "releasesHaveProvenance",
+					Reason:   "no finding has the required outcome True",
+					Severity: "low",
+				},
+			},
+		},
+		{
+			name: "probe did not run",
+			policy: &ScorecardPolicy{
+				Version: 2,
+				Probes: map[string]*ProbePolicy{
+					"releasesAreSigned": {RequiredOutcome: "True", Severity: ProbePolicy_MEDIUM},
+					"hasDangerousWorkflowUntrustedCheckout": {
+						ForbiddenOutcomes: []string{"True"},
+						Severity:          ProbePolicy_HIGH,
+					},
+				},
+			},
+			want: []Violation{
+				{Probe: "hasDangerousWorkflowUntrustedCheckout", Reason: "probe did not run", Severity: "high"},
+				{Probe: "releasesAreSigned", Reason: "probe did not run", Severity: "medium"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Evaluate(tt.policy, tt.checks, tt.findings)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Evaluate() (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEvaluateIntroduced(t *testing.T) {
+	t.Parallel()
+	sp := &ScorecardPolicy{
+		Version: 2,
+		Policies: map[string]*CheckPolicy{
+			"Maintained": {Score: 10, Mode: CheckPolicy_ENFORCED},
+		},
+		Probes: map[string]*ProbePolicy{
+			"releasesAreSigned": {RequiredOutcome: "True", Severity: ProbePolicy_MEDIUM},
+			"pinsDependencies": {
+				ForbiddenOutcomes: []string{"False"},
+				Severity:          ProbePolicy_HIGH,
+			},
+		},
+	}
+	findings := []finding.Finding{
+		{
+			Probe:    "pinsDependencies",
+			Outcome:  finding.OutcomeFalse,
+			Message:  "unpinned",
+			Location: &finding.Location{Path: ".github/workflows/ci.yml"},
+		},
+	}
+	// Neither the check score nor the required outcome of the probe,
+	// which has no introduced finding, are evaluated.
+	want := []Violation{
+		{
+			Probe:    "pinsDependencies",
+			Path:     ".github/workflows/ci.yml",
+			Reason:   "outcome False is forbidden: unpinned",
+			Severity: "high",
+		},
+	}
+	if diff := cmp.Diff(want, EvaluateIntroduced(sp, findings)); diff != "" {
+		t.Errorf("EvaluateIntroduced() (-want,+got): %s", diff)
+	}
+}
+
+func TestNotEvaluated(t *testing.T) {
+	t.Parallel()
+	sp := &ScorecardPolicy{
+		Version: 2,
+		Policies: map[string]*CheckPolicy{
+			"License":    {Score: 5, Mode: CheckPolicy_ENFORCED},
+			"Fuzzing":    {Score: 5, Mode: CheckPolicy_DISABLED},
+			"Maintained": {Score: 5, Mode: CheckPolicy_ENFORCED},
+		},
+	}
+	checks := []checker.CheckResult{
+		{Name: "Fuzzing", Score: checker.InconclusiveResultScore},
+		{Name: "License", Score: checker.InconclusiveResultScore},
+		{Name: "Maintained", Score: 4},
+	}
+	if diff := cmp.Diff([]string{"License"}, NotEvaluated(sp, checks)); diff != "" {
+		t.Errorf("NotEvaluated() (-want,+got): %s", diff)
+	}
+	sp.Version = 1
+	if got := NotEvaluated(sp, checks); got != nil {
+		t.Errorf("NotEvaluated() = %v for a version 1 policy, want nil", got)
+	}
+}
+
+func TestViolation_String(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		want      string
+		violation Violation
+	}{
+		{
+			name:      "check",
+			violation: Violation{Check: "Maintained", Reason: "score 4 is below the minimum of 5"},
+			want:      "Maintained: score 4 is below the minimum of 5",
+		},
+		{
+			name: "probe",
+			violation: Violation{
+				Probe:    "hasDangerousWorkflowUntrustedCheckout",
+				Path:     ".github/workflows/ci.yml",
+				Reason:   "outcome True is forbidden",
+				Severity: "critical",
+			},
+			want: "[critical] hasDangerousWorkflowUntrustedCheckout (.github/workflows/ci.yml): outcome True is forbidden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.violation.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -26,6 +27,8 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks"
 	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 )
 
 var (
@@ -34,19 +37,44 @@ var (
 	errInvalidScore   = errors.New("invalid score")
 	errInvalidMode    = errors.New("invalid mode")
 	errRepeatingCheck = errors.New("check has multiple definitions")
+	errInvalidProbe   = errors.New("invalid probe name")
+	errProbeVersion   = errors.New("probe policies require version 2")
+	errEmptyRule      = errors.New("probe policy has no forbidden or required outcome")
+	errInvalidSev     = errors.New("invalid severity")
+	errInvalidExempt  = errors.New("invalid exemption path")
 )
 
-var allowedVersions = map[int]bool{1: true}
+var allowedVersions = map[int]bool{1: true, 2: true}
 
 var modes = map[string]bool{"enforced": true, "disabled": true}
 
+var severities = map[string]ProbePolicy_Severity{
+	"low":      ProbePolicy_LOW,
+	"medium":   ProbePolicy_MEDIUM,
+	"high":     ProbePolicy_HIGH,
+	"critical": ProbePolicy_CRITICAL,
+}
+
 type checkPolicy struct {
 	Mode  string `yaml:"mode"`
 	Score int    `yaml:"score"`
 }
 
+type exemption struct {
+	Path   string `yaml:"path"`
+	Reason string `yaml:"reason"`
+}
+
+type probePolicy struct {
+	Require    finding.Outcome   `yaml:"require"`
+	Severity   string            `yaml:"severity"`
+	Forbid     []finding.Outcome `yaml:"forbid"`
+	Exemptions []exemption       `yaml:"exemptions"`
+}
+
 type scorecardPolicy struct {
 	Policies map[string]checkPolicy `yaml:"policies"`
+	Probes   map[string]probePolicy `yaml:"probes"`
 	Version  int                    `yaml:"version"`
 }
 
@@ -135,9 +163,58 @@ func parseFromYAML(b []byte) (*ScorecardPolicy, error) {
 		}
 	}
 
+	if len(sp.Probes) > 0 && sp.Version < 2 {
+		return &retPolicy, sce.WithMessage(sce.ErrScorecardInternal, errProbeVersion.Error())
+	}
+	for n, p := range sp.Probes {
+		pp, err := probePolicyToProto(n, &p)
+		if err != nil {
+			return &retPolicy, sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		if retPolicy.Probes == nil {
+			retPolicy.Probes = map[string]*ProbePolicy{}
+		}
+		retPolicy.Probes[n] = pp
+	}
+
 	return &retPolicy, nil
 }
 
+func probePolicyToProto(name string, p *probePolicy) (*ProbePolicy, error) {
+	if _, err := proberegistration.Get(name); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidProbe, name)
+	}
+	if len(p.Forbid) == 0 && p.Require == "" {
+		return nil, fmt.Errorf("%w: %v", errEmptyRule, name)
+	}
+	// Rules are meant to gate, so they are severe unless stated otherwise.
+	severity := ProbePolicy_HIGH
+	if p.Severity != "" {
+		s, exists := severities[p.Severity]
+		if !exists {
+			return nil, fmt.Errorf("%w: %v", errInvalidSev, p.Severity)
+		}
+		severity = s
+	}
+	pp := &ProbePolicy{
+		RequiredOutcome: string(p.Require),
+		Severity:        severity,
+	}
+	for _, o := range p.Forbid {
+		pp.ForbiddenOutcomes = append(pp.ForbiddenOutcomes, string(o))
+	}
+	for _, e := range p.Exemptions {
+		if _, err := path.Match(e.Path, ""); e.Path == "" || err != nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidExempt, e.Path)
+		}
+		pp.Exemptions = append(pp.Exemptions, &ProbePolicy_Exemption{
+			Path:   e.Path,
+			Reason: e.Reason,
+		})
+	}
+	return pp, nil
+}
+
 // GetEnabled returns the list of enabled checks.
 func GetEnabled(
 	sp *ScorecardPolicy,
@@ -162,7 +239,17 @@ func GetEnabled(
 			}
 		}
 	case sp != nil:
-		// Populate checks to run with policy file.
+		// Populate checks to run with policy file, including
+		// the checks running the probes of probe policies.
+		for checkName := range checksForProbes(sp) {
+			if !isSupportedCheck(checkName, requiredRequestTypes) {
+				continue
+			}
+			if !enableCheck(checkName, &enabledChecks) {
+				return enabledChecks,
+					sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("invalid check: %s", checkName))
+			}
+		}
 		for checkName := range sp.GetPolicies() {
 			if !isSupportedCheck(checkName, requiredRequestTypes) {
 				// We silently ignore the check, like we do
@@ -198,10 +285,32 @@ func GetEnabled(
 	return enabledChecks, nil
 }
 
+// checksForProbes returns the checks whose raw results the probes of sp need.
+func checksForProbes(sp *ScorecardPolicy) map[string]bool {
+	checkNames := map[string]bool{}
+	for probeName := range sp.GetProbes() {
+		p, err := proberegistration.Get(probeName)
+		if err != nil {
+			continue
+		}
+		for _, checkName := range p.RequiredRawData {
+			checkNames[checkName] = true
+		}
+	}
+	return checkNames
+}
+
+// IsProbeOnlyCheck reports whether the check has no policy of its own,
+// and only runs for the probe policies of sp.
+func IsProbeOnlyCheck(sp *ScorecardPolicy, checkName string) bool {
+	_, exists := sp.GetPolicies()[checkName]
+	return !exists && checksForProbes(sp)[checkName]
+}
+
 func checksHavePolicies(sp *ScorecardPolicy, enabledChecks checker.CheckNameToFnMap) bool {
 	for checkName := range enabledChecks {
 		_, exists := sp.GetPolicies()[checkName]
-		if !exists {
+		if !exists && !IsProbeOnlyCheck(sp, checkName) {
 			log.Printf("check %s has no policy declared", checkName)
 			return false
 		}
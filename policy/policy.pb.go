@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.36.6
 // 	protoc        v3.12.4
 // source: policy.proto
 
@@ -23,6 +23,7 @@ package policy
 import (
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -82,22 +83,72 @@ func (CheckPolicy_Mode) EnumDescriptor() ([]byte, []int) {
 	return file_policy_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Severity definition.
+type ProbePolicy_Severity int32
+
+const (
+	ProbePolicy_LOW      ProbePolicy_Severity = 0
+	ProbePolicy_MEDIUM   ProbePolicy_Severity = 1
+	ProbePolicy_HIGH     ProbePolicy_Severity = 2
+	ProbePolicy_CRITICAL ProbePolicy_Severity = 3
+)
+
+// Enum value maps for ProbePolicy_Severity.
+var (
+	ProbePolicy_Severity_name = map[int32]string{
+		0: "LOW",
+		1: "MEDIUM",
+		2: "HIGH",
+		3: "CRITICAL",
+	}
+	ProbePolicy_Severity_value = map[string]int32{
+		"LOW":      0,
+		"MEDIUM":   1,
+		"HIGH":     2,
+		"CRITICAL": 3,
+	}
+)
+
+func (x ProbePolicy_Severity) Enum() *ProbePolicy_Severity {
+	p := new(ProbePolicy_Severity)
+	*p = x
+	return p
+}
+
+func (x ProbePolicy_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbePolicy_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_policy_proto_enumTypes[1].Descriptor()
+}
+
+func (ProbePolicy_Severity) Type() protoreflect.EnumType {
+	return &file_policy_proto_enumTypes[1]
+}
+
+func (x ProbePolicy_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProbePolicy_Severity.Descriptor instead.
+func (ProbePolicy_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_policy_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type CheckPolicy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          CheckPolicy_Mode       `protobuf:"varint,1,opt,name=mode,proto3,enum=ossf.scorecard.policy.CheckPolicy_Mode" json:"mode,omitempty"`
+	Score         int32                  `protobuf:"zigzag32,2,opt,name=score,proto3" json:"score,omitempty"` // TODO: add Risk.
 	unknownFields protoimpl.UnknownFields
-
-	Mode  CheckPolicy_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=ossf.scorecard.policy.CheckPolicy_Mode" json:"mode,omitempty"`
-	Score int32            `protobuf:"zigzag32,2,opt,name=score,proto3" json:"score,omitempty"` // TODO: add Risk.
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckPolicy) Reset() {
 	*x = CheckPolicy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_policy_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CheckPolicy) String() string {
@@ -108,7 +159,7 @@ func (*CheckPolicy) ProtoMessage() {}
 
 func (x *CheckPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_policy_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -137,22 +188,90 @@ func (x *CheckPolicy) GetScore() int32 {
 	return 0
 }
 
+type ProbePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Outcomes none of the probe's findings may have.
+	ForbiddenOutcomes []string `protobuf:"bytes,1,rep,name=forbidden_outcomes,json=forbiddenOutcomes,proto3" json:"forbidden_outcomes,omitempty"`
+	// Outcome at least one of the probe's findings must have.
+	RequiredOutcome string                   `protobuf:"bytes,2,opt,name=required_outcome,json=requiredOutcome,proto3" json:"required_outcome,omitempty"`
+	Severity        ProbePolicy_Severity     `protobuf:"varint,3,opt,name=severity,proto3,enum=ossf.scorecard.policy.ProbePolicy_Severity" json:"severity,omitempty"`
+	Exemptions      []*ProbePolicy_Exemption `protobuf:"bytes,4,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProbePolicy) Reset() {
+	*x = ProbePolicy{}
+	mi := &file_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbePolicy) ProtoMessage() {}
+
+func (x *ProbePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbePolicy.ProtoReflect.Descriptor instead.
+func (*ProbePolicy) Descriptor() ([]byte, []int) {
+	return file_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProbePolicy) GetForbiddenOutcomes() []string {
+	if x != nil {
+		return x.ForbiddenOutcomes
+	}
+	return nil
+}
+
+func (x *ProbePolicy) GetRequiredOutcome() string {
+	if x != nil {
+		return x.RequiredOutcome
+	}
+	return ""
+}
+
+func (x *ProbePolicy) GetSeverity() ProbePolicy_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return ProbePolicy_LOW
+}
+
+func (x *ProbePolicy) GetExemptions() []*ProbePolicy_Exemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
 type ScorecardPolicy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Version       int32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Policies      map[string]*CheckPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Probes        map[string]*ProbePolicy `protobuf:"bytes,3,rep,name=probes,proto3" json:"probes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
-
-	Version  int32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Policies map[string]*CheckPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScorecardPolicy) Reset() {
 	*x = ScorecardPolicy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_policy_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScorecardPolicy) String() string {
@@ -162,8 +281,8 @@ func (x *ScorecardPolicy) String() string {
 func (*ScorecardPolicy) ProtoMessage() {}
 
 func (x *ScorecardPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_policy_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_policy_proto_msgTypes[2]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -175,7 +294,7 @@ func (x *ScorecardPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScorecardPolicy.ProtoReflect.Descriptor instead.
 func (*ScorecardPolicy) Descriptor() ([]byte, []int) {
-	return file_policy_proto_rawDescGZIP(), []int{1}
+	return file_policy_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ScorecardPolicy) GetVersion() int32 {
@@ -192,68 +311,142 @@ func (x *ScorecardPolicy) GetPolicies() map[string]*CheckPolicy {
 	return nil
 }
 
-var File_policy_proto protoreflect.FileDescriptor
+func (x *ScorecardPolicy) GetProbes() map[string]*ProbePolicy {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+type ProbePolicy_Exemption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the files, or directories, whose findings are ignored.
+	// Shell patterns are supported.
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_policy_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15,
-	0x6f, 0x73, 0x73, 0x66, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x73, 0x73, 0x66, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x11, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x22, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x4e, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x44, 0x10, 0x01, 0x22, 0xde, 0x01, 0x0a,
-	0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x08, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f,
-	0x73, 0x73, 0x66, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x0d,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x6f, 0x73, 0x73, 0x66, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x22, 0x5a,
-	0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x73, 0x73, 0x66,
-	0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ProbePolicy_Exemption) Reset() {
+	*x = ProbePolicy_Exemption{}
+	mi := &file_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
+func (x *ProbePolicy_Exemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbePolicy_Exemption) ProtoMessage() {}
+
+func (x *ProbePolicy_Exemption) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbePolicy_Exemption.ProtoReflect.Descriptor instead.
+func (*ProbePolicy_Exemption) Descriptor() ([]byte, []int) {
+	return file_policy_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ProbePolicy_Exemption) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProbePolicy_Exemption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_policy_proto protoreflect.FileDescriptor
+
+const file_policy_proto_rawDesc = "" +
+	"\n" +
+	"\fpolicy.proto\x12\x15ossf.scorecard.policy\"\x84\x01\n" +
+	"\vCheckPolicy\x12;\n" +
+	"\x04mode\x18\x01 \x01(\x0e2'.ossf.scorecard.policy.CheckPolicy.ModeR\x04mode\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x11R\x05score\"\"\n" +
+	"\x04Mode\x12\f\n" +
+	"\bDISABLED\x10\x00\x12\f\n" +
+	"\bENFORCED\x10\x01\"\xf0\x02\n" +
+	"\vProbePolicy\x12-\n" +
+	"\x12forbidden_outcomes\x18\x01 \x03(\tR\x11forbiddenOutcomes\x12)\n" +
+	"\x10required_outcome\x18\x02 \x01(\tR\x0frequiredOutcome\x12G\n" +
+	"\bseverity\x18\x03 \x01(\x0e2+.ossf.scorecard.policy.ProbePolicy.SeverityR\bseverity\x12L\n" +
+	"\n" +
+	"exemptions\x18\x04 \x03(\v2,.ossf.scorecard.policy.ProbePolicy.ExemptionR\n" +
+	"exemptions\x1a7\n" +
+	"\tExemption\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"7\n" +
+	"\bSeverity\x12\a\n" +
+	"\x03LOW\x10\x00\x12\n" +
+	"\n" +
+	"\x06MEDIUM\x10\x01\x12\b\n" +
+	"\x04HIGH\x10\x02\x12\f\n" +
+	"\bCRITICAL\x10\x03\"\x89\x03\n" +
+	"\x0fScorecardPolicy\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12P\n" +
+	"\bpolicies\x18\x02 \x03(\v24.ossf.scorecard.policy.ScorecardPolicy.PoliciesEntryR\bpolicies\x12J\n" +
+	"\x06probes\x18\x03 \x03(\v22.ossf.scorecard.policy.ScorecardPolicy.ProbesEntryR\x06probes\x1a_\n" +
+	"\rPoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".ossf.scorecard.policy.CheckPolicyR\x05value:\x028\x01\x1a]\n" +
+	"\vProbesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".ossf.scorecard.policy.ProbePolicyR\x05value:\x028\x01B\"Z github.com/ossf/scorecard/policyb\x06proto3"
+
 var (
 	file_policy_proto_rawDescOnce sync.Once
-	file_policy_proto_rawDescData = file_policy_proto_rawDesc
+	file_policy_proto_rawDescData []byte
 )
 
 func file_policy_proto_rawDescGZIP() []byte {
 	file_policy_proto_rawDescOnce.Do(func() {
-		file_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_policy_proto_rawDescData)
+		file_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_policy_proto_rawDesc), len(file_policy_proto_rawDesc)))
 	})
 	return file_policy_proto_rawDescData
 }
 
-var file_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_policy_proto_goTypes = []interface{}{
-	(CheckPolicy_Mode)(0),   // 0: ossf.scorecard.policy.CheckPolicy.Mode
-	(*CheckPolicy)(nil),     // 1: ossf.scorecard.policy.CheckPolicy
-	(*ScorecardPolicy)(nil), // 2: ossf.scorecard.policy.ScorecardPolicy
-	nil,                     // 3: ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry
+var file_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_policy_proto_goTypes = []any{
+	(CheckPolicy_Mode)(0),         // 0: ossf.scorecard.policy.CheckPolicy.Mode
+	(ProbePolicy_Severity)(0),     // 1: ossf.scorecard.policy.ProbePolicy.Severity
+	(*CheckPolicy)(nil),           // 2: ossf.scorecard.policy.CheckPolicy
+	(*ProbePolicy)(nil),           // 3: ossf.scorecard.policy.ProbePolicy
+	(*ScorecardPolicy)(nil),       // 4: ossf.scorecard.policy.ScorecardPolicy
+	(*ProbePolicy_Exemption)(nil), // 5: ossf.scorecard.policy.ProbePolicy.Exemption
+	nil,                           // 6: ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry
+	nil,                           // 7: ossf.scorecard.policy.ScorecardPolicy.ProbesEntry
 }
 var file_policy_proto_depIdxs = []int32{
 	0, // 0: ossf.scorecard.policy.CheckPolicy.mode:type_name -> ossf.scorecard.policy.CheckPolicy.Mode
-	3, // 1: ossf.scorecard.policy.ScorecardPolicy.policies:type_name -> ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry
-	1, // 2: ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry.value:type_name -> ossf.scorecard.policy.CheckPolicy
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1, // 1: ossf.scorecard.policy.ProbePolicy.severity:type_name -> ossf.scorecard.policy.ProbePolicy.Severity
+	5, // 2: ossf.scorecard.policy.ProbePolicy.exemptions:type_name -> ossf.scorecard.policy.ProbePolicy.Exemption
+	6, // 3: ossf.scorecard.policy.ScorecardPolicy.policies:type_name -> ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry
+	7, // 4: ossf.scorecard.policy.ScorecardPolicy.probes:type_name -> ossf.scorecard.policy.ScorecardPolicy.ProbesEntry
+	2, // 5: ossf.scorecard.policy.ScorecardPolicy.PoliciesEntry.value:type_name -> ossf.scorecard.policy.CheckPolicy
+	3, // 6: ossf.scorecard.policy.ScorecardPolicy.ProbesEntry.value:type_name -> ossf.scorecard.policy.ProbePolicy
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_policy_proto_init() }
@@ -261,39 +454,13 @@ func file_policy_proto_init() {
 	if File_policy_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckPolicy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScorecardPolicy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_policy_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_policy_proto_rawDesc), len(file_policy_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -303,7 +470,6 @@ func file_policy_proto_init() {
 		MessageInfos:      file_policy_proto_msgTypes,
 	}.Build()
 	File_policy_proto = out.File
-	file_policy_proto_rawDesc = nil
 	file_policy_proto_goTypes = nil
 	file_policy_proto_depIdxs = nil
 }
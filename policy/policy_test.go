@@ -80,6 +80,61 @@ func TestPolicyRead(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "probes",
+			filename: "./testdata/policy-probes-ok.yaml",
+			err:      nil,
+			result: ScorecardPolicy{
+				Version: 2,
+				Policies: map[string]*CheckPolicy{
+					"Branch-Protection": {
+						Score: 5,
+						Mode:  CheckPolicy_ENFORCED,
+					},
+				},
+				Probes: map[string]*ProbePolicy{
+					"hasDangerousWorkflowUntrustedCheckout": {
+						ForbiddenOutcomes: []string{"True"},
+						Severity:          ProbePolicy_CRITICAL,
+						Exemptions: []*ProbePolicy_Exemption{
+							{
+								Path:   ".github/workflows/labeler.yml",
+								Reason: "only checks out the labels configuration",
+							},
+						},
+					},
+					"releasesAreSigned": {
+						RequiredOutcome: "True",
+						Severity:        ProbePolicy_HIGH,
+					},
+				},
+			},
+		},
+		{
+			name:     "probes require version 2",
+			filename: "./testdata/policy-probes-v1.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
+		{
+			name:     "invalid probe name",
+			filename: "./testdata/policy-probes-invalid-probe.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
+		{
+			name:     "invalid probe severity",
+			filename: "./testdata/policy-probes-invalid-severity.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
+		{
+			name:     "invalid probe outcome",
+			filename: "./testdata/policy-probes-invalid-outcome.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
+		{
+			name:     "probe without rule",
+			filename: "./testdata/policy-probes-no-rule.yaml",
+			err:      sce.ErrScorecardInternal,
+		},
 		{
 			name:     "invalid score - 0",
 			filename: "./testdata/policy-invalid-score-0.yaml",
@@ -344,6 +399,14 @@ func TestGetEnabled(t *testing.T) {
 			expectedEnabledChecks: 3,
 			expectedError:         false,
 		},
+		{
+			name:                  "checks running probes in policy file enabled",
+			policyFile:            "testdata/policy-probes-ok.yaml",
+			argsChecks:            []string{},
+			requiredRequestTypes:  []checker.RequestType{},
+			expectedEnabledChecks: 3, // Branch-Protection, Dangerous-Workflow and Signed-Releases
+			expectedError:         false,
+		},
 	}
 
 	for _, tt := range tests {
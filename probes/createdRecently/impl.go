@@ -19,7 +19,6 @@ import (
 	"embed"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
@@ -49,7 +48,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 
 	r := raw.MaintainedResults
 
-	recencyThreshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+	recencyThreshold := raw.Metadata.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
 
 	var text string
 	var outcome finding.Outcome
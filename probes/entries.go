@@ -29,34 +29,55 @@ import (
 	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/dismissesStaleReviews"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
+	"github.com/ossf/scorecard/v5/probes/fuzzedInCI"
 	"github.com/ossf/scorecard/v5/probes/hasBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedCloudAccessKeys"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedHighEntropyTokens"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedPrivateKeys"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowCachePoisoning"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
+	"github.com/ossf/scorecard/v5/probes/hasDependencyVulnerabilityGate"
 	"github.com/ossf/scorecard/v5/probes/hasFSFOrOSIApprovedLicense"
 	"github.com/ossf/scorecard/v5/probes/hasLicenseFile"
+	"github.com/ossf/scorecard/v5/probes/hasLockfileForManifest"
 	"github.com/ossf/scorecard/v5/probes/hasNoGitHubWorkflowPermissionUnknown"
 	"github.com/ossf/scorecard/v5/probes/hasOSVVulnerabilities"
 	"github.com/ossf/scorecard/v5/probes/hasOpenSSFBadge"
 	"github.com/ossf/scorecard/v5/probes/hasPermissiveLicense"
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
 	"github.com/ossf/scorecard/v5/probes/hasReleaseSBOM"
+	"github.com/ossf/scorecard/v5/probes/hasSASTConfigFile"
 	"github.com/ossf/scorecard/v5/probes/hasSBOM"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanning"
+	"github.com/ossf/scorecard/v5/probes/hasSecretScanningPushProtection"
 	"github.com/ossf/scorecard/v5/probes/hasUnverifiedBinaryArtifacts"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
+	"github.com/ossf/scorecard/v5/probes/lockfileHasIntegrity"
 	"github.com/ossf/scorecard/v5/probes/packagedWithAutomatedWorkflow"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v5/probes/releaseProvenanceIsBuildLevel3"
+	"github.com/ossf/scorecard/v5/probes/releaseProvenanceIsVerified"
+	"github.com/ossf/scorecard/v5/probes/releaseProvenanceMatchesSource"
 	"github.com/ossf/scorecard/v5/probes/releasesAreSigned"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveProvenance"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveVerifiedProvenance"
+	"github.com/ossf/scorecard/v5/probes/releasesHaveVerifiedSignatures"
 	"github.com/ossf/scorecard/v5/probes/requiresApproversForPullRequests"
 	"github.com/ossf/scorecard/v5/probes/requiresCodeOwnersReview"
+	"github.com/ossf/scorecard/v5/probes/requiresDependencyVulnerabilityGate"
 	"github.com/ossf/scorecard/v5/probes/requiresLastPushApproval"
 	"github.com/ossf/scorecard/v5/probes/requiresPRsToChangeCode"
 	"github.com/ossf/scorecard/v5/probes/requiresUpToDateBranches"
 	"github.com/ossf/scorecard/v5/probes/runsStatusChecksBeforeMerging"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
+	"github.com/ossf/scorecard/v5/probes/sbomDescribesRepository"
+	"github.com/ossf/scorecard/v5/probes/sbomIsCurrent"
+	"github.com/ossf/scorecard/v5/probes/sbomIsValid"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsVulnerabilityDisclosure"
@@ -115,6 +136,9 @@ var (
 	DangerousWorkflows = []ProbeImpl{
 		hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowArtifactPoisoning.Run,
+		hasDangerousWorkflowCachePoisoning.Run,
+		hasDangerousWorkflowSelfHostedRunner.Run,
 	}
 	Maintained = []ProbeImpl{
 		archived.Run,
@@ -138,6 +162,13 @@ var (
 		hasSBOM.Run,
 		hasReleaseSBOM.Run,
 	}
+	Secrets = []ProbeImpl{
+		hasCommittedPrivateKeys.Run,
+		hasCommittedCloudAccessKeys.Run,
+		hasCommittedHighEntropyTokens.Run,
+		hasSecretScanning.Run,
+		hasSecretScanningPushProtection.Run,
+	}
 	SignedReleases = []ProbeImpl{
 		releasesAreSigned.Run,
 		releasesHaveProvenance.Run,
@@ -171,6 +202,19 @@ var (
 		codeReviewOneReviewers.Run,
 		hasBinaryArtifacts.Run,
 		releasesHaveVerifiedProvenance.Run,
+		hasLockfileForManifest.Run,
+		lockfileHasIntegrity.Run,
+		hasDependencyVulnerabilityGate.Run,
+		requiresDependencyVulnerabilityGate.Run,
+		fuzzedInCI.Run,
+		sbomIsValid.Run,
+		sbomDescribesRepository.Run,
+		sbomIsCurrent.Run,
+		releasesHaveVerifiedSignatures.Run,
+		releaseProvenanceIsVerified.Run,
+		releaseProvenanceIsBuildLevel3.Run,
+		releaseProvenanceMatchesSource.Run,
+		hasSASTConfigFile.Run,
 	}
 
 	// Probes which don't use pre-computed raw data but rather collect it themselves.
@@ -194,6 +238,7 @@ func init() {
 		Maintained,
 		Packaging,
 		SAST,
+		Secrets,
 		SecurityPolicy,
 		SignedReleases,
 		Uncategorized,
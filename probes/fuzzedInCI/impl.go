@@ -0,0 +1,74 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package fuzzedInCI
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Fuzzing})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe   = "fuzzedInCI"
+	ToolKey = "tool"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.FuzzingResults
+	if len(r.Jobs) == 0 {
+		var (
+			f   *finding.Finding
+			err error
+		)
+		if len(r.Fuzzers) == 0 {
+			f, err = finding.NewNotApplicable(fs, Probe, "no fuzzer integrations found", nil)
+		} else {
+			f, err = finding.NewFalse(fs, Probe, "no CI job runs the fuzzers", nil)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(r.Jobs))
+	for i := range r.Jobs {
+		job := &r.Jobs[i]
+		f, err := finding.NewTrue(fs, Probe, job.Fuzzer+" runs in CI", job.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ToolKey, job.Fuzzer)
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package fuzzedInCI
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/fuzzers"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no fuzzers",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "fuzzers not run in CI",
+			raw: &checker.RawResults{
+				FuzzingResults: checker.FuzzingData{
+					Fuzzers: []checker.Tool{
+						{
+							Name:  fuzzers.BuiltInGo,
+							Files: []checker.File{{Path: "parser/fuzz_test.go", Offset: 12}},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "fuzzers run in CI",
+			raw: &checker.RawResults{
+				FuzzingResults: checker.FuzzingData{
+					Fuzzers: []checker.Tool{
+						{
+							Name:  fuzzers.BuiltInGo,
+							Files: []checker.File{{Path: "parser/fuzz_test.go", Offset: 12}},
+						},
+					},
+					Jobs: []checker.FuzzingJob{
+						{
+							Fuzzer: fuzzers.BuiltInGo,
+							File:   checker.File{Path: ".github/workflows/fuzz.yml", Offset: 12},
+						},
+						{
+							Fuzzer: fuzzers.ClusterFuzzLite,
+							File:   checker.File{Path: ".github/workflows/cflite.yml", Offset: 16},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+		},
+		{
+			name: "fuzzer run in CI without a known harness",
+			raw: &checker.RawResults{
+				FuzzingResults: checker.FuzzingData{
+					Jobs: []checker.FuzzingJob{
+						{
+							Fuzzer: fuzzers.Mayhem,
+							File:   checker.File{Path: ".github/workflows/mayhem.yml", Offset: 9},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
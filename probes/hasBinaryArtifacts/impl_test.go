@@ -137,3 +137,32 @@ func Test_Run(t *testing.T) {
 		})
 	}
 }
+
+func Test_Run_formatValues(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		BinaryArtifactResults: checker.BinaryArtifactData{
+			Files: []checker.File{
+				{Path: "bin/tool", Type: finding.FileTypeBinary, Format: checker.BinaryFormatELF, Arch: "amd64"},
+				{Path: "lib/tool.jar", Type: finding.FileTypeBinary, Format: checker.BinaryFormatJAR},
+				{Path: "lib/tool.so", Type: finding.FileTypeBinary},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := []map[string]string{
+		{FormatKey: "ELF", ArchKey: "amd64"},
+		{FormatKey: "JAR"},
+		nil,
+	}
+	got := make([]map[string]string, 0, len(findings))
+	for i := range findings {
+		got = append(got, findings[i].Values)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
@@ -69,9 +70,11 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			continue
 		}
 
-		f, err := finding.NewWith(fs, Probe,
-			fmt.Sprintf("script injection with untrusted input '%v'", w.File.Snippet),
-			nil, finding.OutcomeTrue)
+		msg := fmt.Sprintf("script injection with untrusted input '%v'", w.File.Snippet)
+		if w.Caller != nil {
+			msg += fmt.Sprintf(", used by %s:%d", w.Caller.Path, w.Caller.Offset)
+		}
+		f, err := finding.NewWith(fs, Probe, msg, nil, finding.OutcomeTrue)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
 		}
@@ -83,9 +86,13 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			Snippet:   &w.File.Snippet,
 		})
 
-		err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
-		if err == nil {
-			generatePatch(&w, content, workflow, errs, f)
+		// Patches are only generated for GitHub workflows, not GitLab CI pipelines, and not
+		// for inputs of reusable workflows, which are only untrusted for some callers.
+		if fileparser.IsWorkflowFile(w.File.Path) && w.Caller == nil {
+			err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
+			if err == nil {
+				generatePatch(&w, content, workflow, errs, f)
+			}
 		}
 
 		findings = append(findings, *f)
@@ -56,9 +56,11 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	for _, e := range r.Workflows {
 		e := e
 		if e.Type == checker.DangerousWorkflowUntrustedCheckout {
-			f, err := finding.NewWith(fs, Probe,
-				fmt.Sprintf("untrusted code checkout '%v'", e.File.Snippet),
-				nil, finding.OutcomeTrue)
+			msg := fmt.Sprintf("untrusted code checkout '%v'", e.File.Snippet)
+			if e.Caller != nil {
+				msg += fmt.Sprintf(", used by %s:%d", e.Caller.Path, e.Caller.Offset)
+			}
+			f, err := finding.NewWith(fs, Probe, msg, nil, finding.OutcomeTrue)
 			if err != nil {
 				return nil, Probe, fmt.Errorf("create finding: %w", err)
 			}
@@ -0,0 +1,71 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasDependencyVulnerabilityGate
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Vulnerabilities})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe   = "hasDependencyVulnerabilityGate"
+	ToolKey = "tool"
+	JobKey  = "job"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	gates := raw.VulnerabilitiesResults.Gates
+	if len(gates) == 0 {
+		f, err := finding.NewFalse(fs, Probe, "no dependency vulnerability checks found in CI", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(gates))
+	for i := range gates {
+		g := &gates[i]
+		f, err := finding.NewTrue(fs, Probe, fmt.Sprintf("%s checks dependencies for vulnerabilities", g.Type),
+			g.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ToolKey, string(g.Type))
+		if g.Job != "" {
+			f = f.WithValue(JobKey, g.Job)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
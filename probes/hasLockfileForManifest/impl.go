@@ -0,0 +1,80 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasLockfileForManifest
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.PinnedDependencies})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe        = "hasLockfileForManifest"
+	EcosystemKey = "ecosystem"
+	LockfileKey  = "lockfile"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.PinningDependenciesResults
+	if len(r.Manifests) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe,
+			"no dependency manifests found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(r.Manifests))
+	for i := range r.Manifests {
+		m := &r.Manifests[i]
+		loc := m.File.Location()
+		var f *finding.Finding
+		var err error
+		if m.Lockfile == "" {
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("%s manifest %s has no lockfile", m.Ecosystem, m.File.Path), loc)
+		} else {
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("%s manifest %s is locked by %s", m.Ecosystem, m.File.Path, m.Lockfile), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			EcosystemKey: m.Ecosystem,
+			LockfileKey:  m.Lockfile,
+		})
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -19,7 +19,6 @@ import (
 	"embed"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
@@ -50,7 +49,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	var findings []finding.Finding
 
 	r := raw.MaintainedResults
-	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+	threshold := raw.Metadata.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
 	commitsWithinThreshold := 0
 
 	for i := range r.DefaultBranchCommits {
@@ -95,6 +95,25 @@ func Test_Run(t *testing.T) {
 			},
 			outcomes: []finding.Outcome{finding.OutcomeTrue},
 		},
+		{
+			name: "Commits are recent relative to the analysis date",
+			raw: &checker.RawResults{
+				Metadata: checker.MetadataData{
+					Date: time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC),
+				},
+				MaintainedResults: checker.MaintainedData{
+					DefaultBranchCommits: []clients.Commit{
+						{CommittedDate: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+						{CommittedDate: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			},
+			values: map[string]string{
+				NumCommitsKey:  "1",
+				LookbackDayKey: strconv.Itoa(lookBackDays),
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -33,7 +33,12 @@ func init() {
 //go:embed *.yml
 var fs embed.FS
 
-const Probe = "hasUnverifiedBinaryArtifacts"
+const (
+	Probe = "hasUnverifiedBinaryArtifacts"
+	// FormatKey and ArchKey are the format and architecture of the binary, when identified.
+	FormatKey = "format"
+	ArchKey   = "arch"
+)
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	if raw == nil {
@@ -59,6 +64,12 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			LineStart: &file.Offset,
 			Type:      file.Type,
 		})
+		if file.Format != "" {
+			f = f.WithValue(FormatKey, string(file.Format))
+		}
+		if file.Arch != "" {
+			f = f.WithValue(ArchKey, file.Arch)
+		}
 		findings = append(findings, *f)
 	}
 
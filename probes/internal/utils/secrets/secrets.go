@@ -0,0 +1,75 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// RuleKey is the finding value describing the secret, e.g. "AWS access key".
+const RuleKey = "rule"
+
+// CommittedFindings returns one OutcomeTrue finding for each committed secret of type typ,
+// or a single OutcomeFalse finding if there are none.
+func CommittedFindings(probe string, fs embed.FS, r *checker.SecretsData,
+	typ checker.SecretType, description string,
+) ([]finding.Finding, error) {
+	var findings []finding.Finding
+	for i := range r.Secrets {
+		s := &r.Secrets[i]
+		if s.Type != typ {
+			continue
+		}
+		f, err := finding.NewTrue(fs, probe, s.Rule+" committed", s.File.Location())
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(RuleKey, s.Rule)
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewFalse(fs, probe, fmt.Sprintf("no %s committed", description), nil)
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, nil
+}
+
+// SettingFinding returns a finding for a secret scanning setting of the repository.
+func SettingFinding(probe string, fs embed.FS, enabled *bool, setting string) (*finding.Finding, error) {
+	var (
+		f   *finding.Finding
+		err error
+	)
+	switch {
+	case enabled == nil:
+		f, err = finding.NewWith(fs, probe, fmt.Sprintf("unable to retrieve whether %s is enabled", setting),
+			nil, finding.OutcomeNotAvailable)
+	case *enabled:
+		f, err = finding.NewTrue(fs, probe, setting+" is enabled", nil)
+	default:
+		f, err = finding.NewFalse(fs, probe, setting+" is disabled", nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	return f, nil
+}
@@ -52,7 +52,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	numberOfIssuesUpdatedWithinThreshold := 0
 
 	// Look for activity in past `lookBackDays`.
-	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+	threshold := raw.Metadata.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
 	var findings []finding.Finding
 	for i := range r.Issues {
 		if hasActivityByCollaboratorOrHigher(&r.Issues[i], threshold) {
@@ -0,0 +1,83 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package lockfileHasIntegrity
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.PinnedDependencies})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe           = "lockfileHasIntegrity"
+	EcosystemKey    = "ecosystem"
+	DependenciesKey = "dependencies"
+	HashedKey       = "hashed"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.PinningDependenciesResults
+	if len(r.Lockfiles) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no lockfiles found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(r.Lockfiles))
+	for i := range r.Lockfiles {
+		l := &r.Lockfiles[i]
+		loc := l.File.Location()
+		var f *finding.Finding
+		var err error
+		if l.Hashed < l.Dependencies {
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("%d out of %d dependencies in %s are not pinned by hash",
+					l.Dependencies-l.Hashed, l.Dependencies, l.File.Path), loc)
+		} else {
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("all dependencies in %s are pinned by hash", l.File.Path), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			EcosystemKey:    l.Ecosystem,
+			DependenciesKey: strconv.Itoa(l.Dependencies),
+			HashedKey:       strconv.Itoa(l.Hashed),
+		})
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
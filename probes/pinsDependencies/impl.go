@@ -121,12 +121,24 @@ func generateTextIncompleteResults(e checker.ElementError) string {
 }
 
 func generateTextUnpinned(rr *checker.Dependency) string {
+	text := generateTextUnpinnedType(rr)
+	if rr.Caller != nil {
+		text += fmt.Sprintf(", used by %s:%d", rr.Caller.Path, rr.Caller.Offset)
+	}
+	return text
+}
+
+func generateTextUnpinnedType(rr *checker.Dependency) string {
 	if rr.Type == checker.DependencyUseTypeGHAction {
 		// Check if we are dealing with a GitHub action or a third-party one.
 		gitHubOwned := fileparser.IsGitHubOwnedAction(rr.Location.Snippet)
 		owner := generateOwnerToDisplay(gitHubOwned)
 		return fmt.Sprintf("%s not pinned by hash", owner)
 	}
+	if rr.Type == checker.DependencyUseTypeAzurePipelinesTask {
+		// Tasks can't be pinned by hash, only to a full version.
+		return fmt.Sprintf("%s not pinned to a full version", rr.Type)
+	}
 
 	return fmt.Sprintf("%s not pinned by hash", rr.Type)
 }
@@ -654,6 +654,21 @@ func TestGenerateText(t *testing.T) {
 			},
 			expectedText: "third-party GitHubAction not pinned by hash",
 		},
+		{
+			name: "Action of a local composite action",
+			dependency: &checker.Dependency{
+				Type: checker.DependencyUseTypeGHAction,
+				Location: &checker.File{
+					Path:    ".github/actions/setup/action.yml",
+					Snippet: "actions/setup-go@v5",
+				},
+				Caller: &checker.File{
+					Path:   ".github/workflows/ci.yml",
+					Offset: 12,
+				},
+			},
+			expectedText: "GitHub-owned GitHubAction not pinned by hash, used by .github/workflows/ci.yml:12",
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,82 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releaseProvenanceIsBuildLevel3
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SignedReleases})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "releaseProvenanceIsBuildLevel3"
+	ReleaseNameKey = "releaseName"
+	BuilderIDKey   = "builderID"
+	BuildLevelKey  = "buildLevel"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.SignedReleasesResults.Provenance {
+		prov := &raw.SignedReleasesResults.Provenance[i]
+		var (
+			f   *finding.Finding
+			err error
+		)
+		text := fmt.Sprintf("provenance of release %s is SLSA build level %d, built by %s",
+			prov.Release, prov.BuildLevel, prov.BuilderID)
+		if prov.BuildLevel >= 3 {
+			f, err = finding.NewTrue(fs, Probe, text, prov.File.Location())
+		} else {
+			f, err = finding.NewFalse(fs, Probe, text, prov.File.Location())
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			ReleaseNameKey: prov.Release,
+			BuilderIDKey:   prov.BuilderID,
+			BuildLevelKey:  strconv.Itoa(prov.BuildLevel),
+		})
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no release provenance found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
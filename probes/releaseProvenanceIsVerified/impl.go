@@ -0,0 +1,112 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releaseProvenanceIsVerified
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SignedReleases})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "releaseProvenanceIsVerified"
+	ReleaseNameKey = "releaseName"
+	BuilderIDKey   = "builderID"
+	BuildLevelKey  = "buildLevel"
+	IdentityKey    = "identity"
+	IssuerKey      = "issuer"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.SignedReleasesResults.Provenance {
+		prov := &raw.SignedReleasesResults.Provenance[i]
+		matched, mismatched := "", ""
+		for _, s := range prov.Subjects {
+			switch {
+			case s.Matches == nil:
+			case *s.Matches && matched == "":
+				matched = s.Name
+			case !*s.Matches && mismatched == "":
+				mismatched = s.Name
+			}
+		}
+
+		var (
+			f   *finding.Finding
+			err error
+		)
+		loc := prov.File.Location()
+		switch {
+		case prov.Signer == nil:
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("provenance of release %s doesn't verify: %s", prov.Release, prov.Error), loc)
+		case mismatched != "":
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("provenance subject %s doesn't match the asset of release %s", mismatched, prov.Release), loc)
+		case !prov.Logged:
+			// Without a transparency log entry, the certificate is only known to be
+			// valid when it was issued, not when the provenance was signed.
+			f, err = finding.NewNotAvailable(fs, Probe,
+				fmt.Sprintf("provenance of release %s isn't in a transparency log, so when it was signed can't be verified",
+					prov.Release), loc)
+		case matched == "":
+			f, err = finding.NewNotAvailable(fs, Probe,
+				fmt.Sprintf("no subject of the provenance of release %s could be compared with its assets", prov.Release), loc)
+		default:
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("provenance of release %s verified, built by %s", prov.Release, prov.BuilderID), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			ReleaseNameKey: prov.Release,
+			BuilderIDKey:   prov.BuilderID,
+			BuildLevelKey:  strconv.Itoa(prov.BuildLevel),
+		})
+		if prov.Signer != nil {
+			f = f.WithValue(IdentityKey, prov.Signer.Identity).WithValue(IssuerKey, prov.Signer.Issuer)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no release provenance found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releaseProvenanceMatchesSource
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SignedReleases})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "releaseProvenanceMatchesSource"
+	ReleaseNameKey = "releaseName"
+	RepositoryKey  = "repository"
+	RefKey         = "ref"
+	CommitKey      = "commit"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.SignedReleasesResults.Provenance {
+		prov := &raw.SignedReleasesResults.Provenance[i]
+		src := &prov.Source
+		var (
+			f   *finding.Finding
+			err error
+		)
+		loc := prov.File.Location()
+		switch {
+		case src.MatchesRepo != nil && !*src.MatchesRepo:
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("release %s was built from another repository: %s", prov.Release, src.Repository), loc)
+		case src.MatchesRelease != nil && !*src.MatchesRelease:
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("release %s was built from another ref: %s", prov.Release, sourceRevision(src)), loc)
+		case src.MatchesRepo == nil || src.MatchesRelease == nil:
+			f, err = finding.NewNotAvailable(fs, Probe,
+				fmt.Sprintf("the source of the provenance of release %s could not be compared", prov.Release), loc)
+		default:
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("release %s was built from %s at %s", prov.Release, src.Repository, sourceRevision(src)), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ReleaseNameKey, prov.Release)
+		for k, v := range map[string]string{
+			RepositoryKey: src.Repository,
+			RefKey:        src.Ref,
+			CommitKey:     src.Commit,
+		} {
+			if v != "" {
+				f = f.WithValue(k, v)
+			}
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no release provenance found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func sourceRevision(src *checker.ProvenanceSource) string {
+	if src.Ref != "" {
+		return src.Ref
+	}
+	return src.Commit
+}
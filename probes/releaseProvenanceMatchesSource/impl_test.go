@@ -0,0 +1,115 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releaseProvenanceMatchesSource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	matches, mismatches := true, false
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no provenance",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "matching, other repository, other ref and unknown source",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Provenance: []checker.ReleaseProvenance{
+						{
+							Release: "v1.0.0",
+							Source: checker.ProvenanceSource{
+								MatchesRepo:    &matches,
+								MatchesRelease: &matches,
+								Repository:     "git+https://github.com/owner/repo",
+								Ref:            "refs/tags/v1.0.0",
+							},
+						},
+						{
+							Release: "v0.9.0",
+							Source: checker.ProvenanceSource{
+								MatchesRepo:    &mismatches,
+								MatchesRelease: &matches,
+								Repository:     "git+https://github.com/fork/repo",
+								Ref:            "refs/tags/v0.9.0",
+							},
+						},
+						{
+							Release: "v0.8.0",
+							Source: checker.ProvenanceSource{
+								MatchesRepo:    &matches,
+								MatchesRelease: &mismatches,
+								Repository:     "git+https://github.com/owner/repo",
+								Ref:            "refs/heads/feature",
+							},
+						},
+						{
+							Release: "v0.7.0",
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+				finding.OutcomeNotAvailable,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
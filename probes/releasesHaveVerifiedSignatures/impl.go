@@ -0,0 +1,158 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releasesHaveVerifiedSignatures
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SignedReleases})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe           = "releasesHaveVerifiedSignatures"
+	ReleaseNameKey  = "releaseName"
+	AssetNameKey    = "assetName"
+	KindKey         = "kind"
+	IdentityKey     = "identity"
+	IssuerKey       = "issuer"
+	KeyIDKey        = "keyID"
+	releaseLookBack = 5
+)
+
+var signatureExtensions = []string{".asc", ".bundle", ".minisig", ".sig", ".sign", ".sigstore", ".sigstore.json"}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.SignedReleasesResults
+	var findings []finding.Finding
+	for i, release := range r.Releases {
+		if i >= releaseLookBack {
+			break
+		}
+		if len(release.Assets) == 0 {
+			continue
+		}
+
+		var (
+			verified *checker.ReleaseSignature
+			failed   []string
+		)
+		for j := range r.Signatures {
+			sig := &r.Signatures[j]
+			if sig.Release != release.TagName {
+				continue
+			}
+			switch {
+			case sig.Signer == nil:
+				failed = append(failed, fmt.Sprintf("%s: %s", sig.File.Path, sig.Error))
+			case sig.Signer.MatchesRepo != nil && !*sig.Signer.MatchesRepo:
+				// Anyone can sign keyless with their own identity.
+				failed = append(failed, fmt.Sprintf("%s: signed by %s, which isn't known to be the repository or its workflows",
+					sig.File.Path, sig.Signer.Identity))
+			default:
+				verified = sig
+			}
+			if verified != nil {
+				break
+			}
+		}
+
+		var (
+			f   *finding.Finding
+			err error
+		)
+		loc := &finding.Location{Type: finding.FileTypeURL, Path: release.URL}
+		switch {
+		case verified != nil:
+			f, err = finding.NewTrue(fs, Probe,
+				fmt.Sprintf("verified %s signature of release artifact: %s", verified.Kind, verified.Artifact),
+				verified.File.Location())
+			if err == nil {
+				f = f.WithValues(signerValues(release.TagName, verified))
+			}
+		case len(failed) > 0:
+			f, err = finding.NewFalse(fs, Probe,
+				fmt.Sprintf("signatures of release %s don't verify: %s", release.TagName, strings.Join(failed, "; ")), loc)
+		case hasSignatureAsset(release.Assets):
+			f, err = finding.NewNotAvailable(fs, Probe,
+				fmt.Sprintf("signatures of release %s could not be downloaded", release.TagName), loc)
+		default:
+			f, err = finding.NewFalse(fs, Probe, fmt.Sprintf("release %s is not signed", release.TagName), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		if f.Values == nil {
+			f = f.WithValue(ReleaseNameKey, release.TagName)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no GitHub/GitLab releases found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func signerValues(release string, sig *checker.ReleaseSignature) map[string]string {
+	values := map[string]string{
+		ReleaseNameKey: release,
+		AssetNameKey:   sig.Artifact,
+		KindKey:        string(sig.Kind),
+	}
+	for k, v := range map[string]string{
+		IdentityKey: sig.Signer.Identity,
+		IssuerKey:   sig.Signer.Issuer,
+		KeyIDKey:    sig.Signer.KeyID,
+	} {
+		if v != "" {
+			values[k] = v
+		}
+	}
+	return values
+}
+
+func hasSignatureAsset(assets []clients.ReleaseAsset) bool {
+	for _, asset := range assets {
+		for _, ext := range signatureExtensions {
+			if strings.HasSuffix(asset.Name, ext) {
+				return true
+			}
+		}
+	}
+	return false
+}
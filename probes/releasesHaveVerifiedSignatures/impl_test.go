@@ -0,0 +1,205 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package releasesHaveVerifiedSignatures
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func release(tag string, assets ...string) clients.Release {
+	r := clients.Release{TagName: tag, URL: "https://example.com/releases/" + tag}
+	for _, a := range assets {
+		r.Assets = append(r.Assets, clients.ReleaseAsset{Name: a, URL: "https://example.com/" + tag + "/" + a})
+	}
+	return r
+}
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	yes, no := true, false
+	verified := checker.ReleaseSignature{
+		Release:  "v1.0.0",
+		Artifact: "tool.tar.gz",
+		Kind:     checker.SignatureKindSigstore,
+		Signer: &checker.ReleaseSigner{
+			Identity:    "https://github.com/owner/repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+			Issuer:      "https://token.actions.githubusercontent.com",
+			MatchesRepo: &yes,
+		},
+		File: checker.File{Path: "https://example.com/v1.0.0/tool.tar.gz.sigstore.json", Type: finding.FileTypeURL},
+	}
+	otherIdentity := checker.ReleaseSignature{
+		Release:  "v0.9.0",
+		Artifact: "tool.tar.gz",
+		Kind:     checker.SignatureKindSigstore,
+		Signer: &checker.ReleaseSigner{
+			Identity:    "https://github.com/attacker/repo/.github/workflows/release.yml@refs/heads/main",
+			Issuer:      "https://token.actions.githubusercontent.com",
+			MatchesRepo: &no,
+		},
+		File: checker.File{Path: "https://example.com/v0.9.0/tool.tar.gz.sigstore.json", Type: finding.FileTypeURL},
+	}
+	failed := checker.ReleaseSignature{
+		Release:  "v0.9.0",
+		Artifact: "tool.tar.gz",
+		Kind:     checker.SignatureKindOpenPGP,
+		Error:    "OpenPGP: no matching key",
+		File:     checker.File{Path: "https://example.com/v0.9.0/tool.tar.gz.asc", Type: finding.FileTypeURL},
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no releases",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "releases without assets",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{release("v1.0.0")},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "verified, failed, not downloaded and unsigned releases",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{
+						release("v1.0.0", "tool.tar.gz", "tool.tar.gz.sigstore.json"),
+						release("v0.9.0", "tool.tar.gz", "tool.tar.gz.asc"),
+						release("v0.8.0", "tool.tar.gz", "tool.tar.gz.minisig"),
+						release("v0.7.0", "tool.tar.gz"),
+					},
+					Signatures: []checker.ReleaseSignature{verified, failed},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeFalse,
+				finding.OutcomeNotAvailable,
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "signed by another identity",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{
+						release("v1.0.0", "tool.tar.gz", "tool.tar.gz.sigstore.json"),
+						release("v0.9.0", "tool.tar.gz", "tool.tar.gz.sigstore.json"),
+					},
+					Signatures: []checker.ReleaseSignature{verified, otherIdentity},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "only the last 5 releases",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: func() []clients.Release {
+						var releases []clients.Release
+						for i := 6; i > 0; i-- {
+							releases = append(releases, release(fmt.Sprintf("v%d.0.0", i), "tool.tar.gz"))
+						}
+						return releases
+					}(),
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+				finding.OutcomeFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
+
+func Test_Run_signerValues(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		SignedReleasesResults: checker.SignedReleasesData{
+			Releases: []clients.Release{release("v1.0.0", "tool", "tool.minisig")},
+			Signatures: []checker.ReleaseSignature{{
+				Release:  "v1.0.0",
+				Artifact: "tool",
+				Kind:     checker.SignatureKindMinisign,
+				Signer:   &checker.ReleaseSigner{KeyID: "E85D337B0C912A4E"},
+			}},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := map[string]string{
+		ReleaseNameKey: "v1.0.0",
+		AssetNameKey:   "tool",
+		KindKey:        "minisign",
+		KeyIDKey:       "E85D337B0C912A4E",
+	}
+	if diff := cmp.Diff(want, findings[0].Values); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
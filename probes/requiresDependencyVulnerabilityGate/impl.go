@@ -0,0 +1,87 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package requiresDependencyVulnerabilityGate
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Vulnerabilities})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe   = "requiresDependencyVulnerabilityGate"
+	ToolKey = "tool"
+	JobKey  = "job"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	gates := raw.VulnerabilitiesResults.Gates
+	for i := range gates {
+		g := &gates[i]
+		if g.Job == "" {
+			continue
+		}
+		var (
+			f   *finding.Finding
+			err error
+		)
+		loc := g.File.Location()
+		switch {
+		case g.Required == nil:
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("unable to retrieve whether job %q is a required status check", g.Job),
+				loc, finding.OutcomeNotAvailable)
+		case *g.Required:
+			f, err = finding.NewTrue(fs, Probe, fmt.Sprintf("job %q is a required status check", g.Job), loc)
+		default:
+			f, err = finding.NewFalse(fs, Probe, fmt.Sprintf("job %q is not a required status check", g.Job), loc)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			ToolKey: string(g.Type),
+			JobKey:  g.Job,
+		})
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no CI job checks dependencies for vulnerabilities", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomIsCurrent
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SBOM})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "sbomIsCurrent"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	sboms := raw.SBOMResults.SBOMFiles
+	for i := range sboms {
+		sbom := &sboms[i]
+		if sbom.Document == nil || sbom.Document.Stale == nil {
+			continue
+		}
+		var (
+			f   *finding.Finding
+			err error
+		)
+		if *sbom.Document.Stale {
+			f, err = finding.NewFalse(fs, Probe,
+				"SBOM "+sbom.Name+" was generated before the latest release", sbom.File.Location())
+		} else {
+			f, err = finding.NewTrue(fs, Probe,
+				"SBOM "+sbom.Name+" was generated for the latest release", sbom.File.Location())
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no SBOM to compare with the latest release", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
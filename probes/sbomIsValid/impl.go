@@ -0,0 +1,97 @@
+// Copyright 2025 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package sbomIsValid
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SBOM})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "sbomIsValid"
+	FormatKey      = "format"
+	SpecVersionKey = "specVersion"
+	ComponentsKey  = "components"
+	ToolsKey       = "tools"
+	CreatedKey     = "created"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	sboms := raw.SBOMResults.SBOMFiles
+	if len(sboms) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no SBOM found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(sboms))
+	for i := range sboms {
+		sbom := &sboms[i]
+		doc := sbom.Document
+		var (
+			f   *finding.Finding
+			err error
+		)
+		switch {
+		case doc == nil:
+			f, err = finding.NewNotAvailable(fs, Probe, "SBOM could not be parsed: "+sbom.Name, sbom.File.Location())
+		case len(doc.Errors) > 0:
+			msg := fmt.Sprintf("%s SBOM %s is invalid: %s", doc.Format, sbom.Name, strings.Join(doc.Errors, "; "))
+			f, err = finding.NewFalse(fs, Probe, msg, sbom.File.Location())
+		default:
+			msg := fmt.Sprintf("%s %s SBOM %s is valid", doc.Format, doc.SpecVersion, sbom.Name)
+			f, err = finding.NewTrue(fs, Probe, msg, sbom.File.Location())
+			if err == nil {
+				f = f.WithValues(map[string]string{
+					FormatKey:      string(doc.Format),
+					SpecVersionKey: doc.SpecVersion,
+					ComponentsKey:  strconv.Itoa(doc.Components),
+					ToolsKey:       strings.Join(doc.Tools, ","),
+				})
+				if !doc.Created.IsZero() {
+					f = f.WithValue(CreatedKey, doc.Created.Format(time.RFC3339))
+				}
+			}
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
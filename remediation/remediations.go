@@ -32,6 +32,12 @@ var (
 	//nolint:lll
 	workflowMarkdown  = "update your workflow using [https://app.stepsecurity.io](https://app.stepsecurity.io/secureworkflow/%s/%s/%s?enable=%s)"
 	dockerfilePinText = "pin your Docker image by updating %[1]s to %[1]s@%s"
+
+	gitlabRemoteIncludePinText  = "pin the remote include %s by adding its integrity: sha256-<base64 digest>"
+	gitlabProjectIncludePinText = "pin the include of project %s by setting its ref to a full-length commit SHA"
+	gitlabComponentPinText      = "pin the component %[1]s by updating %[1]s@%[2]s to %[1]s@<full-length commit SHA>"
+	azureTemplatePinText        = "pin the repository resource %s by setting its ref to a full-length commit SHA"
+	azureTaskPinText            = "pin the task %[1]s by updating %[1]s@%[2]s to %[1]s@<major>.<minor>.<patch>"
 )
 
 // TODO fix how this info makes it checks/evaluation.
@@ -120,3 +126,56 @@ func CreateDockerfilePinningRemediation(dep *checker.Dependency, digester Digest
 		Markdown: markdown,
 	}
 }
+
+// CreateGitLabCIIncludePinningRemediation create remediation for pinning GitLab CI includes.
+func CreateGitLabCIIncludePinningRemediation(dep *checker.Dependency, includeType string) *finding.Remediation {
+	if dep.Name == nil || *dep.Name == "" {
+		return nil
+	}
+
+	var text string
+	switch includeType {
+	case "remote":
+		text = fmt.Sprintf(gitlabRemoteIncludePinText, *dep.Name)
+	case "project":
+		text = fmt.Sprintf(gitlabProjectIncludePinText, *dep.Name)
+	case "component":
+		text = fmt.Sprintf(gitlabComponentPinText, *dep.Name, valueOrEmpty(dep.PinnedAt))
+	default:
+		return nil
+	}
+
+	return &finding.Remediation{
+		Text:     text,
+		Markdown: text,
+	}
+}
+
+// CreateAzurePipelinesPinningRemediation create remediation for pinning Azure Pipelines templates and tasks.
+func CreateAzurePipelinesPinningRemediation(dep *checker.Dependency) *finding.Remediation {
+	if dep.Name == nil || *dep.Name == "" {
+		return nil
+	}
+
+	var text string
+	switch dep.Type {
+	case checker.DependencyUseTypeAzurePipelinesTemplate:
+		text = fmt.Sprintf(azureTemplatePinText, *dep.Name)
+	case checker.DependencyUseTypeAzurePipelinesTask:
+		text = fmt.Sprintf(azureTaskPinText, *dep.Name, valueOrEmpty(dep.PinnedAt))
+	default:
+		return nil
+	}
+
+	return &finding.Remediation{
+		Text:     text,
+		Markdown: text,
+	}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
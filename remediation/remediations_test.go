@@ -190,3 +190,53 @@ func TestCreateWorkflowPinningRemediation(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAzurePipelinesPinningRemediation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		dep      checker.Dependency
+		expected *finding.Remediation
+		name     string
+	}{
+		{
+			name: "task",
+			dep: checker.Dependency{
+				Name:     asPointer("NodeTool"),
+				PinnedAt: asPointer("0"),
+				Type:     checker.DependencyUseTypeAzurePipelinesTask,
+			},
+			expected: &finding.Remediation{
+				Text:     "pin the task NodeTool by updating NodeTool@0 to NodeTool@<major>.<minor>.<patch>",
+				Markdown: "pin the task NodeTool by updating NodeTool@0 to NodeTool@<major>.<minor>.<patch>",
+			},
+		},
+		{
+			name: "template",
+			dep: checker.Dependency{
+				Name: asPointer("my-org/templates"),
+				Type: checker.DependencyUseTypeAzurePipelinesTemplate,
+			},
+			expected: &finding.Remediation{
+				Text:     "pin the repository resource my-org/templates by setting its ref to a full-length commit SHA",
+				Markdown: "pin the repository resource my-org/templates by setting its ref to a full-length commit SHA",
+			},
+		},
+		{
+			name: "no name",
+			dep: checker.Dependency{
+				Type: checker.DependencyUseTypeAzurePipelinesTask,
+			},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := CreateAzurePipelinesPinningRemediation(&tt.dep)
+			if !cmp.Equal(got, tt.expected) {
+				t.Error(cmp.Diff(got, tt.expected))
+			}
+		})
+	}
+}